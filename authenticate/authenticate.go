@@ -41,6 +41,9 @@ type Authenticate struct {
 	cfg     *authenticateConfig
 	options *atomicutil.Value[*config.Options]
 	state   *atomicutil.Value[*authenticateState]
+
+	localUserLocks     localUserLocks
+	localSignInLimiter *localSignInLimiter
 }
 
 // New validates and creates a new authenticate service from a set of Options.
@@ -50,6 +53,8 @@ func New(ctx context.Context, cfg *config.Config, options ...Option) (*Authentic
 		cfg:     authenticateConfig,
 		options: config.NewAtomicOptions(),
 		state:   atomicutil.NewValue(newAuthenticateState()),
+
+		localSignInLimiter: newLocalSignInLimiter(),
 	}
 
 	a.options.Store(cfg.Options)
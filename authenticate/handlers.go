@@ -13,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
+	"golang.org/x/oauth2"
 
 	"github.com/pomerium/csrf"
 	"github.com/pomerium/pomerium/internal/authenticateflow"
@@ -20,12 +21,17 @@ import (
 	"github.com/pomerium/pomerium/internal/httputil"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/middleware"
+	"github.com/pomerium/pomerium/internal/scim"
 	"github.com/pomerium/pomerium/internal/sessions"
 	"github.com/pomerium/pomerium/internal/telemetry/trace"
 	"github.com/pomerium/pomerium/internal/urlutil"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
+	identitypb "github.com/pomerium/pomerium/pkg/grpc/identity"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
 	"github.com/pomerium/pomerium/pkg/identity"
 	"github.com/pomerium/pomerium/pkg/identity/oidc"
+	"github.com/pomerium/pomerium/pkg/identity/saml"
+	"github.com/pomerium/pomerium/pkg/policy/criteria"
 )
 
 // Handler returns the authenticate service's handler chain.
@@ -39,6 +45,7 @@ func (a *Authenticate) Handler() http.Handler {
 func (a *Authenticate) Mount(r *mux.Router) {
 	r.StrictSlash(true)
 	r.Use(middleware.SetHeaders(httputil.HeadersContentSecurityPolicy))
+	r.Use(samlRelayState)
 	r.Use(func(h http.Handler) http.Handler {
 		options := a.options.Load()
 		state := a.state.Load()
@@ -56,7 +63,16 @@ func (a *Authenticate) Mount(r *mux.Router) {
 			csrf.ErrorHandler(httputil.HandlerFunc(httputil.CSRFFailureHandler)),
 			csrf.SameSite(options.GetCSRFSameSite()),
 		}
-		return csrf.Protect(state.cookieSecret, csrfOptions...)(h)
+		protected := csrf.Protect(state.cookieSecret, csrfOptions...)(h)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// SCIM clients authenticate with a bearer token rather than
+			// cookies, so the SCIM endpoints don't need csrf protection
+			if strings.HasPrefix(r.URL.Path, scim.BasePath+"/") {
+				h.ServeHTTP(w, r)
+				return
+			}
+			protected.ServeHTTP(w, r)
+		})
 	})
 
 	// redirect / to /.pomerium/
@@ -67,6 +83,9 @@ func (a *Authenticate) Mount(r *mux.Router) {
 	// Identity Provider (IdP) endpoints
 	r.Path("/oauth2/callback").Handler(httputil.HandlerFunc(a.OAuthCallback)).Methods(http.MethodGet, http.MethodPost)
 
+	// SCIM provisioning endpoints
+	r.PathPrefix(scim.BasePath + "/").Handler(httputil.HandlerFunc(a.serveSCIM))
+
 	a.mountDashboard(r)
 }
 
@@ -90,6 +109,10 @@ func (a *Authenticate) mountDashboard(r *mux.Router) {
 	// routes that don't need a session:
 	sr.Path("/sign_out").Handler(httputil.HandlerFunc(a.SignOut))
 	sr.Path("/signed_out").Handler(httputil.HandlerFunc(a.signedOut)).Methods(http.MethodGet)
+	sr.Path("/saml/metadata").Handler(httputil.HandlerFunc(a.samlMetadata)).Methods(http.MethodGet)
+	sr.Path("/local").Handler(httputil.HandlerFunc(a.localSignIn)).Methods(http.MethodGet, http.MethodPost)
+	sr.Path("/passkey").Handler(httputil.HandlerFunc(a.passkeySignIn)).Methods(http.MethodGet, http.MethodPost)
+	sr.Path("/passkey/enroll").Handler(httputil.HandlerFunc(a.passkeyEnroll)).Methods(http.MethodGet, http.MethodPost)
 
 	// routes that need a session:
 	sr = sr.NewRoute().Subrouter()
@@ -105,6 +128,16 @@ func (a *Authenticate) mountDashboard(r *mux.Router) {
 	cr.Path("/").Handler(a.requireValidSignature(a.Callback)).Methods(http.MethodGet)
 }
 
+// serveSCIM serves the SCIM provisioning endpoints if they are enabled.
+func (a *Authenticate) serveSCIM(w http.ResponseWriter, r *http.Request) error {
+	h := a.state.Load().scim
+	if h == nil {
+		return httputil.NewError(http.StatusNotFound, errors.New("scim is not enabled"))
+	}
+	h.ServeHTTP(w, r)
+	return nil
+}
+
 // RetrieveSession is the middleware used retrieve session by the sessionLoader
 func (a *Authenticate) RetrieveSession(next http.Handler) http.Handler {
 	return sessions.RetrieveSession(a.state.Load().sessionLoader)(next)
@@ -129,7 +162,7 @@ func (a *Authenticate) VerifySession(next http.Handler) http.Handler {
 			return a.reauthenticateOrFail(w, r, err)
 		}
 
-		if sessionState.IdentityProviderID != idpID {
+		if !a.options.Load().IsIdentityProviderAllowed(idpID, sessionState.IdentityProviderID) {
 			log.FromRequest(r).Info().
 				Str("idp_id", idpID).
 				Str("session_idp_id", sessionState.IdentityProviderID).
@@ -146,11 +179,31 @@ func (a *Authenticate) VerifySession(next http.Handler) http.Handler {
 			return a.reauthenticateOrFail(w, r, err)
 		}
 
+		if reauthenticationRequested(a.getSignInRequestParams(r), sessionState) {
+			log.FromRequest(r).Info().
+				Str("idp_id", idpID).
+				Str("id", sessionState.ID).
+				Msg("authenticate: re-authentication requested")
+			return a.reauthenticateOrFail(w, r, errors.New("re-authentication requested"))
+		}
+
 		next.ServeHTTP(w, r.WithContext(ctx))
 		return nil
 	})
 }
 
+// reauthenticationRequested returns true if the sign in request params ask for
+// the user to log in again, unless they just did.
+func reauthenticationRequested(params url.Values, sessionState *sessions.State) bool {
+	if params.Get(urlutil.QueryPrompt) != "login" {
+		return false
+	}
+	if sessionState.IssuedAt == nil {
+		return true
+	}
+	return time.Since(sessionState.IssuedAt.Time()) >= criteria.ReauthenticationGracePeriod
+}
+
 // RobotsTxt handles the /robots.txt route.
 func (a *Authenticate) RobotsTxt(w http.ResponseWriter, _ *http.Request) {
 	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
@@ -201,7 +254,7 @@ func (a *Authenticate) signOutRedirect(w http.ResponseWriter, r *http.Request) e
 	defer span.End()
 
 	options := a.options.Load()
-	idpID := a.getIdentityProviderIDForRequest(r)
+	idpID := a.getIdentityProviderIDForSession(ctx, r)
 
 	authenticator, err := a.cfg.getIdentityProvider(options, idpID)
 	if err != nil {
@@ -253,6 +306,45 @@ func (a *Authenticate) signedOut(w http.ResponseWriter, r *http.Request) error {
 	return nil
 }
 
+func (a *Authenticate) samlMetadata(w http.ResponseWriter, r *http.Request) error {
+	options := a.options.Load()
+	idpID := a.getIdentityProviderIDForRequest(r)
+
+	authenticator, err := a.cfg.getIdentityProvider(options, idpID)
+	if err != nil {
+		return err
+	}
+
+	sp, ok := authenticator.(interface {
+		Metadata(ctx context.Context) ([]byte, error)
+	})
+	if !ok {
+		return httputil.NewError(http.StatusNotFound, fmt.Errorf("identity provider does not support SAML"))
+	}
+
+	metadata, err := sp.Metadata(r.Context())
+	if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	_, err = w.Write(metadata)
+	return err
+}
+
+// samlRelayState copies the SAML relay state into the state form value for
+// responses posted to the callback endpoint, so the csrf token it contains
+// can be validated.
+func samlRelayState(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/oauth2/callback" &&
+			r.FormValue("state") == "" && r.FormValue(saml.FormRelayState) != "" {
+			r.Form.Set("state", r.FormValue(saml.FormRelayState))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 // reauthenticateOrFail starts the authenticate process by redirecting the
 // user to their respective identity provider. This function also builds the
 // 'state' parameter which is encrypted and includes authenticating data
@@ -274,6 +366,23 @@ func (a *Authenticate) reauthenticateOrFail(w http.ResponseWriter, r *http.Reque
 	options := a.options.Load()
 	idpID := a.getIdentityProviderIDForRequest(r)
 
+	// when there are several identity providers to choose from, use the one
+	// matching the login hint or let the user pick one
+	choices, err := options.GetIdentityProviderChoices(idpID)
+	if err != nil {
+		return err
+	}
+	if len(choices) > 1 {
+		idp, err := options.GetIdentityProviderForLoginHint(idpID, r.FormValue(urlutil.QueryLoginHint))
+		if err != nil {
+			return err
+		}
+		if idp == nil {
+			return a.selectIdentityProvider(w, r, choices)
+		}
+		idpID = idp.GetId()
+	}
+
 	authenticator, err := a.cfg.getIdentityProvider(options, idpID)
 	if err != nil {
 		return err
@@ -283,6 +392,9 @@ func (a *Authenticate) reauthenticateOrFail(w http.ResponseWriter, r *http.Reque
 
 	state.sessionStore.ClearSession(w, r)
 	redirectURL := state.redirectURL.ResolveReference(r.URL)
+	if len(choices) > 1 {
+		redirectURL = withSelectedIdentityProviderID(redirectURL, idpID)
+	}
 	nonce := csrf.Token(r)
 	now := time.Now().Unix()
 	b := []byte(fmt.Sprintf("%s|%d|", nonce, now))
@@ -290,6 +402,10 @@ func (a *Authenticate) reauthenticateOrFail(w http.ResponseWriter, r *http.Reque
 	b = append(b, enc...)
 	encodedState := base64.URLEncoding.EncodeToString(b)
 
+	// the PKCE code verifier is derived from the state, so that it is the
+	// same when the authorization code is redeemed in the callback
+	r = r.WithContext(oidc.WithPKCEVerifier(r.Context(), state.flow.GetPKCEVerifier(encodedState)))
+	r = r.WithContext(oidc.WithSignInRequestParams(r.Context(), a.getSignInRequestParams(r)))
 	err = authenticator.SignIn(w, r, encodedState)
 	if err != nil {
 		return httputil.NewError(http.StatusInternalServerError,
@@ -324,7 +440,6 @@ func (a *Authenticate) getOAuthCallback(w http.ResponseWriter, r *http.Request)
 	ctx, span := trace.StartSpan(r.Context(), "authenticate.getOAuthCallback")
 	defer span.End()
 
-	state := a.state.Load()
 	options := a.options.Load()
 
 	// Error Authentication Response: rfc6749#section-4.1.2.1 & OIDC#3.1.2.6
@@ -336,12 +451,55 @@ func (a *Authenticate) getOAuthCallback(w http.ResponseWriter, r *http.Request)
 
 	// fail if no session redemption code is returned
 	code := r.FormValue("code")
+	if samlResponse := r.FormValue(saml.FormResponse); samlResponse != "" {
+		// SAML identity providers post the response to the callback instead of a code
+		code = url.Values{
+			saml.FormResponse:   {samlResponse},
+			saml.FormRelayState: {r.FormValue(saml.FormRelayState)},
+		}.Encode()
+	}
 	if code == "" {
 		return nil, httputil.NewError(http.StatusBadRequest, fmt.Errorf("identity provider returned empty code"))
 	}
 
 	// state includes a csrf nonce (validated by middleware) and redirect uri
-	bytes, err := base64.URLEncoding.DecodeString(r.FormValue("state"))
+	encodedState := r.FormValue("state")
+	redirectURL, err := a.getRedirectURLFromState(encodedState)
+	if err != nil {
+		return nil, err
+	}
+	state := a.state.Load()
+	ctx = oidc.WithPKCEVerifier(ctx, state.flow.GetPKCEVerifier(encodedState))
+	ctx = saml.WithAssertionReplayCache(ctx, samlAssertionReplayCache{client: state.dataBrokerClient})
+
+	idpID := a.getIdentityProviderIDForURLValues(redirectURL.Query())
+
+	authenticator, err := a.cfg.getIdentityProvider(options, idpID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Successful Authentication Response: rfc6749#section-4.1.2 & OIDC#3.1.2.5
+	//
+	// Exchange the supplied Authorization Code for a valid user session.
+	var claims identity.SessionClaims
+	accessToken, err := authenticator.Authenticate(ctx, code, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("error redeeming authenticate code: %w", err)
+	}
+
+	if err := a.saveNewSession(ctx, w, r, idpID, redirectURL, claims, accessToken); err != nil {
+		return nil, err
+	}
+	return redirectURL, nil
+}
+
+// getRedirectURLFromState decrypts and validates the redirect url contained in
+// the state created by reauthenticateOrFail.
+func (a *Authenticate) getRedirectURLFromState(encodedState string) (*url.URL, error) {
+	state := a.state.Load()
+
+	bytes, err := base64.URLEncoding.DecodeString(encodedState)
 	if err != nil {
 		return nil, httputil.NewError(http.StatusBadRequest, fmt.Errorf("bad bytes: %w", err))
 	}
@@ -377,27 +535,26 @@ Try again by following this link: [%s](%s).
 Or contact your administrator.
 `, redirectURL.String(), redirectURL.String()))
 	}
+	return redirectURL, nil
+}
 
-	idpID := state.flow.GetIdentityProviderIDForURLValues(redirectURL.Query())
-
-	authenticator, err := a.cfg.getIdentityProvider(options, idpID)
-	if err != nil {
-		return nil, err
-	}
-
-	// Successful Authentication Response: rfc6749#section-4.1.2 & OIDC#3.1.2.5
-	//
-	// Exchange the supplied Authorization Code for a valid user session.
-	var claims identity.SessionClaims
-	accessToken, err := authenticator.Authenticate(ctx, code, &claims)
-	if err != nil {
-		return nil, fmt.Errorf("error redeeming authenticate code: %w", err)
-	}
+// saveNewSession saves a new session for the claims returned by the identity
+// provider, to the databroker/cookie store and to local storage.
+func (a *Authenticate) saveNewSession(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	idpID string,
+	redirectURL *url.URL,
+	claims identity.SessionClaims,
+	accessToken *oauth2.Token,
+) error {
+	state := a.state.Load()
 
 	s := sessions.NewState(idpID)
-	err = claims.Claims.Claims(&s)
+	err := claims.Claims.Claims(&s)
 	if err != nil {
-		return nil, fmt.Errorf("error unmarshaling session state: %w", err)
+		return fmt.Errorf("error unmarshaling session state: %w", err)
 	}
 
 	newState := s.WithNewIssuer(state.redirectURL.Hostname(), []string{state.redirectURL.Hostname()})
@@ -407,14 +564,14 @@ Or contact your administrator.
 
 	// save the session and access token to the databroker/cookie store
 	if err := state.flow.PersistSession(ctx, w, &newState, claims, accessToken); err != nil {
-		return nil, fmt.Errorf("failed saving new session: %w", err)
+		return fmt.Errorf("failed saving new session: %w", err)
 	}
 
 	// ...  and the user state to local storage.
 	if err := state.sessionStore.SaveSession(w, r, &newState); err != nil {
-		return nil, fmt.Errorf("failed saving new session: %w", err)
+		return fmt.Errorf("failed saving new session: %w", err)
 	}
-	return redirectURL, nil
+	return nil
 }
 
 func (a *Authenticate) getSessionFromCtx(ctx context.Context) (*sessions.State, error) {
@@ -482,7 +639,7 @@ func (a *Authenticate) revokeSession(ctx context.Context, w http.ResponseWriter,
 	// clear the user's local session no matter what
 	defer state.sessionStore.ClearSession(w, r)
 
-	idpID := r.FormValue(urlutil.QueryIdentityProviderID)
+	idpID := a.getIdentityProviderIDForSession(ctx, r)
 
 	authenticator, err := a.cfg.getIdentityProvider(options, idpID)
 	if err != nil {
@@ -546,5 +703,83 @@ func (a *Authenticate) getIdentityProviderIDForRequest(r *http.Request) string {
 	if err := r.ParseForm(); err != nil {
 		return ""
 	}
-	return a.state.Load().flow.GetIdentityProviderIDForURLValues(r.Form)
+	return a.getIdentityProviderIDForURLValues(r.Form)
+}
+
+// getSignInRequestParams returns the params of the sign in request, which the
+// stateless flow encrypts.
+func (a *Authenticate) getSignInRequestParams(r *http.Request) url.Values {
+	if err := r.ParseForm(); err != nil {
+		return nil
+	}
+	return a.state.Load().flow.GetSignInRequestParams(r.Form)
+}
+
+// getIdentityProviderIDForURLValues returns the identity provider ID for the
+// given URL values, honoring the identity provider selected by the user if it
+// is allowed.
+func (a *Authenticate) getIdentityProviderIDForURLValues(vs url.Values) string {
+	idpID := a.state.Load().flow.GetIdentityProviderIDForURLValues(vs)
+	if selected := vs.Get(urlutil.QuerySelectedIdentityProviderID); selected != "" &&
+		a.options.Load().IsIdentityProviderAllowed(idpID, selected) {
+		return selected
+	}
+	return idpID
+}
+
+// getIdentityProviderIDForSession returns the ID of the identity provider
+// that issued the current session, falling back to the one for the request.
+func (a *Authenticate) getIdentityProviderIDForSession(ctx context.Context, r *http.Request) string {
+	if sessionState, err := a.getSessionFromCtx(ctx); err == nil && sessionState.IdentityProviderID != "" {
+		return sessionState.IdentityProviderID
+	}
+	return a.getIdentityProviderIDForRequest(r)
+}
+
+// selectIdentityProvider renders a page for the user to choose which of the
+// identity providers to sign in with.
+func (a *Authenticate) selectIdentityProvider(w http.ResponseWriter, r *http.Request, idps []*identitypb.Provider) error {
+	selfURL := a.state.Load().redirectURL.ResolveReference(r.URL)
+
+	data := handlers.SelectIdentityProviderData{
+		BrandingOptions: a.options.Load().BrandingOptions,
+	}
+	for _, idp := range idps {
+		name := idp.GetName()
+		if name == "" {
+			name = idp.GetType()
+		}
+		data.IdentityProviders = append(data.IdentityProviders, handlers.SelectIdentityProviderOption{
+			Name: name,
+			URL:  withSelectedIdentityProviderID(selfURL, idp.GetId()).String(),
+		})
+	}
+	handlers.SelectIdentityProvider(data).ServeHTTP(w, r)
+	return nil
+}
+
+func withSelectedIdentityProviderID(u *url.URL, idpID string) *url.URL {
+	q := u.Query()
+	q.Set(urlutil.QuerySelectedIdentityProviderID, idpID)
+	cp := *u
+	cp.RawQuery = q.Encode()
+	return &cp
+}
+
+// newUserSessionClaims returns the session claims for a user stored in the
+// databroker, for identity providers without an upstream. Any claims stored on
+// the user record are included, so that they can be used in policies.
+func newUserSessionClaims(u *user.User) identity.SessionClaims {
+	claims := identity.Claims{}
+	for k, vs := range u.GetClaims() {
+		claims[k] = vs.AsSlice()
+	}
+	claims["sub"] = u.GetId()
+	if u.GetEmail() != "" {
+		claims["email"] = u.GetEmail()
+	}
+	if u.GetName() != "" {
+		claims["name"] = u.GetName()
+	}
+	return identity.SessionClaims{Claims: claims}
 }
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/atomicutil"
@@ -28,6 +30,7 @@ import (
 	"github.com/pomerium/pomerium/internal/encoding/mock"
 	"github.com/pomerium/pomerium/internal/handlers"
 	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/scim"
 	"github.com/pomerium/pomerium/internal/sessions"
 	mstore "github.com/pomerium/pomerium/internal/sessions/mock"
 	"github.com/pomerium/pomerium/internal/testutil"
@@ -35,6 +38,7 @@ import (
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	configproto "github.com/pomerium/pomerium/pkg/grpc/config"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
 	"github.com/pomerium/pomerium/pkg/identity"
 	"github.com/pomerium/pomerium/pkg/identity/oidc"
 )
@@ -685,6 +689,119 @@ func TestSignOutBranding(t *testing.T) {
 	})
 }
 
+func TestAuthenticate_SelectIdentityProvider(t *testing.T) {
+	t.Parallel()
+
+	options := config.NewDefaultOptions()
+	options.AuthenticateURLString = "https://authenticate.example.com"
+	options.Provider = "oidc"
+	options.ProviderURL = "https://idp.example.com"
+	options.IdentityProviders = []config.IdentityProvider{
+		{Name: "google", DisplayName: "Google", Provider: "google", Domains: []string{"example.com"}},
+		{Name: "okta", DisplayName: "Okta", Provider: "okta"},
+	}
+	defaultIDP, err := options.GetIdentityProviderForPolicy(nil)
+	require.NoError(t, err)
+	idps, err := options.GetIdentityProviders()
+	require.NoError(t, err)
+
+	aead, err := chacha20poly1305.NewX(cryptutil.NewKey())
+	require.NoError(t, err)
+
+	handle := func(t *testing.T, rawURL string) (*httptest.ResponseRecorder, []string) {
+		t.Helper()
+
+		var signInIDPIDs []string
+		sessionStore := &mstore.Store{LoadError: errors.New("no session")}
+		a := &Authenticate{
+			cfg: getAuthenticateConfig(WithGetIdentityProvider(func(_ *config.Options, idpID string) (identity.Authenticator, error) {
+				signInIDPIDs = append(signInIDPIDs, idpID)
+				return identity.MockProvider{}, nil
+			})),
+			state: atomicutil.NewValue(&authenticateState{
+				cookieSecret:  cryptutil.NewKey(),
+				redirectURL:   uriParseHelper("https://authenticate.example.com"),
+				sessionLoader: sessionStore,
+				sessionStore:  sessionStore,
+				cookieCipher:  aead,
+				sharedEncoder: mock.Encoder{},
+				flow:          &stubFlow{idpID: defaultIDP.GetId()},
+			}),
+			options: config.NewAtomicOptions(),
+		}
+		a.options.Store(options)
+
+		r := httptest.NewRequest(http.MethodGet, rawURL, nil)
+		r = r.WithContext(sessions.NewContext(r.Context(), "", errors.New("no session")))
+		w := httptest.NewRecorder()
+		a.VerifySession(http.NotFoundHandler()).ServeHTTP(w, r)
+		return w, signInIDPIDs
+	}
+
+	t.Run("chooser", func(t *testing.T) {
+		t.Parallel()
+
+		w, signInIDPIDs := handle(t, "https://authenticate.example.com/.pomerium/sign_in")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, signInIDPIDs)
+		body := w.Body.String()
+		assert.Contains(t, body, `"page":"SelectIdentityProvider"`)
+		assert.Contains(t, body, `"name":"Google"`)
+		assert.Contains(t, body, urlutil.QuerySelectedIdentityProviderID+"="+url.QueryEscape(idps[1].GetId()))
+	})
+	t.Run("login hint", func(t *testing.T) {
+		t.Parallel()
+
+		_, signInIDPIDs := handle(t, "https://authenticate.example.com/.pomerium/sign_in?login_hint=user@example.com")
+		assert.Equal(t, []string{idps[0].GetId()}, signInIDPIDs)
+	})
+	t.Run("selected", func(t *testing.T) {
+		t.Parallel()
+
+		_, signInIDPIDs := handle(t, "https://authenticate.example.com/.pomerium/sign_in?"+
+			urlutil.QuerySelectedIdentityProviderID+"="+url.QueryEscape(idps[1].GetId()))
+		assert.Equal(t, []string{idps[1].GetId()}, signInIDPIDs)
+	})
+	t.Run("not allowed", func(t *testing.T) {
+		t.Parallel()
+
+		w, signInIDPIDs := handle(t, "https://authenticate.example.com/.pomerium/sign_in?"+
+			urlutil.QuerySelectedIdentityProviderID+"=UNKNOWN")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, signInIDPIDs)
+		assert.Contains(t, w.Body.String(), `"page":"SelectIdentityProvider"`)
+	})
+}
+
+func TestSAMLRelayState(t *testing.T) {
+	t.Parallel()
+
+	var state string
+	h := samlRelayState(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		state = r.FormValue("state")
+	}))
+
+	for _, tc := range []struct {
+		name   string
+		method string
+		path   string
+		body   url.Values
+		expect string
+	}{
+		{"relay state", http.MethodPost, "/oauth2/callback", url.Values{"SAMLResponse": {"RESPONSE"}, "RelayState": {"STATE"}}, "STATE"},
+		{"existing state", http.MethodPost, "/oauth2/callback", url.Values{"state": {"OTHER"}, "RelayState": {"STATE"}}, "OTHER"},
+		{"other path", http.MethodPost, "/.pomerium/sign_out", url.Values{"RelayState": {"STATE"}}, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			state = ""
+			r := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			h.ServeHTTP(httptest.NewRecorder(), r)
+			assert.Equal(t, tc.expect, state)
+		})
+	}
+}
+
 type mockDataBrokerServiceClient struct {
 	databroker.DataBrokerServiceClient
 
@@ -711,6 +828,7 @@ func mustParseURL(rawurl string) *url.URL {
 // stubFlow is a stub implementation of the flow interface.
 type stubFlow struct {
 	verifySignatureErr error
+	idpID              string
 }
 
 func (f *stubFlow) VerifyAuthenticateSignature(*http.Request) error {
@@ -743,6 +861,74 @@ func (*stubFlow) GetUserInfoData(*http.Request, *sessions.State) handlers.UserIn
 
 func (*stubFlow) LogAuthenticateEvent(*http.Request) {}
 
-func (*stubFlow) GetIdentityProviderIDForURLValues(url.Values) string {
+func (f *stubFlow) GetIdentityProviderIDForURLValues(url.Values) string {
+	return f.idpID
+}
+
+func (*stubFlow) GetSignInRequestParams(vs url.Values) url.Values {
+	return vs
+}
+
+func (*stubFlow) GetPKCEVerifier(string) string {
 	return ""
 }
+
+func TestAuthenticate_SCIM(t *testing.T) {
+	t.Parallel()
+
+	auth := testAuthenticate()
+	h := auth.Handler()
+
+	post := func() int {
+		r := httptest.NewRequest(http.MethodPost, "https://authenticate.example.com/scim/v2/Users", strings.NewReader(`{}`))
+		r.Header.Set("Authorization", "Bearer WRONG")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusNotFound, post(), "should return not found when disabled")
+
+	auth.state.Load().scim = scim.New(nil, "TOKEN")
+	assert.Equal(t, http.StatusUnauthorized, post(), "should skip csrf protection and check the bearer token")
+}
+
+func TestReauthenticationRequested(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, reauthenticationRequested(url.Values{}, sessions.NewState("IDP")))
+
+	params := url.Values{urlutil.QueryPrompt: {"login"}}
+	assert.False(t, reauthenticationRequested(params, sessions.NewState("IDP")),
+		"should not ask a user who just logged in to log in again")
+	assert.True(t, reauthenticationRequested(params, &sessions.State{
+		IssuedAt: jwt.NewNumericDate(time.Now().Add(-time.Hour)),
+	}))
+	assert.True(t, reauthenticationRequested(params, &sessions.State{}))
+}
+
+func TestNewUserSessionClaims(t *testing.T) {
+	t.Parallel()
+
+	groups, err := structpb.NewList([]any{"admins", "developers"})
+	assert.NoError(t, err)
+
+	claims := newUserSessionClaims(&user.User{
+		Id:    "u1",
+		Name:  "Alice",
+		Email: "alice@example.com",
+		Claims: map[string]*structpb.ListValue{
+			"groups": groups,
+			"sub":    {Values: []*structpb.Value{structpb.NewStringValue("other")}},
+		},
+	})
+	assert.Equal(t, identity.Claims{
+		"sub":    "u1",
+		"name":   "Alice",
+		"email":  "alice@example.com",
+		"groups": []any{"admins", "developers"},
+	}, claims.Claims)
+
+	claims = newUserSessionClaims(&user.User{Id: "u2"})
+	assert.Equal(t, identity.Claims{"sub": "u2"}, claims.Claims)
+}
@@ -0,0 +1,220 @@
+package authenticate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/identity/local"
+	"github.com/pomerium/pomerium/ui"
+)
+
+const (
+	localSignInInvalid     = "Invalid username, password or code."
+	localSignInLocked      = "Too many failed sign-in attempts. Try again later."
+	localSignInRateLimited = "Too many sign-in attempts. Try again later."
+)
+
+// localDummyPasswordHash is verified against when a user does not exist, so
+// that the response time does not reveal which users exist.
+var localDummyPasswordHash = sync.OnceValue(func() string {
+	hash, _ := local.HashPassword("")
+	return hash
+})
+
+// localSignIn serves the sign-in form the local identity provider redirects
+// to, and creates a session for the user whose credentials are posted from it.
+func (a *Authenticate) localSignIn(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	state := a.state.Load()
+	options := a.options.Load()
+
+	// state is the same as the one passed to identity providers and includes
+	// a csrf nonce (validated by middleware) and redirect uri
+	redirectURL, err := a.getRedirectURLFromState(r.FormValue("state"))
+	if err != nil {
+		return err
+	}
+
+	idpID := a.getIdentityProviderIDForURLValues(redirectURL.Query())
+	authenticator, err := a.cfg.getIdentityProvider(options, idpID)
+	if err != nil {
+		return err
+	}
+	if _, ok := authenticator.(*local.Provider); !ok {
+		return httputil.NewError(http.StatusNotFound, fmt.Errorf("identity provider does not support local users"))
+	}
+
+	servePage := func(username, errorMessage string) error {
+		m := map[string]any{
+			"selfUrl":  r.URL.String(),
+			"username": username,
+		}
+		if errorMessage != "" {
+			m["error"] = errorMessage
+		}
+		httputil.AddBrandingOptionsToMap(m, options.BrandingOptions)
+		return ui.ServePage(w, r, "LocalSignIn", "Sign In", m)
+	}
+
+	if r.Method == http.MethodGet {
+		return servePage("", "")
+	}
+
+	username := r.FormValue("username")
+	if !a.localSignInLimiter.Allow(httputil.GetClientIPAddress(r), username, time.Now()) {
+		return servePage(username, localSignInRateLimited)
+	}
+
+	lu, errorMessage, err := authenticateLocalUser(ctx, state.dataBrokerClient, &a.localUserLocks,
+		username, r.FormValue("password"), r.FormValue("code"), time.Now())
+	if err != nil {
+		return err
+	} else if errorMessage != "" {
+		return servePage(username, errorMessage)
+	}
+
+	u, err := user.Get(ctx, state.dataBrokerClient, lu.GetId())
+	if status.Code(err) == codes.NotFound {
+		u = &user.User{Id: lu.GetId()}
+	} else if err != nil {
+		return fmt.Errorf("error retrieving user record: %w", err)
+	}
+
+	err = a.saveNewSession(ctx, w, r, idpID, redirectURL, newUserSessionClaims(u), &oauth2.Token{
+		TokenType: local.TokenType,
+	})
+	if err != nil {
+		return err
+	}
+
+	httputil.Redirect(w, r, redirectURL.String(), http.StatusFound)
+	return nil
+}
+
+// authenticateLocalUser verifies the credentials of a local user, and returns
+// the message to show if they are invalid. Attempts for the same user are
+// serialized, within this instance and across instances with a databroker
+// lease, so that concurrent failed attempts are all counted towards the
+// lockout.
+func authenticateLocalUser(
+	ctx context.Context,
+	client databroker.DataBrokerServiceClient,
+	locks *localUserLocks,
+	username, password, code string,
+	now time.Time,
+) (lu *user.LocalUser, errorMessage string, err error) {
+	// unknown users are not locked, there's nothing to update
+	_, err = user.GetLocalUser(ctx, client, username)
+	if status.Code(err) == codes.NotFound {
+		err = withLocalPasswordVerification(ctx, func() {
+			local.VerifyPassword(localDummyPasswordHash(), password)
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return nil, localSignInInvalid, nil
+	} else if err != nil {
+		return nil, "", fmt.Errorf("error retrieving local user: %w", err)
+	}
+
+	lockCtx, cancel := context.WithTimeout(ctx, localUserLockTimeout)
+	defer cancel()
+	unlock, err := locks.lock(lockCtx, username)
+	if err != nil {
+		return nil, "", fmt.Errorf("error locking local user: %w", err)
+	}
+	defer unlock()
+	release, err := acquireLocalUserLease(lockCtx, client, username)
+	if err != nil {
+		return nil, "", fmt.Errorf("error acquiring local user lease: %w", err)
+	}
+	defer release()
+
+	// the user may have changed while waiting for the lock
+	lu, err = user.GetLocalUser(ctx, client, username)
+	if status.Code(err) == codes.NotFound {
+		return nil, localSignInInvalid, nil
+	} else if err != nil {
+		return nil, "", fmt.Errorf("error retrieving local user: %w", err)
+	}
+
+	var changed bool
+	err = withLocalPasswordVerification(ctx, func() {
+		errorMessage, changed = verifyLocalUser(lu, password, code, now)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if changed {
+		if errorMessage == localSignInLocked {
+			log.Ctx(ctx).Info().Str("user_id", lu.GetId()).Msg("authenticate: locking out local user after too many failed sign-in attempts")
+		}
+		if _, err := user.PutLocalUser(ctx, client, lu); err != nil {
+			return nil, "", fmt.Errorf("error saving local user: %w", err)
+		}
+	}
+	return lu, errorMessage, nil
+}
+
+// withLocalPasswordVerification calls fn, which verifies a password, once
+// fewer than maxConcurrentLocalPasswordVerifications are in progress.
+func withLocalPasswordVerification(ctx context.Context, fn func()) error {
+	ctx, cancel := context.WithTimeout(ctx, localPasswordVerificationTimeout)
+	defer cancel()
+	if err := localPasswordVerifications.Acquire(ctx, 1); err != nil {
+		return httputil.NewError(http.StatusServiceUnavailable, fmt.Errorf("too many concurrent sign-in attempts: %w", err))
+	}
+	defer localPasswordVerifications.Release(1)
+
+	fn()
+	return nil
+}
+
+// verifyLocalUser verifies the password and TOTP code of a local user, and
+// returns the message to show if they are invalid. The failed attempts and
+// lockout of the user are updated, and changed reports whether the user needs
+// to be saved.
+func verifyLocalUser(lu *user.LocalUser, password, code string, now time.Time) (errorMessage string, changed bool) {
+	if lu.GetLockedUntil().IsValid() && now.Before(lu.GetLockedUntil().AsTime()) {
+		return localSignInLocked, false
+	}
+
+	valid := local.VerifyPassword(lu.GetPasswordHash(), password)
+	var totpStep int64
+	if lu.TotpSecret != nil {
+		var totpValid bool
+		totpStep, totpValid = local.VerifyTOTP(lu.GetTotpSecret(), code, now, lu.GetTotpLastStep())
+		valid = valid && totpValid
+	}
+	if !valid {
+		lu.FailedAttempts++
+		if lu.FailedAttempts >= local.MaxFailedAttempts {
+			lu.FailedAttempts = 0
+			lu.LockedUntil = timestamppb.New(now.Add(local.LockoutDuration))
+			return localSignInLocked, true
+		}
+		return localSignInInvalid, true
+	}
+
+	changed = lu.FailedAttempts != 0 || lu.LockedUntil != nil
+	lu.FailedAttempts = 0
+	lu.LockedUntil = nil
+	if lu.TotpSecret != nil {
+		// the code can't be used again
+		lu.TotpLastStep = totpStep
+		changed = true
+	}
+	return "", changed
+}
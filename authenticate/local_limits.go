@@ -0,0 +1,70 @@
+package authenticate
+
+import (
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// maxConcurrentLocalPasswordVerifications bounds the memory used by
+	// password hashing, since each argon2id verification allocates the
+	// memory cost of the hash.
+	maxConcurrentLocalPasswordVerifications = 4
+	// localPasswordVerificationTimeout is how long a sign-in attempt waits
+	// for other password verifications to finish.
+	localPasswordVerificationTimeout = 10 * time.Second
+
+	// localSignInRate and localSignInBurst limit the sign-in attempts from
+	// each client IP address and for each username.
+	localSignInRate  = rate.Limit(1.0 / 6) // 10 per minute
+	localSignInBurst = 10
+	// localSignInLimiterSize is the number of IP addresses and usernames
+	// whose attempts are tracked.
+	localSignInLimiterSize = 10_000
+)
+
+var localPasswordVerifications = semaphore.NewWeighted(maxConcurrentLocalPasswordVerifications)
+
+// A localSignInLimiter rate limits local sign-in attempts by client IP address
+// and by username, before any password is verified.
+//
+// Attempts are tracked in memory, so limits are enforced separately by each
+// authenticate instance.
+type localSignInLimiter struct {
+	byIP       *lru.Cache[string, *rate.Limiter]
+	byUsername *lru.Cache[string, *rate.Limiter]
+}
+
+func newLocalSignInLimiter() *localSignInLimiter {
+	// only errors if size <= 0
+	byIP, _ := lru.New[string, *rate.Limiter](localSignInLimiterSize)
+	byUsername, _ := lru.New[string, *rate.Limiter](localSignInLimiterSize)
+	return &localSignInLimiter{
+		byIP:       byIP,
+		byUsername: byUsername,
+	}
+}
+
+// Allow reports whether a sign-in attempt from the IP address for the
+// username is allowed.
+func (l *localSignInLimiter) Allow(ip, username string, now time.Time) bool {
+	// both limits are always charged, so that spreading attempts over
+	// usernames doesn't avoid the IP limit or vice versa
+	ipAllowed := allowLocalSignIn(l.byIP, ip, now)
+	usernameAllowed := allowLocalSignIn(l.byUsername, username, now)
+	return ipAllowed && usernameAllowed
+}
+
+func allowLocalSignIn(c *lru.Cache[string, *rate.Limiter], key string, now time.Time) bool {
+	limiter, ok := c.Get(key)
+	if !ok {
+		limiter = rate.NewLimiter(localSignInRate, localSignInBurst)
+		if prev, ok, _ := c.PeekOrAdd(key, limiter); ok {
+			limiter = prev
+		}
+	}
+	return limiter.AllowN(now, 1)
+}
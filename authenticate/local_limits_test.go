@@ -0,0 +1,45 @@
+package authenticate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalSignInLimiter(t *testing.T) {
+	t.Parallel()
+
+	l := newLocalSignInLimiter()
+	now := time.Now()
+
+	for i := range localSignInBurst {
+		assert.True(t, l.Allow("192.0.2.1", "u1", now), "attempt %d", i)
+	}
+	assert.False(t, l.Allow("192.0.2.1", "u1", now), "username and ip are limited")
+	assert.False(t, l.Allow("192.0.2.1", "u2", now), "ip is limited for any username")
+	assert.False(t, l.Allow("192.0.2.2", "u1", now), "username is limited from any ip")
+	assert.True(t, l.Allow("192.0.2.2", "u2", now))
+
+	// tokens are replenished over time
+	assert.True(t, l.Allow("192.0.2.1", "u1", now.Add(time.Minute)))
+}
+
+func TestWithLocalPasswordVerification(t *testing.T) {
+	// not parallel, since the semaphore is shared by the package
+
+	require.NoError(t, localPasswordVerifications.Acquire(context.Background(), maxConcurrentLocalPasswordVerifications))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	called := false
+	err := withLocalPasswordVerification(ctx, func() { called = true })
+	assert.ErrorContains(t, err, "too many concurrent sign-in attempts")
+	assert.False(t, called)
+	localPasswordVerifications.Release(maxConcurrentLocalPasswordVerifications)
+
+	err = withLocalPasswordVerification(context.Background(), func() { called = true })
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
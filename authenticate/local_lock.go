@@ -0,0 +1,115 @@
+package authenticate
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+const (
+	// localUserLeaseDuration bounds how long a local user stays locked if an
+	// authenticate instance fails to release its lease.
+	localUserLeaseDuration = 30 * time.Second
+	// localUserLockTimeout is how long a sign-in attempt waits for other
+	// attempts for the same user.
+	localUserLockTimeout = 10 * time.Second
+)
+
+// localUserLocks serializes the sign-in attempts for each local user within
+// an authenticate instance.
+type localUserLocks struct {
+	mu    sync.Mutex
+	locks map[string]*localUserLock
+}
+
+type localUserLock struct {
+	ch   chan struct{}
+	refs int
+}
+
+// lock waits for the lock of the user, and returns a function to unlock it.
+func (l *localUserLocks) lock(ctx context.Context, userID string) (unlock func(), err error) {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*localUserLock)
+	}
+	ul, ok := l.locks[userID]
+	if !ok {
+		ul = &localUserLock{ch: make(chan struct{}, 1)}
+		l.locks[userID] = ul
+	}
+	ul.refs++
+	l.mu.Unlock()
+
+	release := func() {
+		l.mu.Lock()
+		ul.refs--
+		if ul.refs == 0 {
+			delete(l.locks, userID)
+		}
+		l.mu.Unlock()
+	}
+
+	select {
+	case ul.ch <- struct{}{}:
+	case <-ctx.Done():
+		release()
+		return nil, ctx.Err()
+	}
+
+	return func() {
+		<-ul.ch
+		release()
+	}, nil
+}
+
+// acquireLocalUserLease waits for the databroker lease of the user, so that
+// sign-in attempts are also serialized across authenticate instances, and
+// returns a function to release it.
+func acquireLocalUserLease(
+	ctx context.Context,
+	client databroker.DataBrokerServiceClient,
+	userID string,
+) (release func(), err error) {
+	leaseName := "pomerium/authenticate/local-user/" + userID
+
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = 10 * time.Millisecond
+	bo.MaxInterval = 500 * time.Millisecond
+	bo.MaxElapsedTime = 0
+
+	for {
+		res, err := client.AcquireLease(ctx, &databroker.AcquireLeaseRequest{
+			Name:     leaseName,
+			Duration: durationpb.New(localUserLeaseDuration),
+		})
+		if err == nil {
+			return func() {
+				_, err := client.ReleaseLease(context.WithoutCancel(ctx), &databroker.ReleaseLeaseRequest{
+					Name: leaseName,
+					Id:   res.GetId(),
+				})
+				if err != nil {
+					log.Ctx(ctx).Error().Err(err).Str("user_id", userID).
+						Msg("authenticate: error releasing local user lease")
+				}
+			}, nil
+		} else if status.Code(err) != codes.AlreadyExists {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(bo.NextBackOff()):
+		}
+	}
+}
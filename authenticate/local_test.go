@@ -0,0 +1,124 @@
+package authenticate
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+
+	"github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/testutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/identity/local"
+)
+
+func TestVerifyLocalUser(t *testing.T) {
+	t.Parallel()
+
+	// bcrypt with the minimum cost keeps the test fast
+	hash, err := bcrypt.GenerateFromPassword([]byte("hunter2"), bcrypt.MinCost)
+	require.NoError(t, err)
+	// test vector from RFC 6238
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	now := time.Unix(1234567890, 0)
+
+	t.Run("password", func(t *testing.T) {
+		lu := &user.LocalUser{Id: "u1", PasswordHash: string(hash), FailedAttempts: 2}
+		msg, changed := verifyLocalUser(lu, "hunter2", "", now)
+		assert.Empty(t, msg)
+		assert.True(t, changed)
+		assert.Zero(t, lu.FailedAttempts)
+
+		msg, changed = verifyLocalUser(lu, "hunter2", "", now)
+		assert.Empty(t, msg)
+		assert.False(t, changed)
+	})
+	t.Run("totp", func(t *testing.T) {
+		lu := &user.LocalUser{Id: "u1", PasswordHash: string(hash), TotpSecret: &secret}
+		msg, changed := verifyLocalUser(lu, "hunter2", "005924", now)
+		assert.Empty(t, msg)
+		assert.True(t, changed)
+		assert.Equal(t, now.Unix()/30, lu.GetTotpLastStep())
+		msg, _ = verifyLocalUser(lu, "hunter2", "005924", now.Add(30*time.Second))
+		assert.Equal(t, localSignInInvalid, msg, "should reject a replayed code")
+		msg, _ = verifyLocalUser(lu, "hunter2", "", now)
+		assert.Equal(t, localSignInInvalid, msg)
+		msg, _ = verifyLocalUser(lu, "hunter3", "005924", now)
+		assert.Equal(t, localSignInInvalid, msg)
+		assert.Equal(t, int32(3), lu.FailedAttempts)
+	})
+	t.Run("lockout", func(t *testing.T) {
+		lu := &user.LocalUser{Id: "u1", PasswordHash: string(hash)}
+		for range local.MaxFailedAttempts - 1 {
+			msg, changed := verifyLocalUser(lu, "hunter3", "", now)
+			assert.Equal(t, localSignInInvalid, msg)
+			assert.True(t, changed)
+		}
+		msg, changed := verifyLocalUser(lu, "hunter3", "", now)
+		assert.Equal(t, localSignInLocked, msg)
+		assert.True(t, changed)
+		assert.True(t, now.Add(local.LockoutDuration).Equal(lu.GetLockedUntil().AsTime()))
+
+		// the correct password is rejected while locked out
+		msg, changed = verifyLocalUser(lu, "hunter2", "", now.Add(time.Minute))
+		assert.Equal(t, localSignInLocked, msg)
+		assert.False(t, changed)
+
+		msg, changed = verifyLocalUser(lu, "hunter2", "", now.Add(local.LockoutDuration))
+		assert.Empty(t, msg)
+		assert.True(t, changed)
+		assert.Nil(t, lu.LockedUntil)
+	})
+}
+
+func TestAuthenticateLocalUserConcurrent(t *testing.T) {
+	t.Parallel()
+
+	ctx, clearTimeout := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(clearTimeout)
+
+	cc := testutil.NewGRPCServer(t, func(srv *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(srv, databroker.New(ctx))
+	})
+	client := databrokerpb.NewDataBrokerServiceClient(cc)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("hunter2"), bcrypt.MinCost)
+	require.NoError(t, err)
+	_, err = user.PutLocalUser(ctx, client, &user.LocalUser{Id: "u1", PasswordHash: string(hash)})
+	require.NoError(t, err)
+
+	// two sets of locks simulate two authenticate instances, which are
+	// serialized by the databroker lease
+	var locks [2]localUserLocks
+	now := time.Now()
+	var wg sync.WaitGroup
+	for i := range local.MaxFailedAttempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, msg, err := authenticateLocalUser(ctx, client, &locks[i%2], "u1", "hunter3", "", now)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, msg)
+		}()
+	}
+	wg.Wait()
+
+	lu, err := user.GetLocalUser(ctx, client, "u1")
+	require.NoError(t, err)
+	assert.True(t, now.Add(local.LockoutDuration).Equal(lu.GetLockedUntil().AsTime()),
+		"expected the user to be locked out after %d concurrent failed attempts", local.MaxFailedAttempts)
+
+	_, msg, err := authenticateLocalUser(ctx, client, &locks[0], "u1", "hunter2", "", now)
+	require.NoError(t, err)
+	assert.Equal(t, localSignInLocked, msg)
+
+	_, msg, err = authenticateLocalUser(ctx, client, &locks[0], "unknown", "hunter2", "", now)
+	require.NoError(t, err)
+	assert.Equal(t, localSignInInvalid, msg)
+}
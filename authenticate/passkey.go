@@ -0,0 +1,183 @@
+package authenticate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/grpc/device"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/identity/passkey"
+	"github.com/pomerium/pomerium/pkg/webauthnutil"
+	"github.com/pomerium/pomerium/ui"
+	"github.com/pomerium/webauthn"
+)
+
+var (
+	errInvalidPasskey = httputil.NewError(http.StatusUnauthorized, errors.New(
+		"passkey is not registered for sign-in"))
+	errMissingEnrollmentToken = httputil.NewError(http.StatusBadRequest, errors.New(
+		urlutil.QueryEnrollmentToken+" is a required parameter"))
+	errInvalidEnrollmentToken = httputil.NewError(http.StatusBadRequest, errors.New(
+		"invalid or expired enrollment link"))
+)
+
+// passkeySignIn serves the passkey sign-in page the passkey identity provider
+// redirects to, and creates a session for the user of the passkey posted from
+// it.
+func (a *Authenticate) passkeySignIn(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	state := a.state.Load()
+	options := a.options.Load()
+
+	// state is the same as the one passed to identity providers and includes
+	// a csrf nonce (validated by middleware) and redirect uri
+	redirectURL, err := a.getRedirectURLFromState(r.FormValue("state"))
+	if err != nil {
+		return err
+	}
+
+	idpID := a.getIdentityProviderIDForURLValues(redirectURL.Query())
+	authenticator, err := a.cfg.getIdentityProvider(options, idpID)
+	if err != nil {
+		return err
+	}
+	if _, ok := authenticator.(*passkey.Provider); !ok {
+		return httputil.NewError(http.StatusNotFound, fmt.Errorf("identity provider does not support passkeys"))
+	}
+
+	deviceType := webauthnutil.GetDeviceType(ctx, state.dataBrokerClient, webauthnutil.PasskeyDeviceType)
+
+	if r.Method == http.MethodGet {
+		// passkeys are discoverable, so any credential of the device type is allowed
+		m := map[string]any{
+			"requestOptions": webauthnutil.GenerateRequestOptions(r, state.sharedKey, deviceType, nil),
+			"selfUrl":        r.URL.String(),
+		}
+		httputil.AddBrandingOptionsToMap(m, options.BrandingOptions)
+		return ui.ServePage(w, r, "PasskeySignIn", "Sign In", m)
+	}
+
+	var credential webauthn.PublicKeyAssertionCredential
+	err = json.Unmarshal([]byte(r.FormValue("authenticate_response")), &credential)
+	if err != nil {
+		return httputil.NewError(http.StatusBadRequest, errors.New("invalid authenticate response"))
+	}
+
+	// the device credential identifies the user signing in
+	deviceCredential, err := device.GetCredential(ctx, state.dataBrokerClient,
+		webauthnutil.GetDeviceCredentialID(credential.RawID))
+	if status.Code(err) == codes.NotFound {
+		return errInvalidPasskey
+	} else if err != nil {
+		return httputil.NewError(http.StatusInternalServerError,
+			fmt.Errorf("error retrieving device credential: %w", err))
+	}
+	if deviceCredential.GetTypeId() != deviceType.GetId() {
+		return errInvalidPasskey
+	}
+
+	u, err := user.Get(ctx, state.dataBrokerClient, deviceCredential.GetUserId())
+	if err != nil {
+		return fmt.Errorf("error retrieving user record: %w", err)
+	}
+	if !u.HasDeviceCredentialID(deviceCredential.GetId()) {
+		return errInvalidPasskey
+	}
+
+	// the authenticator returns the user handle for discoverable credentials,
+	// which is verified to be the owner of the credential
+	if len(credential.Response.UserHandle) == 0 {
+		credential.Response.UserHandle = webauthnutil.GetUserEntityID(u.GetId())
+	}
+
+	requestOptions, err := webauthnutil.GetRequestOptionsForCredential(r, state.sharedKey, deviceType, nil, &credential)
+	if err != nil {
+		return httputil.NewError(http.StatusBadRequest, fmt.Errorf("invalid authenticate options: %w", err))
+	}
+
+	_, err = webauthnutil.GetRelyingParty(r, state.dataBrokerClient).VerifyAuthenticationCeremony(ctx, requestOptions, &credential)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, fmt.Errorf("error verifying passkey: %w", err))
+	}
+
+	err = a.saveNewSession(ctx, w, r, idpID, redirectURL, newUserSessionClaims(u), &oauth2.Token{
+		TokenType: passkey.TokenType,
+	})
+	if err != nil {
+		return err
+	}
+
+	httputil.Redirect(w, r, redirectURL.String(), http.StatusFound)
+	return nil
+}
+
+// passkeyEnroll serves the page for the one-time passkey enrollment links
+// issued by administrators, and registers the passkey posted from it.
+func (a *Authenticate) passkeyEnroll(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	state := a.state.Load()
+	options := a.options.Load()
+
+	enrollmentToken := r.FormValue(urlutil.QueryEnrollmentToken)
+	if enrollmentToken == "" {
+		return errMissingEnrollmentToken
+	}
+	enrollmentID, err := webauthnutil.ParseAndVerifyEnrollmentToken(state.sharedKey, enrollmentToken)
+	if err != nil {
+		return errInvalidEnrollmentToken
+	}
+
+	enrollment, err := device.GetEnrollment(ctx, state.dataBrokerClient, enrollmentID)
+	if status.Code(err) == codes.NotFound {
+		return errInvalidEnrollmentToken
+	} else if err != nil {
+		return fmt.Errorf("error retrieving device enrollment: %w", err)
+	}
+	if enrollment.GetTypeId() != webauthnutil.PasskeyDeviceType {
+		return errInvalidEnrollmentToken
+	}
+	if enrollment.GetEnrolledAt().IsValid() {
+		return httputil.NewError(http.StatusForbidden, errors.New("enrollment link has already been used"))
+	}
+
+	u, err := user.Get(ctx, state.dataBrokerClient, enrollment.GetUserId())
+	if err != nil {
+		return fmt.Errorf("error retrieving user record: %w", err)
+	}
+
+	deviceType := webauthnutil.GetDeviceType(ctx, state.dataBrokerClient, enrollment.GetTypeId())
+
+	if r.Method == http.MethodGet {
+		m := map[string]any{
+			"creationOptions": webauthnutil.GenerateCreationOptions(r, state.sharedKey, deviceType, u),
+			"selfUrl":         r.URL.String(),
+			"user":            webauthnutil.GetUserEntity(u).Name,
+		}
+		httputil.AddBrandingOptionsToMap(m, options.BrandingOptions)
+		return ui.ServePage(w, r, "PasskeyEnroll", "Passkey Enrollment", m)
+	}
+
+	var credential webauthn.PublicKeyCreationCredential
+	err = json.Unmarshal([]byte(r.FormValue("register_response")), &credential)
+	if err != nil {
+		return httputil.NewError(http.StatusBadRequest, errors.New("invalid register response"))
+	}
+
+	_, err = webauthnutil.RegisterDeviceCredential(ctx, r, state.dataBrokerClient,
+		webauthnutil.GetRelyingParty(r, state.dataBrokerClient), state.sharedKey, deviceType, u, &credential)
+	if err != nil {
+		return err
+	}
+
+	// sign in with the new passkey
+	httputil.Redirect(w, r, "/.pomerium/", http.StatusFound)
+	return nil
+}
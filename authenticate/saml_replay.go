@@ -0,0 +1,35 @@
+package authenticate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// samlAssertionReplayCache records the consumed SAML assertions as databroker
+// leases, so that an assertion is only accepted once across authenticate
+// instances. The leases are never released and expire with the assertion.
+type samlAssertionReplayCache struct {
+	client databroker.DataBrokerServiceClient
+}
+
+func (c samlAssertionReplayCache) Consume(ctx context.Context, key string, expiresAt time.Time) (bool, error) {
+	h := sha256.Sum256([]byte(key))
+	_, err := c.client.AcquireLease(ctx, &databroker.AcquireLeaseRequest{
+		Name:     "pomerium/authenticate/saml-assertion/" + hex.EncodeToString(h[:]),
+		Duration: durationpb.New(max(time.Until(expiresAt), time.Second)),
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,39 @@
+package authenticate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/testutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+func TestSAMLAssertionReplayCache(t *testing.T) {
+	t.Parallel()
+
+	ctx, clearTimeout := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(clearTimeout)
+
+	cc := testutil.NewGRPCServer(t, func(srv *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(srv, databroker.New(ctx))
+	})
+	cache := samlAssertionReplayCache{client: databrokerpb.NewDataBrokerServiceClient(cc)}
+
+	ok, err := cache.Consume(ctx, "https://idp.example.com\x00ID1", time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = cache.Consume(ctx, "https://idp.example.com\x00ID1", time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, ok, "should reject a consumed assertion")
+
+	ok, err = cache.Consume(ctx, "https://idp.example.com\x00ID2", time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
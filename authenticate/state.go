@@ -15,13 +15,18 @@ import (
 	"github.com/pomerium/pomerium/internal/encoding"
 	"github.com/pomerium/pomerium/internal/encoding/jws"
 	"github.com/pomerium/pomerium/internal/handlers"
+	"github.com/pomerium/pomerium/internal/scim"
 	"github.com/pomerium/pomerium/internal/sessions"
 	"github.com/pomerium/pomerium/internal/sessions/cookie"
 	"github.com/pomerium/pomerium/internal/urlutil"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 	"github.com/pomerium/pomerium/pkg/identity"
 )
 
+var outboundGRPCConnection = new(grpc.CachedOutboundGRPClientConn)
+
 type flow interface {
 	VerifyAuthenticateSignature(r *http.Request) error
 	SignIn(w http.ResponseWriter, r *http.Request, sessionState *sessions.State) error
@@ -31,6 +36,8 @@ type flow interface {
 	GetUserInfoData(r *http.Request, sessionState *sessions.State) handlers.UserInfoData
 	LogAuthenticateEvent(r *http.Request)
 	GetIdentityProviderIDForURLValues(url.Values) string
+	GetSignInRequestParams(url.Values) url.Values
+	GetPKCEVerifier(state string) string
 }
 
 type authenticateState struct {
@@ -55,6 +62,11 @@ type authenticateState struct {
 	sessionLoader sessions.SessionLoader
 
 	jwk *jose.JSONWebKeySet
+
+	dataBrokerClient databroker.DataBrokerServiceClient
+
+	// scim serves the SCIM provisioning endpoints, or is nil if disabled
+	scim http.Handler
 }
 
 func newAuthenticateState() *authenticateState {
@@ -160,5 +172,24 @@ func newAuthenticateStateFromConfig(
 		return nil, err
 	}
 
+	dataBrokerConn, err := outboundGRPCConnection.Get(ctx, &grpc.OutboundOptions{
+		OutboundPort:   cfg.OutboundPort,
+		InstallationID: cfg.Options.InstallationID,
+		ServiceName:    cfg.Options.Services,
+		SignedJWTKey:   state.sharedKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	state.dataBrokerClient = databroker.NewDataBrokerServiceClient(dataBrokerConn)
+
+	scimBearerToken, err := cfg.Options.GetSCIMBearerToken()
+	if err != nil {
+		return nil, err
+	}
+	if scimBearerToken != "" {
+		state.scim = scim.New(state.dataBrokerClient, scimBearerToken)
+	}
+
 	return state, nil
 }
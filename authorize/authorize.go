@@ -13,9 +13,11 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/authorize/internal/ratelimit"
 	"github.com/pomerium/pomerium/authorize/internal/store"
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/atomicutil"
+	"github.com/pomerium/pomerium/internal/auditlog"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/telemetry/metrics"
 	"github.com/pomerium/pomerium/internal/telemetry/trace"
@@ -25,12 +27,18 @@ import (
 	"github.com/pomerium/pomerium/pkg/storage"
 )
 
+// auditLogCloseTimeout bounds how long shutdown waits for the audit sinks to
+// send their buffered entries.
+const auditLogCloseTimeout = 5 * time.Second
+
 // Authorize struct holds
 type Authorize struct {
 	state          *atomicutil.Value[*authorizeState]
 	store          *store.Store
 	currentOptions *atomicutil.Value[*config.Options]
 	accessTracker  *AccessTracker
+	rateLimiter    *ratelimit.Limiter
+	auditLogger    *auditlog.Logger
 	globalCache    storage.Cache
 
 	// The stateLock prevents updating the evaluator store simultaneously with an evaluation.
@@ -44,6 +52,8 @@ func New(ctx context.Context, cfg *config.Config) (*Authorize, error) {
 	a := &Authorize{
 		currentOptions: config.NewAtomicOptions(),
 		store:          store.New(),
+		rateLimiter:    ratelimit.New(),
+		auditLogger:    auditlog.NewLogger(),
 		globalCache:    storage.NewGlobalCache(time.Minute),
 	}
 	a.accessTracker = NewAccessTracker(a, accessTrackerMaxSize, accessTrackerDebouncePeriod)
@@ -73,6 +83,13 @@ func (a *Authorize) Run(ctx context.Context) error {
 		_ = grpc.WaitForReady(ctx, a.state.Load().dataBrokerClientConnection, time.Second*10)
 		return nil
 	})
+	eg.Go(func() error {
+		<-ctx.Done()
+		// flush the audit sinks without delaying shutdown for unavailable sinks
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), auditLogCloseTimeout)
+		defer cancel()
+		return a.auditLogger.Close(ctx)
+	})
 	return eg.Wait()
 }
 
@@ -136,6 +153,7 @@ func newPolicyEvaluator(
 	allPolicies := slices.Collect(opts.GetAllPolicies())
 	return evaluator.New(ctx, store, previous,
 		evaluator.WithPolicies(allPolicies),
+		evaluator.WithImpersonationPolicy(opts.Impersonation.GetPolicy()),
 		evaluator.WithClientCA(clientCA),
 		evaluator.WithAddDefaultClientCertificateRule(addDefaultClientCertificateRule),
 		evaluator.WithClientCRL(clientCRL),
@@ -144,6 +162,7 @@ func newPolicyEvaluator(
 		evaluator.WithAuthenticateURL(authenticateURL.String()),
 		evaluator.WithGoogleCloudServerlessAuthenticationServiceAccount(opts.GetGoogleCloudServerlessAuthenticationServiceAccount()),
 		evaluator.WithJWTClaimsHeaders(opts.JWTClaimsHeaders),
+		evaluator.WithGeoIPDatabaseFile(opts.GeoIPDatabaseFile),
 	)
 }
 
@@ -156,4 +175,7 @@ func (a *Authorize) OnConfigChange(ctx context.Context, cfg *config.Config) {
 	} else {
 		a.state.Store(state)
 	}
+	if err := a.auditLogger.OnConfigChange(ctx, cfg); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("authorize: error updating audit sinks")
+	}
 }
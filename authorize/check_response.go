@@ -47,6 +47,14 @@ func (a *Authorize) handleResult(
 		return a.requireLoginResponse(ctx, in, request)
 	}
 
+	// when the user needs to log in again, for example because their login
+	// is too old for the route, redirect to authenticate and ask the identity
+	// provider to re-authenticate them
+	if result.Allow.Reasons.Has(criteria.ReasonUserReauthenticate) ||
+		result.Deny.Reasons.Has(criteria.ReasonUserReauthenticate) {
+		return a.requireReauthenticationResponse(ctx, in, request, result)
+	}
+
 	// when the user's device is unauthenticated it means they haven't
 	// registered a webauthn device yet, so redirect to the webauthn flow
 	if result.Allow.Reasons.Has(criteria.ReasonDeviceUnauthenticated) ||
@@ -61,7 +69,7 @@ func (a *Authorize) handleResult(
 
 	// if there's an allow, the result is allowed.
 	if result.Allow.Value {
-		return a.handleResultAllowed(ctx, in, result)
+		return a.handleResultAllowed(ctx, in, request, result)
 	}
 
 	// otherwise, the result is denied using the allow reasons.
@@ -71,9 +79,30 @@ func (a *Authorize) handleResult(
 func (a *Authorize) handleResultAllowed(
 	_ context.Context,
 	_ *envoy_service_auth_v3.CheckRequest,
+	request *evaluator.Request,
 	result *evaluator.Result,
 ) (*envoy_service_auth_v3.CheckResponse, error) {
-	return a.okResponse(result.Headers), nil
+	res := a.okResponse(result.Headers)
+	if request.IsInternal {
+		setClientCertificateHeader(res, request.HTTP.ClientCertificate)
+	}
+	return res, nil
+}
+
+// setClientCertificateHeader passes the client certificate to Pomerium's own
+// endpoints. A client certificate header sent by the client is always removed.
+func setClientCertificateHeader(
+	res *envoy_service_auth_v3.CheckResponse,
+	clientCertificate evaluator.ClientCertificateInfo,
+) {
+	okResponse := res.GetOkResponse()
+	if clientCertificate.Leaf == "" {
+		okResponse.HeadersToRemove = append(okResponse.HeadersToRemove, httputil.HeaderPomeriumClientCertificate)
+		return
+	}
+	okResponse.Headers = append(okResponse.Headers,
+		mkHeader(httputil.HeaderPomeriumClientCertificate,
+			url.QueryEscape(clientCertificate.Leaf+clientCertificate.Intermediates)))
 }
 
 func (a *Authorize) handleResultDenied(
@@ -237,6 +266,34 @@ func (a *Authorize) requireLoginResponse(
 	ctx context.Context,
 	in *envoy_service_auth_v3.CheckRequest,
 	request *evaluator.Request,
+) (*envoy_service_auth_v3.CheckResponse, error) {
+	return a.signInRedirectResponse(ctx, in, request, nil)
+}
+
+func (a *Authorize) requireReauthenticationResponse(
+	ctx context.Context,
+	in *envoy_service_auth_v3.CheckRequest,
+	request *evaluator.Request,
+	result *evaluator.Result,
+) (*envoy_service_auth_v3.CheckResponse, error) {
+	q := url.Values{}
+	q.Set(urlutil.QueryPrompt, "login")
+	for _, data := range []map[string]any{result.Allow.AdditionalData, result.Deny.AdditionalData} {
+		if maxAge, ok := data["max_age"].(string); ok {
+			q.Set(urlutil.QueryMaxAge, maxAge)
+		}
+		if acrValues, ok := data["acr_values"].(string); ok {
+			q.Set(urlutil.QueryACRValues, acrValues)
+		}
+	}
+	return a.signInRedirectResponse(ctx, in, request, q)
+}
+
+func (a *Authorize) signInRedirectResponse(
+	ctx context.Context,
+	in *envoy_service_auth_v3.CheckRequest,
+	request *evaluator.Request,
+	queryParams url.Values,
 ) (*envoy_service_auth_v3.CheckResponse, error) {
 	options := a.currentOptions.Load()
 	state := a.state.Load()
@@ -255,7 +312,7 @@ func (a *Authorize) requireLoginResponse(
 	checkRequestURL.Scheme = "https"
 
 	redirectTo, err := state.authenticateFlow.AuthenticateSignInURL(
-		ctx, nil, &checkRequestURL, idp.GetId())
+		ctx, queryParams, &checkRequestURL, idp.GetId())
 	if err != nil {
 		return nil, err
 	}
@@ -280,7 +337,9 @@ func (a *Authorize) requireWebAuthnResponse(
 
 	// If we're already on a webauthn route, return OK.
 	// https://github.com/pomerium/pomerium-console/issues/3210
-	if checkRequestURL.Path == urlutil.WebAuthnURLPath || checkRequestURL.Path == urlutil.DeviceEnrolledPath {
+	if checkRequestURL.Path == urlutil.WebAuthnURLPath ||
+		checkRequestURL.Path == urlutil.ClientCertificateURLPath ||
+		checkRequestURL.Path == urlutil.DeviceEnrolledPath {
 		return a.okResponse(result.Headers), nil
 	}
 
@@ -288,21 +347,28 @@ func (a *Authorize) requireWebAuthnResponse(
 		return a.deniedResponse(ctx, in, http.StatusUnauthorized, http.StatusText(http.StatusUnauthorized), nil)
 	}
 
-	q := url.Values{}
+	deviceTypeID := webauthnutil.DefaultDeviceType
 	if deviceType, ok := result.Allow.AdditionalData["device_type"].(string); ok {
-		q.Set(urlutil.QueryDeviceType, deviceType)
+		deviceTypeID = deviceType
 	} else if deviceType, ok := result.Deny.AdditionalData["device_type"].(string); ok {
-		q.Set(urlutil.QueryDeviceType, deviceType)
-	} else {
-		q.Set(urlutil.QueryDeviceType, webauthnutil.DefaultDeviceType)
+		deviceTypeID = deviceType
 	}
+
+	q := url.Values{}
+	q.Set(urlutil.QueryDeviceType, deviceTypeID)
 	q.Set(urlutil.QueryRedirectURI, checkRequestURL.String())
 	idp, err := opts.GetIdentityProviderForPolicy(request.Policy)
 	if err != nil {
 		return nil, err
 	}
 	q.Set(urlutil.QueryIdentityProviderID, idp.GetId())
-	signinURL := urlutil.WebAuthnURL(getHTTPRequestFromCheckRequest(in), &checkRequestURL, state.sharedKey, q)
+
+	// client certificate devices are enrolled using their own flow
+	deviceURL := urlutil.WebAuthnURL
+	if webauthnutil.GetDeviceType(ctx, state.dataBrokerClient, deviceTypeID).GetClientCertificate() != nil {
+		deviceURL = urlutil.ClientCertificateURL
+	}
+	signinURL := deviceURL(getHTTPRequestFromCheckRequest(in), &checkRequestURL, state.sharedKey, q)
 	return a.deniedResponse(ctx, in, http.StatusFound, "Login", map[string]string{
 		"Location": signinURL,
 	})
@@ -7,6 +7,7 @@ import (
 
 type evaluatorConfig struct {
 	Policies                                          []*config.Policy `hash:"-"`
+	ImpersonationPolicy                               *config.Policy   `hash:"-"`
 	ClientCA                                          []byte
 	ClientCRL                                         []byte
 	AddDefaultClientCertificateRule                   bool
@@ -15,6 +16,7 @@ type evaluatorConfig struct {
 	AuthenticateURL                                   string
 	GoogleCloudServerlessAuthenticationServiceAccount string
 	JWTClaimsHeaders                                  config.JWTClaimHeaders
+	GeoIPDatabaseFile                                 string
 }
 
 // cacheKey() returns a hash over the configuration, except for the policies.
@@ -40,6 +42,14 @@ func WithPolicies(policies []*config.Policy) Option {
 	}
 }
 
+// WithImpersonationPolicy sets the policy used to authorize starting an
+// impersonation in the config.
+func WithImpersonationPolicy(policy *config.Policy) Option {
+	return func(cfg *evaluatorConfig) {
+		cfg.ImpersonationPolicy = policy
+	}
+}
+
 // WithClientCA sets the client CA in the config.
 func WithClientCA(clientCA []byte) Option {
 	return func(cfg *evaluatorConfig) {
@@ -97,3 +107,10 @@ func WithJWTClaimsHeaders(headers config.JWTClaimHeaders) Option {
 		cfg.JWTClaimsHeaders = headers
 	}
 }
+
+// WithGeoIPDatabaseFile sets the GeoIP database file in the config.
+func WithGeoIPDatabaseFile(geoIPDatabaseFile string) Option {
+	return func(cfg *evaluatorConfig) {
+		cfg.GeoIPDatabaseFile = geoIPDatabaseFile
+	}
+}
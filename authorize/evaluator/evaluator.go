@@ -17,6 +17,7 @@ import (
 	"github.com/pomerium/pomerium/authorize/internal/store"
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/errgrouputil"
+	"github.com/pomerium/pomerium/internal/geoip"
 	"github.com/pomerium/pomerium/internal/httputil"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/telemetry/trace"
@@ -75,6 +76,9 @@ type ClientCertificateInfo struct {
 	// Intermediates contains the remainder of the client certificate chain as
 	// it was originally presented by the client (unvalidated).
 	Intermediates string `json:"intermediates,omitempty"`
+
+	// Fingerprint is the hex-encoded SHA-256 of the leaf client certificate.
+	Fingerprint string `json:"fingerprint,omitempty"`
 }
 
 // RequestSession is the session field in the request.
@@ -94,10 +98,12 @@ type Result struct {
 type Evaluator struct {
 	store                 *store.Store
 	policyEvaluators      map[uint64]*PolicyEvaluator
+	impersonationPolicy   *PolicyEvaluator
 	headersEvaluators     *HeadersEvaluator
 	clientCA              []byte
 	clientCRL             []byte
 	clientCertConstraints ClientCertConstraints
+	geoIP                 *geoip.Database
 
 	cfgCacheKey uint64
 }
@@ -125,17 +131,36 @@ func New(
 	// can reuse the HeadersEvaluator along with any PolicyEvaluators for
 	// unchanged policies.
 	var cachedPolicyEvaluators map[uint64]*PolicyEvaluator
+	var cachedImpersonationPolicy *PolicyEvaluator
 	if previous != nil && previous.cfgCacheKey == e.cfgCacheKey {
 		e.headersEvaluators = previous.headersEvaluators
+		e.geoIP = previous.geoIP
 		cachedPolicyEvaluators = previous.policyEvaluators
+		cachedImpersonationPolicy = previous.impersonationPolicy
 	} else {
 		e.headersEvaluators = NewHeadersEvaluator(store)
+		if cfg.GeoIPDatabaseFile != "" {
+			e.geoIP, err = geoip.Open(cfg.GeoIPDatabaseFile)
+			if err != nil {
+				return nil, fmt.Errorf("authorize: error opening geoip database: %w", err)
+			}
+		}
 	}
 	e.policyEvaluators, err = getOrCreatePolicyEvaluators(ctx, cfg, store, cachedPolicyEvaluators)
 	if err != nil {
 		return nil, err
 	}
 
+	if p := cfg.ImpersonationPolicy; p != nil && cachedImpersonationPolicy != nil &&
+		cachedImpersonationPolicy.policyChecksum == p.Checksum() {
+		e.impersonationPolicy = cachedImpersonationPolicy
+	} else if p != nil {
+		e.impersonationPolicy, err = NewPolicyEvaluator(ctx, store, p, false)
+		if err != nil {
+			return nil, fmt.Errorf("authorize: error building impersonation policy evaluator: %w", err)
+		}
+	}
+
 	return e, nil
 }
 
@@ -236,14 +261,22 @@ func (e *Evaluator) Evaluate(ctx context.Context, req *Request) (*Result, error)
 	return res, nil
 }
 
+// ImpersonatePath is the internal endpoint used to start an impersonation.
+// Only POST requests start an impersonation, other requests just show the
+// impersonation page.
+const ImpersonatePath = "/.pomerium/impersonate"
+
 // Internal endpoints that require a logged-in user.
 var internalPathsNeedingLogin = set.From([]string{
+	"/.pomerium/client-certificate",
+	"/.pomerium/impersonate",
+	"/.pomerium/impersonate/stop",
 	"/.pomerium/jwt",
 	"/.pomerium/user",
 	"/.pomerium/webauthn",
 })
 
-func (e *Evaluator) evaluateInternal(_ context.Context, req *Request) (*PolicyResponse, error) {
+func (e *Evaluator) evaluateInternal(ctx context.Context, req *Request) (*PolicyResponse, error) {
 	if internalPathsNeedingLogin.Contains(req.HTTP.Path) {
 		if req.Session.ID == "" {
 			return &PolicyResponse{
@@ -252,6 +285,25 @@ func (e *Evaluator) evaluateInternal(_ context.Context, req *Request) (*PolicyRe
 		}
 	}
 
+	// starting an impersonation requires the operator to satisfy the
+	// impersonation policy
+	if req.HTTP.Path == ImpersonatePath {
+		if e.impersonationPolicy == nil {
+			return &PolicyResponse{
+				Deny: NewRuleResult(true, criteria.ReasonRouteNotFound),
+			}, nil
+		}
+		if req.HTTP.Method != http.MethodPost {
+			return &PolicyResponse{
+				Allow: NewRuleResult(true, criteria.ReasonPomeriumRoute),
+			}, nil
+		}
+		return e.impersonationPolicy.Evaluate(ctx, &PolicyRequest{
+			HTTP:    req.HTTP,
+			Session: req.Session,
+		})
+	}
+
 	return &PolicyResponse{
 		Allow: NewRuleResult(true, criteria.ReasonPomeriumRoute),
 	}, nil
@@ -291,9 +343,23 @@ func (e *Evaluator) evaluatePolicy(ctx context.Context, req *Request) (*PolicyRe
 		HTTP:                     req.HTTP,
 		Session:                  req.Session,
 		IsValidClientCertificate: isValidClientCertificate,
+		Country:                  e.getCountry(ctx, req.HTTP.IP),
 	})
 }
 
+func (e *Evaluator) getCountry(ctx context.Context, ip string) string {
+	if e.geoIP == nil || ip == "" {
+		return ""
+	}
+
+	country, err := e.geoIP.LookupCountry(ip)
+	if err != nil {
+		log.Ctx(ctx).Debug().Err(err).Str("ip", ip).Msg("authorize: error looking up country")
+		return ""
+	}
+	return country
+}
+
 func (e *Evaluator) evaluateHeaders(ctx context.Context, req *Request) (*HeadersResponse, error) {
 	headersReq, err := NewHeadersRequestFromPolicy(req.Policy, req.HTTP)
 	if err != nil {
@@ -552,6 +552,46 @@ func TestEvaluator(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(t, res.Allow.Value)
 	})
+	t.Run("country", func(t *testing.T) {
+		p := &config.Policy{
+			To: config.WeightedURLs{{URL: *mustParseURL("https://to13.example.com")}},
+			Policy: &config.PPLPolicy{
+				Policy: &parser.Policy{
+					Rules: []parser.Rule{{
+						Action: parser.ActionAllow,
+						Or: []parser.Criterion{{
+							Name: "country", Data: parser.String("GB"),
+						}},
+					}},
+				},
+			},
+		}
+		options := []Option{
+			WithPolicies([]*config.Policy{p}),
+			WithGeoIPDatabaseFile("../../internal/geoip/testdata/country.mmdb"),
+		}
+		for _, tc := range []struct {
+			ip     string
+			allow  bool
+			reason criteria.Reason
+		}{
+			{"81.2.69.142", true, criteria.ReasonCountryOK},
+			{"127.0.0.1", false, criteria.ReasonCountryUnauthorized},
+		} {
+			res, err := eval(t, options, []proto.Message{}, &Request{
+				Policy: p,
+				HTTP: NewRequestHTTP(
+					http.MethodGet,
+					*mustParseURL("https://from.example.com/"),
+					nil,
+					ClientCertificateInfo{},
+					tc.ip,
+				),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, NewRuleResult(tc.allow, tc.reason), res.Allow, tc.ip)
+		}
+	})
 }
 
 func TestEvaluator_EvaluateInternal(t *testing.T) {
@@ -586,6 +626,7 @@ func TestEvaluator_EvaluateInternal(t *testing.T) {
 
 	// Internal paths that do require login.
 	for _, path := range []string{
+		"/.pomerium/impersonate/stop",
 		"/.pomerium/jwt",
 		"/.pomerium/user",
 		"/.pomerium/webauthn",
@@ -620,6 +661,66 @@ func TestEvaluator_EvaluateInternal(t *testing.T) {
 	}
 }
 
+func TestEvaluator_EvaluateImpersonate(t *testing.T) {
+	ctx := storage.WithQuerier(context.Background(), storage.NewStaticQuerier(
+		&session.Session{Id: "admin-session", UserId: "admin"},
+		&session.Session{Id: "user-session", UserId: "user"},
+	))
+
+	evaluate := func(t *testing.T, options []Option, method, sessionID string) *Result {
+		t.Helper()
+		e, err := New(ctx, store.New(), nil, options...)
+		require.NoError(t, err)
+		res, err := e.Evaluate(ctx, &Request{
+			IsInternal: true,
+			HTTP:       RequestHTTP{Method: method, Path: ImpersonatePath},
+			Session:    RequestSession{ID: sessionID},
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		res := evaluate(t, nil, http.MethodPost, "admin-session")
+		assert.True(t, res.Deny.Value)
+		assert.Equal(t, criteria.NewReasons(criteria.ReasonRouteNotFound), res.Deny.Reasons)
+	})
+
+	options := []Option{WithImpersonationPolicy(&config.Policy{
+		Policy: &config.PPLPolicy{
+			Policy: &parser.Policy{
+				Rules: []parser.Rule{{
+					Action: parser.ActionAllow,
+					Or: []parser.Criterion{{
+						Name: "user", Data: parser.Object{
+							"is": parser.String("admin"),
+						},
+					}},
+				}},
+			},
+		},
+	})}
+	t.Run("allowed", func(t *testing.T) {
+		res := evaluate(t, options, http.MethodPost, "admin-session")
+		assert.True(t, res.Allow.Value)
+		assert.False(t, res.Deny.Value)
+	})
+	t.Run("denied", func(t *testing.T) {
+		res := evaluate(t, options, http.MethodPost, "user-session")
+		assert.False(t, res.Allow.Value)
+	})
+	t.Run("page", func(t *testing.T) {
+		res := evaluate(t, options, http.MethodGet, "user-session")
+		assert.True(t, res.Allow.Value)
+		assert.Equal(t, criteria.NewReasons(criteria.ReasonPomeriumRoute), res.Allow.Reasons)
+	})
+	t.Run("unauthenticated", func(t *testing.T) {
+		res := evaluate(t, options, http.MethodGet, "")
+		assert.False(t, res.Allow.Value)
+		assert.Equal(t, criteria.NewReasons(criteria.ReasonUserUnauthenticated), res.Allow.Reasons)
+	})
+}
+
 func TestPolicyEvaluatorReuse(t *testing.T) {
 	ctx := context.Background()
 
@@ -708,6 +809,9 @@ func TestPolicyEvaluatorReuse(t *testing.T) {
 	t.Run("JWTClaimsHeaders changed", func(t *testing.T) {
 		assertNoneReused(t, WithJWTClaimsHeaders(config.JWTClaimHeaders{"dummy": "header"}))
 	})
+	t.Run("GeoIPDatabaseFile changed", func(t *testing.T) {
+		assertNoneReused(t, WithGeoIPDatabaseFile("../../internal/geoip/testdata/country.mmdb"))
+	})
 
 	// If some policies have changed, but the evaluatorConfig is otherwise
 	// identical, only evaluators for the changed policies should be updated.
@@ -18,6 +18,7 @@ import (
 type HeadersRequest struct {
 	EnableGoogleCloudServerlessAuthentication bool                  `json:"enable_google_cloud_serverless_authentication"`
 	EnableRoutingKey                          bool                  `json:"enable_routing_key"`
+	RoutingKeyByUser                          bool                  `json:"routing_key_by_user"`
 	Issuer                                    string                `json:"issuer"`
 	Audience                                  string                `json:"audience"`
 	KubernetesServiceAccountToken             string                `json:"kubernetes_service_account_token"`
@@ -46,7 +47,9 @@ func NewHeadersRequestFromPolicy(policy *config.Policy, http RequestHTTP) (*Head
 	if policy != nil {
 		input.EnableGoogleCloudServerlessAuthentication = policy.EnableGoogleCloudServerlessAuthentication
 		input.EnableRoutingKey = policy.EnvoyOpts.GetLbPolicy() == envoy_config_cluster_v3.Cluster_RING_HASH ||
-			policy.EnvoyOpts.GetLbPolicy() == envoy_config_cluster_v3.Cluster_MAGLEV
+			policy.EnvoyOpts.GetLbPolicy() == envoy_config_cluster_v3.Cluster_MAGLEV ||
+			policy.LoadBalancingPolicy.UsesRoutingKey()
+		input.RoutingKeyByUser = policy.LoadBalancingPolicy.GetType() == config.LoadBalancingPolicyTypeUser
 		var err error
 		input.KubernetesServiceAccountToken, err = policy.GetKubernetesServiceAccountToken()
 		if err != nil {
@@ -109,10 +109,19 @@ func (e *headersEvaluatorEvaluation) fillGoogleCloudServerlessHeaders(ctx contex
 	}
 }
 
-func (e *headersEvaluatorEvaluation) fillRoutingKeyHeaders() {
-	if e.request.EnableRoutingKey {
-		e.response.Headers.Add("x-pomerium-routing-key", cryptoSHA256(e.request.Session.ID))
+func (e *headersEvaluatorEvaluation) fillRoutingKeyHeaders(ctx context.Context) {
+	if !e.request.EnableRoutingKey {
+		return
 	}
+
+	routingKey := e.request.Session.ID
+	// route all of a user's sessions to the same upstream
+	if e.request.RoutingKeyByUser {
+		if userID := e.getUserID(ctx); userID != "" {
+			routingKey = userID
+		}
+	}
+	e.response.Headers.Add("x-pomerium-routing-key", cryptoSHA256(routingKey))
 }
 
 func (e *headersEvaluatorEvaluation) fillSetRequestHeaders(ctx context.Context) {
@@ -143,7 +152,7 @@ func (e *headersEvaluatorEvaluation) fillHeaders(ctx context.Context) {
 	e.fillJWTClaimHeaders(ctx)
 	e.fillKubernetesHeaders(ctx)
 	e.fillGoogleCloudServerlessHeaders(ctx)
-	e.fillRoutingKeyHeaders()
+	e.fillRoutingKeyHeaders(ctx)
 	e.fillSetRequestHeaders(ctx)
 }
 
@@ -166,6 +175,14 @@ func (e *headersEvaluatorEvaluation) getSessionOrServiceAccount(ctx context.Cont
 	return e.cachedSession, e.cachedServiceAccount
 }
 
+func (e *headersEvaluatorEvaluation) getUserID(ctx context.Context) string {
+	s, sa := e.getSessionOrServiceAccount(ctx)
+	if sa != nil {
+		return sa.GetUserId()
+	}
+	return s.GetUserId()
+}
+
 func (e *headersEvaluatorEvaluation) getUser(ctx context.Context) *user.User {
 	if e.gotUser {
 		return e.cachedUser
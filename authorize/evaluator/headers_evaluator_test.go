@@ -113,6 +113,28 @@ func TestNewHeadersRequestFromPolicy(t *testing.T) {
 	}, req)
 }
 
+func TestNewHeadersRequestFromPolicy_LoadBalancingPolicy(t *testing.T) {
+	for _, tc := range []struct {
+		lbp              *config.LoadBalancingPolicy
+		enableRoutingKey bool
+		routingKeyByUser bool
+	}{
+		{nil, false, false},
+		{&config.LoadBalancingPolicy{Type: config.LoadBalancingPolicyTypeUser}, true, true},
+		{&config.LoadBalancingPolicy{Type: config.LoadBalancingPolicyTypeSession}, true, false},
+		{&config.LoadBalancingPolicy{Type: config.LoadBalancingPolicyTypeCookie}, false, false},
+		{&config.LoadBalancingPolicy{Type: config.LoadBalancingPolicyTypeHeader, HeaderName: "x-tenant"}, false, false},
+	} {
+		req, err := NewHeadersRequestFromPolicy(&config.Policy{
+			From:                "https://from.example.com",
+			LoadBalancingPolicy: tc.lbp,
+		}, RequestHTTP{Hostname: "from.example.com"})
+		require.NoError(t, err)
+		assert.Equal(t, tc.enableRoutingKey, req.EnableRoutingKey, "type: %s", tc.lbp.GetType())
+		assert.Equal(t, tc.routingKeyByUser, req.RoutingKeyByUser, "type: %s", tc.lbp.GetType())
+	}
+}
+
 func TestNewHeadersRequestFromPolicy_IssuerFormat(t *testing.T) {
 	policy := &config.Policy{
 		EnableGoogleCloudServerlessAuthentication: true,
@@ -438,6 +460,29 @@ func TestHeadersEvaluator(t *testing.T) {
 			})
 		require.NoError(t, err)
 		assert.Equal(t, "e8bc163c82eee18733288c7d4ac636db3a6deb013ef2d37b68322be20edc45cc", output.Headers.Get("X-Pomerium-Routing-Key"))
+
+		output, err = eval(t,
+			[]protoreflect.ProtoMessage{
+				&session.Session{Id: "s1", UserId: "u1"},
+			},
+			&HeadersRequest{
+				EnableRoutingKey: true,
+				RoutingKeyByUser: true,
+				Session:          RequestSession{ID: "s1"},
+			})
+		require.NoError(t, err)
+		assert.Equal(t, cryptoSHA256("u1"), output.Headers.Get("X-Pomerium-Routing-Key"))
+
+		// without a user, the session is used
+		output, err = eval(t,
+			[]protoreflect.ProtoMessage{},
+			&HeadersRequest{
+				EnableRoutingKey: true,
+				RoutingKeyByUser: true,
+				Session:          RequestSession{ID: "s1"},
+			})
+		require.NoError(t, err)
+		assert.Equal(t, "e8bc163c82eee18733288c7d4ac636db3a6deb013ef2d37b68322be20edc45cc", output.Headers.Get("X-Pomerium-Routing-Key"))
 	})
 
 	t.Run("jwt payload email", func(t *testing.T) {
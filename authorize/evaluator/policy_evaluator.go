@@ -23,6 +23,7 @@ type PolicyRequest struct {
 	HTTP                     RequestHTTP    `json:"http"`
 	Session                  RequestSession `json:"session"`
 	IsValidClientCertificate bool           `json:"is_valid_client_certificate"`
+	Country                  string         `json:"country"`
 }
 
 // PolicyResponse is the result of evaluating a policy.
@@ -186,6 +187,13 @@ func NewPolicyEvaluator(
 	return e, nil
 }
 
+// ValidatePolicy generates and compiles the rego scripts for a policy without
+// evaluating them, returning any error in its PPL or custom rego.
+func ValidatePolicy(ctx context.Context, configPolicy *config.Policy, addDefaultClientCertificateRule bool) error {
+	_, err := NewPolicyEvaluator(ctx, store.New(), configPolicy, addDefaultClientCertificateRule)
+	return err
+}
+
 // Evaluate evaluates the policy rego scripts.
 func (e *PolicyEvaluator) Evaluate(ctx context.Context, req *PolicyRequest) (*PolicyResponse, error) {
 	res := NewPolicyResponse()
@@ -353,3 +353,28 @@ func TestPolicyEvaluator(t *testing.T) {
 		}, output)
 	})
 }
+
+func TestValidatePolicy(t *testing.T) {
+	ctx := context.Background()
+	to := config.WeightedURLs{{URL: *mustParseURL("https://to.example.com")}}
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, ValidatePolicy(ctx, &config.Policy{
+			From:         "https://from.example.com",
+			To:           to,
+			AllowedUsers: []string{"u1@example.com"},
+			SubPolicies: []config.SubPolicy{{
+				Rego: []string{"package pomerium.policy\n\nallow := true"},
+			}},
+		}, true))
+	})
+	t.Run("invalid rego", func(t *testing.T) {
+		assert.Error(t, ValidatePolicy(ctx, &config.Policy{
+			From: "https://from.example.com",
+			To:   to,
+			SubPolicies: []config.SubPolicy{{
+				Rego: []string{"package pomerium.policy\n\nallow := "},
+			}},
+		}, false))
+	})
+}
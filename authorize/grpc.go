@@ -22,6 +22,8 @@ import (
 	"github.com/pomerium/pomerium/internal/telemetry/trace"
 	"github.com/pomerium/pomerium/internal/urlutil"
 	"github.com/pomerium/pomerium/pkg/contextutil"
+	"github.com/pomerium/pomerium/pkg/grpc/device"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
 	"github.com/pomerium/pomerium/pkg/grpc/user"
 	"github.com/pomerium/pomerium/pkg/storage"
 	"github.com/pomerium/pomerium/pkg/telemetry/requestid"
@@ -66,6 +68,9 @@ func (a *Authorize) Check(ctx context.Context, in *envoy_service_auth_v3.CheckRe
 		}
 	}
 	if sessionState != nil && s != nil {
+		if s, ok := s.(*session.Session); ok {
+			a.endExpiredImpersonation(ctx, s)
+		}
 		u, _ = a.getDataBrokerUser(ctx, s.GetUserId()) // ignore any missing user error
 	}
 
@@ -93,6 +98,7 @@ func (a *Authorize) Check(ctx context.Context, in *envoy_service_auth_v3.CheckRe
 	if err != nil {
 		log.Ctx(ctx).Error().Err(err).Str("request-id", requestID).Msg("grpc check ext_authz_error")
 	}
+	setRateLimitMetadata(resp, req, s)
 	a.logAuthorizeCheck(ctx, in, resp, res, s, u)
 	return resp, err
 }
@@ -112,7 +118,7 @@ func (a *Authorize) getEvaluatorRequestFromCheckRequest(
 			requestURL,
 			getCheckRequestHeaders(in),
 			getClientCertificateInfo(ctx, clientCertMetadata),
-			attrs.GetSource().GetAddress().GetSocketAddress().GetAddress(),
+			getClientIP(in, a.currentOptions.Load()),
 		),
 	}
 	if sessionState != nil {
@@ -163,6 +169,35 @@ func getCheckRequestHeaders(req *envoy_service_auth_v3.CheckRequest) map[string]
 	return hdrs
 }
 
+// getClientIP returns the downstream client IP address. When
+// xff_num_trusted_hops is set the address is taken from the x-forwarded-for
+// header, the same way envoy determines the trusted client address.
+func getClientIP(req *envoy_service_auth_v3.CheckRequest, options *config.Options) string {
+	ip := req.GetAttributes().GetSource().GetAddress().GetSocketAddress().GetAddress()
+
+	hops := int(options.XffNumTrustedHops)
+	if hops == 0 {
+		return ip
+	}
+
+	var addrs []string
+	for _, addr := range strings.Split(getCheckRequestHeaders(req)["X-Forwarded-For"], ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	// envoy appends the remote address to the x-forwarded-for header before
+	// the request is sent to the authorize service
+	if !options.SkipXffAppend {
+		hops++
+	}
+	if len(addrs) < hops {
+		return ip
+	}
+	return addrs[len(addrs)-hops]
+}
+
 func getCheckRequestURL(req *envoy_service_auth_v3.CheckRequest) url.URL {
 	h := req.GetAttributes().GetRequest().GetHttp()
 	u := url.URL{
@@ -214,5 +249,6 @@ func getClientCertificateInfo(
 	}
 	c.Leaf = string(pem.EncodeToMemory(p))
 	c.Intermediates = string(rest)
+	c.Fingerprint = device.ClientCertificateFingerprint(p.Bytes)
 	return c
 }
@@ -109,6 +109,7 @@ func Test_getEvaluatorRequest(t *testing.T) {
 				Presented:     true,
 				Leaf:          certPEM[1:] + "\n",
 				Intermediates: "",
+				Fingerprint:   "a653e4069c461f7e19c2e309338685b87ae10e3598042871a338695c741bc756",
 			},
 			"",
 		),
@@ -217,8 +218,9 @@ fYCZHo3CID0gRSemaQ/jYMgyeBFrHIr6icZh
 			true,
 			url.QueryEscape(leafPEM),
 			evaluator.ClientCertificateInfo{
-				Presented: true,
-				Leaf:      leafPEM,
+				Presented:   true,
+				Leaf:        leafPEM,
+				Fingerprint: "0695aef5ebe8096f5cb7324425649ca2c5111a1d26a2d99645b7c8ddaa8ebc0e",
 			},
 			"",
 		},
@@ -230,6 +232,7 @@ fYCZHo3CID0gRSemaQ/jYMgyeBFrHIr6icZh
 				Presented:     true,
 				Leaf:          leafPEM,
 				Intermediates: intermediatePEM + rootPEM,
+				Fingerprint:   "0695aef5ebe8096f5cb7324425649ca2c5111a1d26a2d99645b7c8ddaa8ebc0e",
 			},
 			"",
 		},
@@ -324,3 +327,47 @@ func mustParseURL(rawURL string) url.URL {
 	}
 	return *u
 }
+
+func Test_getClientIP(t *testing.T) {
+	t.Parallel()
+
+	newCheckRequest := func(xff string) *envoy_service_auth_v3.CheckRequest {
+		return &envoy_service_auth_v3.CheckRequest{
+			Attributes: &envoy_service_auth_v3.AttributeContext{
+				Source: &envoy_service_auth_v3.AttributeContext_Peer{
+					Address: &envoy_config_core_v3.Address{
+						Address: &envoy_config_core_v3.Address_SocketAddress{
+							SocketAddress: &envoy_config_core_v3.SocketAddress{
+								Address: "198.51.100.1",
+							},
+						},
+					},
+				},
+				Request: &envoy_service_auth_v3.AttributeContext_Request{
+					Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
+						Headers: map[string]string{
+							"x-forwarded-for": xff,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		xff     string
+		options config.Options
+		expect  string
+	}{
+		{"no trusted hops", "192.0.2.10, 203.0.113.1, 198.51.100.1", config.Options{}, "198.51.100.1"},
+		{"one trusted hop", "192.0.2.10, 203.0.113.1, 198.51.100.1", config.Options{XffNumTrustedHops: 1}, "203.0.113.1"},
+		{"two trusted hops", "192.0.2.10, 203.0.113.1, 198.51.100.1", config.Options{XffNumTrustedHops: 2}, "192.0.2.10"},
+		{"skip xff append", "192.0.2.10, 203.0.113.1", config.Options{XffNumTrustedHops: 1, SkipXffAppend: true}, "203.0.113.1"},
+		{"too few addresses", "198.51.100.1", config.Options{XffNumTrustedHops: 2}, "198.51.100.1"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, getClientIP(newCheckRequest(tc.xff), &tc.options))
+		})
+	}
+}
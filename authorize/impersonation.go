@@ -0,0 +1,66 @@
+package authorize
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+// endExpiredImpersonation ends the impersonation of the session if the
+// impersonated session has expired or no longer exists, so the operator's
+// own identity is used again. Impersonations are limited by the expiry of
+// the impersonated session.
+func (a *Authorize) endExpiredImpersonation(ctx context.Context, s *session.Session) {
+	impersonateSessionID := s.GetImpersonateSessionId()
+	if impersonateSessionID == "" {
+		return
+	}
+
+	ctx, span := trace.StartSpan(ctx, "authorize.endExpiredImpersonation")
+	defer span.End()
+
+	sessionTypeURL := grpcutil.GetTypeURL(new(session.Session))
+	record, err := getDataBrokerRecord(ctx, sessionTypeURL, impersonateSessionID, 0)
+	if err == nil {
+		var impersonated session.Session
+		err = record.GetData().UnmarshalTo(&impersonated)
+		if err == nil {
+			err = impersonated.Validate()
+		}
+	}
+	if err == nil {
+		return
+	} else if !storage.IsNotFound(err) && !errors.Is(err, session.ErrSessionExpired) {
+		log.Ctx(ctx).Error().Err(err).Str("session-id", s.GetId()).Msg("authorize: error checking impersonation")
+		return
+	}
+
+	client := a.state.Load().dataBrokerClient
+	s.ImpersonateSessionId = nil
+	res, err := session.Patch(ctx, client, s, &fieldmaskpb.FieldMask{Paths: []string{"impersonate_session_id"}})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("session-id", s.GetId()).Msg("authorize: error ending impersonation")
+		return
+	}
+	if err := session.Delete(ctx, client, impersonateSessionID); err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("session-id", impersonateSessionID).Msg("authorize: error deleting impersonated session")
+	}
+
+	// make sure the updated session is used for policy evaluation
+	if len(res.GetRecords()) > 0 {
+		_, _ = getDataBrokerRecord(ctx, sessionTypeURL, s.GetId(), res.GetRecords()[0].GetVersion())
+	}
+
+	log.Ctx(ctx).Info().
+		Str("session-id", s.GetId()).
+		Str("user-id", s.GetUserId()).
+		Str("impersonate-session-id", impersonateSessionID).
+		Msg("authorize: impersonation expired")
+}
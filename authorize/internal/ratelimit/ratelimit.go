@@ -0,0 +1,81 @@
+// Package ratelimit contains an in-memory token bucket rate limiter.
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+const pruneInterval = time.Minute
+
+type bucketKey struct {
+	routeID  uint64
+	key      string
+	requests uint32
+	burst    uint32
+	interval time.Duration
+}
+
+// A Limiter limits requests using a token bucket for each route and key.
+//
+// Buckets are stored in memory, so limits are enforced separately by each
+// authorize instance.
+type Limiter struct {
+	now func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*rate.Limiter
+	lastPrune time.Time
+}
+
+// New creates a new Limiter.
+func New() *Limiter {
+	return &Limiter{
+		now:     time.Now,
+		buckets: make(map[bucketKey]*rate.Limiter),
+	}
+}
+
+// Allow reports whether the given number of hits are allowed for the route
+// and key, along with the number of requests remaining.
+func (l *Limiter) Allow(routeID uint64, key string, rateLimit *config.RateLimit, hits uint32) (allowed bool, remaining uint32) {
+	now := l.now()
+	bk := bucketKey{
+		routeID:  routeID,
+		key:      key,
+		requests: rateLimit.Requests,
+		burst:    rateLimit.GetBurst(),
+		interval: rateLimit.GetInterval(),
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastPrune) >= pruneInterval {
+		l.prune(now)
+		l.lastPrune = now
+	}
+
+	b, ok := l.buckets[bk]
+	if !ok {
+		b = rate.NewLimiter(rate.Limit(float64(bk.requests)/bk.interval.Seconds()), int(bk.burst))
+		l.buckets[bk] = b
+	}
+
+	allowed = b.AllowN(now, int(hits))
+	return allowed, uint32(max(0, math.Floor(b.TokensAt(now))))
+}
+
+// prune removes any full buckets, as they are equivalent to new buckets.
+func (l *Limiter) prune(now time.Time) {
+	for bk, b := range l.buckets {
+		if b.TokensAt(now) >= float64(b.Burst()) {
+			delete(l.buckets, bk)
+		}
+	}
+}
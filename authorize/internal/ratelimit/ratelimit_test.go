@@ -0,0 +1,44 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	l := New()
+	l.now = func() time.Time { return now }
+
+	rl := &config.RateLimit{Requests: 2, Interval: time.Minute}
+
+	allowed, remaining := l.Allow(1, "a", rl, 1)
+	assert.True(t, allowed)
+	assert.Equal(t, uint32(1), remaining)
+	allowed, remaining = l.Allow(1, "a", rl, 1)
+	assert.True(t, allowed)
+	assert.Equal(t, uint32(0), remaining)
+	allowed, _ = l.Allow(1, "a", rl, 1)
+	assert.False(t, allowed, "should limit after the burst")
+
+	allowed, _ = l.Allow(1, "b", rl, 1)
+	assert.True(t, allowed, "should limit keys separately")
+	allowed, _ = l.Allow(2, "a", rl, 1)
+	assert.True(t, allowed, "should limit routes separately")
+
+	now = now.Add(30 * time.Second)
+	allowed, _ = l.Allow(1, "a", rl, 1)
+	assert.True(t, allowed, "should refill over the interval")
+	allowed, _ = l.Allow(1, "a", rl, 1)
+	assert.False(t, allowed)
+
+	now = now.Add(time.Hour)
+	l.Allow(3, "c", rl, 1)
+	assert.Len(t, l.buckets, 1, "should prune full buckets")
+}
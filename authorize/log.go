@@ -3,12 +3,14 @@ package authorize
 import (
 	"context"
 	"strings"
+	"time"
 
 	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/rs/zerolog"
 
 	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/internal/auditlog"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/telemetry/trace"
 	"github.com/pomerium/pomerium/pkg/grpc/audit"
@@ -69,6 +71,13 @@ func (a *Authorize) logAuthorizeCheck(
 			log.Ctx(ctx).Error().Err(err).Msg("authorize: error encrypting audit record")
 			return
 		}
+		if a.auditLogger.Enabled() {
+			entry := auditlog.NewEntry(time.Now(), requestid.FromContext(ctx), sealed)
+			if err := a.auditLogger.Write(ctx, entry); err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("authorize: error writing audit record")
+			}
+			return
+		}
 		log.Ctx(ctx).Info().
 			Str("request-id", requestid.FromContext(ctx)).
 			EmbedObject(sealed).
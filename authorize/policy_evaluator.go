@@ -0,0 +1,275 @@
+package authorize
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pomerium/datasource/pkg/directory"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/grpc/device"
+	policypb "github.com/pomerium/pomerium/pkg/grpc/policy"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+	"github.com/pomerium/pomerium/pkg/identity"
+	"github.com/pomerium/pomerium/pkg/policy/criteria"
+	"github.com/pomerium/pomerium/pkg/storage"
+	"github.com/pomerium/pomerium/pkg/webauthnutil"
+)
+
+// dryRunSessionID is the id of the synthetic session used for dry runs.
+const dryRunSessionID = "pomerium-dry-run"
+
+var errInvalidDryRunRequest = errors.New("invalid request")
+
+// Evaluate implements the policy evaluator gRPC endpoint. Requests must be
+// signed with the shared secret.
+func (a *Authorize) Evaluate(ctx context.Context, in *policypb.EvaluateRequest) (*policypb.EvaluateResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "authorize.grpc.Evaluate")
+	defer span.End()
+
+	sharedKey, err := a.currentOptions.Load().GetSharedKey()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := grpcutil.RequireSignedJWT(ctx, sharedKey); err != nil {
+		return nil, err
+	}
+
+	res, err := a.DryRun(ctx, in)
+	if errors.Is(err, errInvalidDryRunRequest) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DryRun evaluates the policy for a synthetic request. The identity in the
+// request is used in place of any databroker data, so no session is created.
+func (a *Authorize) DryRun(ctx context.Context, in *policypb.EvaluateRequest) (*policypb.EvaluateResponse, error) {
+	req, err := newDryRunEvaluatorRequest(a.currentOptions.Load(), in)
+	if err != nil {
+		return nil, err
+	}
+	ctx = storage.WithQuerier(ctx, storage.NewStaticQuerier(newDryRunRecords(in.GetIdentity())...))
+
+	a.stateLock.RLock()
+	res, err := a.state.Load().evaluator.Evaluate(ctx, req)
+	a.stateLock.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	out := &policypb.EvaluateResponse{
+		RouteId: getDryRunRouteID(req.Policy),
+		Allowed: isAllowed(res),
+		Allow: &policypb.RuleResult{
+			Value:   res.Allow.Value,
+			Reasons: res.Allow.Reasons.Strings(),
+		},
+		Deny: &policypb.RuleResult{
+			Value:   res.Deny.Value,
+			Reasons: res.Deny.Reasons.Strings(),
+		},
+		Headers: make(map[string]string, len(res.Headers)),
+	}
+	for k, vs := range res.Headers {
+		out.Headers[k] = strings.Join(vs, ",")
+	}
+	for _, t := range res.Traces {
+		out.Traces = append(out.Traces, &policypb.Trace{
+			Id:          t.ID,
+			Explanation: t.Explanation,
+			Remediation: t.Remediation,
+			Allow:       t.Allow,
+			Deny:        t.Deny,
+		})
+	}
+	return out, nil
+}
+
+// isAllowed returns true if the result would be allowed by handleResult.
+func isAllowed(res *evaluator.Result) bool {
+	for _, reasons := range []criteria.Reasons{res.Allow.Reasons, res.Deny.Reasons} {
+		if reasons.Has(criteria.ReasonUserUnauthenticated) ||
+			reasons.Has(criteria.ReasonUserReauthenticate) ||
+			reasons.Has(criteria.ReasonDeviceUnauthenticated) {
+			return false
+		}
+	}
+	return !invalidClientCertReason(res.Deny.Reasons) && !res.Deny.Value && res.Allow.Value
+}
+
+func newDryRunEvaluatorRequest(options *config.Options, in *policypb.EvaluateRequest) (*evaluator.Request, error) {
+	var policy *config.Policy
+	if in.GetRouteId() != "" {
+		for p := range options.GetAllPolicies() {
+			if getDryRunRouteID(p) == in.GetRouteId() {
+				policy = p
+				break
+			}
+		}
+		if policy == nil {
+			return nil, fmt.Errorf("%w: route not found: %s", errInvalidDryRunRequest, in.GetRouteId())
+		}
+	}
+
+	rawURL := in.GetUrl()
+	if rawURL == "" && policy != nil {
+		rawURL = policy.From
+	}
+	if rawURL == "" {
+		return nil, fmt.Errorf("%w: either a route id or url is required", errInvalidDryRunRequest)
+	}
+	requestURL, err := urlutil.ParseAndValidateURL(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid url: %w", errInvalidDryRunRequest, err)
+	}
+
+	if policy == nil {
+		for p := range options.GetAllPolicies() {
+			if p.Matches(requestURL, options.IsRuntimeFlagSet(config.RuntimeFlagMatchAnyIncomingPort)) {
+				policy = p
+				break
+			}
+		}
+		if policy == nil {
+			return nil, fmt.Errorf("%w: no route matches url: %s", errInvalidDryRunRequest, rawURL)
+		}
+	}
+
+	if in.GetIdentity() != nil && in.GetIdentity().GetUserId() == "" {
+		return nil, fmt.Errorf("%w: identity user id is required", errInvalidDryRunRequest)
+	}
+
+	method := in.GetMethod()
+	if method == "" {
+		method = http.MethodGet
+	}
+	headers := make(map[string]string, len(in.GetHeaders()))
+	for k, v := range in.GetHeaders() {
+		headers[http.CanonicalHeaderKey(k)] = v
+	}
+
+	req := &evaluator.Request{
+		Policy: policy,
+		HTTP: evaluator.NewRequestHTTP(
+			strings.ToUpper(method),
+			*requestURL,
+			headers,
+			evaluator.ClientCertificateInfo{},
+			in.GetIp(),
+		),
+	}
+	if in.GetIdentity() != nil {
+		req.Session.ID = dryRunSessionID
+	}
+	return req, nil
+}
+
+// getDryRunRouteID returns the policy's id, or its generated route id if it
+// has no id.
+func getDryRunRouteID(p *config.Policy) string {
+	if p.ID != "" {
+		return p.ID
+	}
+	routeID, err := p.RouteID()
+	if err != nil {
+		return ""
+	}
+	return strconv.FormatUint(routeID, 10)
+}
+
+// newDryRunRecords returns the databroker records for a synthetic identity.
+func newDryRunRecords(id *policypb.Identity) []proto.Message {
+	if id == nil {
+		return nil
+	}
+
+	now := time.Now()
+	claims := identity.Claims(id.GetClaims().AsMap()).Flatten()
+
+	s := &session.Session{
+		Id:         dryRunSessionID,
+		UserId:     id.GetUserId(),
+		IdpId:      id.GetIdpId(),
+		IssuedAt:   timestamppb.New(now),
+		AccessedAt: timestamppb.New(now),
+		ExpiresAt:  timestamppb.New(now.Add(time.Hour)),
+	}
+	s.AddClaims(claims)
+
+	u := &user.User{
+		Id:    id.GetUserId(),
+		Name:  id.GetName(),
+		Email: id.GetEmail(),
+	}
+	u.AddClaims(claims)
+
+	var records []proto.Message
+	groupIDs := make([]any, 0, len(id.GetGroups()))
+	for _, g := range id.GetGroups() {
+		groupIDs = append(groupIDs, g.GetId())
+		records = append(records, newDryRunDirectoryRecord(directory.GroupRecordType, map[string]any{
+			"id":    g.GetId(),
+			"name":  g.GetName(),
+			"email": g.GetEmail(),
+		}))
+	}
+	records = append(records, newDryRunDirectoryRecord(directory.UserRecordType, map[string]any{
+		"id":           id.GetUserId(),
+		"display_name": id.GetName(),
+		"email":        id.GetEmail(),
+		"group_ids":    groupIDs,
+	}))
+
+	for _, d := range id.GetDevices() {
+		typeID := d.GetTypeId()
+		if typeID == "" {
+			typeID = webauthnutil.DefaultDeviceType
+		}
+		s.DeviceCredentials = append(s.DeviceCredentials, &session.Session_DeviceCredential{
+			TypeId:     typeID,
+			Credential: &session.Session_DeviceCredential_Id{Id: d.GetCredentialId()},
+		})
+		u.AddDeviceCredentialID(d.GetCredentialId())
+		enrollment := &device.Enrollment{
+			Id:           d.GetCredentialId(),
+			TypeId:       typeID,
+			CredentialId: d.GetCredentialId(),
+			UserId:       id.GetUserId(),
+			EnrolledAt:   timestamppb.New(now),
+		}
+		if d.GetApproved() {
+			enrollment.ApprovedBy = dryRunSessionID
+		}
+		records = append(records, &device.Credential{
+			Id:           d.GetCredentialId(),
+			TypeId:       typeID,
+			EnrollmentId: enrollment.GetId(),
+			UserId:       id.GetUserId(),
+		}, enrollment)
+	}
+
+	return append(records, s, u)
+}
+
+func newDryRunDirectoryRecord(recordType string, data map[string]any) proto.Message {
+	s, _ := structpb.NewStruct(data)
+	return storage.NewStaticRecord(recordType, s)
+}
@@ -0,0 +1,145 @@
+package authorize
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pomerium/pomerium/config"
+	policypb "github.com/pomerium/pomerium/pkg/grpc/policy"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+	"github.com/pomerium/pomerium/pkg/policy/parser"
+)
+
+func TestAuthorize_DryRun(t *testing.T) {
+	t.Parallel()
+
+	ppl, err := parser.ParseYAML(strings.NewReader(`
+allow:
+  and:
+    - groups:
+        has: admins
+    - claim/department: engineering
+    - device:
+        approved: true
+`))
+	require.NoError(t, err)
+
+	opts := config.NewDefaultOptions()
+	opts.SharedKey = "2p/Wi2Q6bYDfzmoSEbKqYKtg+DUoLWTEHHs7vOhvL7w="
+	opts.JWTClaimsHeaders = config.JWTClaimHeaders{"X-Email": "email", "X-Groups": "groups"}
+	opts.Policies = []config.Policy{{
+		ID:     "admin",
+		From:   "https://admin.example.com",
+		To:     mustParseWeightedURLs(t, "https://to.example.com"),
+		Policy: &config.PPLPolicy{Policy: ppl},
+	}}
+	cfg := &config.Config{Options: opts}
+	a, err := New(context.Background(), cfg)
+	require.NoError(t, err)
+	a.OnConfigChange(context.Background(), cfg)
+
+	identity := func() *policypb.Identity {
+		return &policypb.Identity{
+			UserId: "u1",
+			Email:  "jane@example.com",
+			Claims: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"department": structpb.NewStringValue("engineering"),
+			}},
+			Groups:  []*policypb.Group{{Id: "admins", Name: "Administrators"}},
+			Devices: []*policypb.Device{{CredentialId: "d1", Approved: true}},
+		}
+	}
+
+	t.Run("allowed", func(t *testing.T) {
+		res, err := a.DryRun(context.Background(), &policypb.EvaluateRequest{
+			Url:      "https://admin.example.com/settings",
+			Identity: identity(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "admin", res.GetRouteId())
+		assert.True(t, res.GetAllowed())
+		assert.Equal(t, []string{"claim-ok", "device-ok", "groups-ok"}, res.GetAllow().GetReasons())
+		assert.Equal(t, "jane@example.com", res.GetHeaders()["X-Email"])
+		assert.Equal(t, "admins,Administrators", res.GetHeaders()["X-Groups"])
+		assert.NotEmpty(t, res.GetHeaders()["X-Pomerium-Jwt-Assertion"])
+	})
+	t.Run("denied", func(t *testing.T) {
+		id := identity()
+		id.Groups = nil
+		id.Devices[0].Approved = false
+		res, err := a.DryRun(context.Background(), &policypb.EvaluateRequest{
+			RouteId:  "admin",
+			Identity: id,
+		})
+		require.NoError(t, err)
+		assert.False(t, res.GetAllowed())
+		assert.Equal(t, []string{"device-unauthorized", "groups-unauthorized"}, res.GetAllow().GetReasons())
+	})
+	t.Run("generated route id", func(t *testing.T) {
+		routeID, err := opts.Policies[0].RouteID()
+		require.NoError(t, err)
+		opts := *opts
+		opts.Policies = []config.Policy{opts.Policies[0]}
+		opts.Policies[0].ID = ""
+		cfg := &config.Config{Options: &opts}
+		a, err := New(context.Background(), cfg)
+		require.NoError(t, err)
+		a.OnConfigChange(context.Background(), cfg)
+
+		res, err := a.DryRun(context.Background(), &policypb.EvaluateRequest{
+			RouteId:  strconv.FormatUint(routeID, 10),
+			Identity: identity(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, strconv.FormatUint(routeID, 10), res.GetRouteId())
+		assert.True(t, res.GetAllowed())
+	})
+	t.Run("unauthenticated", func(t *testing.T) {
+		res, err := a.DryRun(context.Background(), &policypb.EvaluateRequest{
+			Url: "https://admin.example.com",
+		})
+		require.NoError(t, err)
+		assert.False(t, res.GetAllowed())
+		assert.Contains(t, res.GetAllow().GetReasons(), "user-unauthenticated")
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := a.DryRun(context.Background(), &policypb.EvaluateRequest{Url: "https://other.example.com"})
+		assert.ErrorIs(t, err, errInvalidDryRunRequest)
+		_, err = a.DryRun(context.Background(), &policypb.EvaluateRequest{RouteId: "other"})
+		assert.ErrorIs(t, err, errInvalidDryRunRequest)
+		_, err = a.DryRun(context.Background(), &policypb.EvaluateRequest{})
+		assert.ErrorIs(t, err, errInvalidDryRunRequest)
+	})
+	t.Run("evaluate", func(t *testing.T) {
+		_, err := a.Evaluate(context.Background(), &policypb.EvaluateRequest{RouteId: "admin"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		sharedKey, err := base64.StdEncoding.DecodeString(opts.SharedKey)
+		require.NoError(t, err)
+		var ctx context.Context
+		err = grpcutil.WithUnarySignedJWT(func() []byte { return sharedKey })(context.Background(), "", nil, nil, nil,
+			func(outgoingCtx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+				md, _ := metadata.FromOutgoingContext(outgoingCtx)
+				ctx = metadata.NewIncomingContext(context.Background(), md)
+				return nil
+			})
+		require.NoError(t, err)
+
+		_, err = a.Evaluate(ctx, &policypb.EvaluateRequest{RouteId: "other"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		res, err := a.Evaluate(ctx, &policypb.EvaluateRequest{RouteId: "admin"})
+		require.NoError(t, err)
+		assert.False(t, res.GetAllowed())
+	})
+}
@@ -0,0 +1,90 @@
+package authorize
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	envoy_service_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config/envoyconfig"
+	"github.com/pomerium/pomerium/internal/telemetry/metrics"
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+)
+
+// ShouldRateLimit implements the envoy rate limit service gRPC endpoint.
+func (a *Authorize) ShouldRateLimit(
+	ctx context.Context,
+	in *envoy_service_ratelimit_v3.RateLimitRequest,
+) (*envoy_service_ratelimit_v3.RateLimitResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "authorize.grpc.ShouldRateLimit")
+	defer span.End()
+
+	hits := in.GetHitsAddend()
+	if hits == 0 {
+		hits = 1
+	}
+
+	res := &envoy_service_ratelimit_v3.RateLimitResponse{
+		OverallCode: envoy_service_ratelimit_v3.RateLimitResponse_OK,
+	}
+	for _, descriptor := range in.GetDescriptors() {
+		status := &envoy_service_ratelimit_v3.RateLimitResponse_DescriptorStatus{
+			Code: envoy_service_ratelimit_v3.RateLimitResponse_OK,
+		}
+		res.Statuses = append(res.Statuses, status)
+
+		var rawRouteID string
+		var keys []string
+		for _, entry := range descriptor.GetEntries() {
+			if entry.GetKey() == envoyconfig.RateLimitDescriptorKeyRouteID {
+				rawRouteID = entry.GetValue()
+			} else {
+				keys = append(keys, entry.GetKey()+"="+entry.GetValue())
+			}
+		}
+		routeID, err := strconv.ParseUint(rawRouteID, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		policy := a.getMatchingPolicy(routeID)
+		if policy == nil || policy.RateLimit == nil {
+			continue
+		}
+
+		allowed, remaining := a.rateLimiter.Allow(routeID, strings.Join(keys, "&"), policy.RateLimit, hits)
+		status.LimitRemaining = remaining
+		if allowed {
+			metrics.RecordRateLimitRequest(ctx, rawRouteID, "ok")
+		} else {
+			metrics.RecordRateLimitRequest(ctx, rawRouteID, "over_limit")
+			status.Code = envoy_service_ratelimit_v3.RateLimitResponse_OVER_LIMIT
+			res.OverallCode = envoy_service_ratelimit_v3.RateLimitResponse_OVER_LIMIT
+		}
+	}
+	return res, nil
+}
+
+// setRateLimitMetadata adds the user and session id to the dynamic metadata
+// of an allowed response so that they can be used as rate limit keys.
+func setRateLimitMetadata(
+	resp *envoy_service_auth_v3.CheckResponse,
+	req *evaluator.Request,
+	s sessionOrServiceAccount,
+) {
+	if resp.GetOkResponse() == nil || req.Policy == nil || req.Policy.RateLimit == nil || req.Session.ID == "" {
+		return
+	}
+
+	fields := map[string]*structpb.Value{
+		envoyconfig.RateLimitDescriptorKeySessionID: structpb.NewStringValue(req.Session.ID),
+	}
+	if s != nil && s.GetUserId() != "" {
+		fields[envoyconfig.RateLimitDescriptorKeyUserID] = structpb.NewStringValue(s.GetUserId())
+	}
+	resp.DynamicMetadata = &structpb.Struct{Fields: fields}
+}
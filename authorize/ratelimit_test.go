@@ -0,0 +1,100 @@
+package authorize
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	envoy_extensions_common_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	envoy_service_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/authorize/internal/ratelimit"
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/atomicutil"
+	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+func TestAuthorize_ShouldRateLimit(t *testing.T) {
+	t.Parallel()
+
+	a := &Authorize{
+		currentOptions: config.NewAtomicOptions(),
+		state:          atomicutil.NewValue(new(authorizeState)),
+		rateLimiter:    ratelimit.New(),
+	}
+	a.currentOptions.Store(&config.Options{
+		Policies: []config.Policy{
+			{
+				From:      "https://a.example.com",
+				To:        mustParseWeightedURLs(t, "https://to.example.com"),
+				RateLimit: &config.RateLimit{Requests: 1, Key: config.RateLimitKeyUserID},
+			},
+			{
+				From: "https://b.example.com",
+				To:   mustParseWeightedURLs(t, "https://to.example.com"),
+			},
+		},
+	})
+	routeIDA, err := a.currentOptions.Load().Policies[0].RouteID()
+	require.NoError(t, err)
+	routeIDB, err := a.currentOptions.Load().Policies[1].RouteID()
+	require.NoError(t, err)
+
+	shouldRateLimit := func(routeID uint64, userID string) envoy_service_ratelimit_v3.RateLimitResponse_Code {
+		t.Helper()
+
+		entries := []*envoy_extensions_common_ratelimit_v3.RateLimitDescriptor_Entry{
+			{Key: "route_id", Value: strconv.FormatUint(routeID, 10)},
+		}
+		if userID != "" {
+			entries = append(entries, &envoy_extensions_common_ratelimit_v3.RateLimitDescriptor_Entry{Key: "user_id", Value: userID})
+		}
+		res, err := a.ShouldRateLimit(context.Background(), &envoy_service_ratelimit_v3.RateLimitRequest{
+			Domain:      "pomerium",
+			Descriptors: []*envoy_extensions_common_ratelimit_v3.RateLimitDescriptor{{Entries: entries}},
+		})
+		require.NoError(t, err)
+		return res.GetOverallCode()
+	}
+
+	assert.Equal(t, envoy_service_ratelimit_v3.RateLimitResponse_OK, shouldRateLimit(routeIDA, "u1"))
+	assert.Equal(t, envoy_service_ratelimit_v3.RateLimitResponse_OVER_LIMIT, shouldRateLimit(routeIDA, "u1"))
+	assert.Equal(t, envoy_service_ratelimit_v3.RateLimitResponse_OK, shouldRateLimit(routeIDA, "u2"))
+	assert.Equal(t, envoy_service_ratelimit_v3.RateLimitResponse_OK, shouldRateLimit(routeIDA, ""))
+	assert.Equal(t, envoy_service_ratelimit_v3.RateLimitResponse_OVER_LIMIT, shouldRateLimit(routeIDA, ""))
+	assert.Equal(t, envoy_service_ratelimit_v3.RateLimitResponse_OK, shouldRateLimit(routeIDB, "u1"))
+	assert.Equal(t, envoy_service_ratelimit_v3.RateLimitResponse_OK, shouldRateLimit(routeIDB, "u1"),
+		"should not limit routes without a rate limit")
+}
+
+func Test_setRateLimitMetadata(t *testing.T) {
+	t.Parallel()
+
+	policy := &config.Policy{RateLimit: &config.RateLimit{Requests: 1}}
+	ok := func() *envoy_service_auth_v3.CheckResponse {
+		return &envoy_service_auth_v3.CheckResponse{
+			HttpResponse: &envoy_service_auth_v3.CheckResponse_OkResponse{
+				OkResponse: &envoy_service_auth_v3.OkHttpResponse{},
+			},
+		}
+	}
+
+	resp := ok()
+	setRateLimitMetadata(resp, &evaluator.Request{Policy: policy, Session: evaluator.RequestSession{ID: "s1"}}, &session.Session{UserId: "u1"})
+	testutil.AssertProtoJSONEqual(t, `{"session_id": "s1", "user_id": "u1"}`, resp.GetDynamicMetadata())
+
+	resp = ok()
+	setRateLimitMetadata(resp, &evaluator.Request{Policy: &config.Policy{}, Session: evaluator.RequestSession{ID: "s1"}}, &session.Session{UserId: "u1"})
+	assert.Nil(t, resp.GetDynamicMetadata(), "should only set metadata for routes with a rate limit")
+
+	resp = ok()
+	setRateLimitMetadata(resp, &evaluator.Request{Policy: policy}, nil)
+	assert.Nil(t, resp.GetDynamicMetadata(), "should only set metadata for sessions")
+
+	setRateLimitMetadata(nil, &evaluator.Request{Policy: policy, Session: evaluator.RequestSession{ID: "s1"}}, nil)
+}
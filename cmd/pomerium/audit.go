@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pomerium/pomerium/internal/auditlog"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+func auditCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Work with audit logs",
+	}
+	cmd.AddCommand(auditDecryptCommand())
+	return cmd
+}
+
+func auditDecryptCommand() *cobra.Command {
+	var flags struct {
+		key     string
+		keyFile string
+	}
+	cmd := &cobra.Command{
+		Use:   "decrypt [FILE]...",
+		Short: "Decrypt audit records",
+		Long: `Decrypt the audit records in an audit sink file, or in pomerium logs, and
+print them as newline-delimited JSON. Lines which are not audit records are
+skipped. If no files are given, records are read from stdin.
+
+The private key is the base64-encoded Curve25519 private key matching the
+public audit_key.`,
+		Example: `  pomerium audit decrypt --key-file audit.key /var/log/pomerium/audit.log`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := loadAuditPrivateKey(flags.key, flags.keyFile)
+			if err != nil {
+				return err
+			}
+			dec := protoutil.NewDecryptor(cryptutil.KeyEncryptionKeySourceFunc(func(id string) (*cryptutil.PrivateKeyEncryptionKey, error) {
+				if id != key.ID() {
+					return nil, fmt.Errorf("unknown key id: %s", id)
+				}
+				return key, nil
+			}))
+
+			out := json.NewEncoder(cmd.OutOrStdout())
+			fn := func(entry *auditlog.DecryptedEntry) error {
+				record, err := protojson.Marshal(entry.Record)
+				if err != nil {
+					return err
+				}
+				return out.Encode(struct {
+					Time      string          `json:"time,omitempty"`
+					RequestID string          `json:"request_id,omitempty"`
+					Record    json.RawMessage `json:"record"`
+				}{entry.Time, entry.RequestID, record})
+			}
+
+			if len(args) == 0 {
+				return auditlog.ReadEntries(cmd.InOrStdin(), dec, fn)
+			}
+			for _, name := range args {
+				if err := decryptAuditFile(name, dec, fn); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&flags.key, "key", "", "base64-encoded audit private key")
+	cmd.Flags().StringVar(&flags.keyFile, "key-file", "", "file containing the base64-encoded audit private key")
+	return cmd
+}
+
+func decryptAuditFile(name string, dec *protoutil.Decryptor, fn func(*auditlog.DecryptedEntry) error) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := auditlog.ReadEntries(f, dec, fn); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+func loadAuditPrivateKey(key, keyFile string) (*cryptutil.PrivateKeyEncryptionKey, error) {
+	switch {
+	case key != "" && keyFile != "":
+		return nil, errors.New("only one of --key or --key-file may be set")
+	case keyFile != "":
+		bs, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		key = string(bs)
+	case key == "":
+		return nil, errors.New("an audit private key is required, set --key or --key-file")
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return nil, fmt.Errorf("invalid audit private key: %w", err)
+	}
+	return cryptutil.NewPrivateKeyEncryptionKey(raw)
+}
@@ -0,0 +1,440 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func configCommand(configFile *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the configuration",
+	}
+	cmd.AddCommand(configDumpCommand(configFile))
+	return cmd
+}
+
+func configDumpCommand(configFile *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "dump",
+		Short: "Print the effective configuration and the generated Envoy configuration",
+		Long: `Print the effective options, after merging the config file, environment
+variables and defaults, along with the generated Envoy bootstrap, listeners,
+clusters and route configurations as a single JSON document.
+
+Secrets in the options and inline data, such as private keys, in the Envoy
+configuration are redacted. The ports of pomerium's internal listeners
+are allocated randomly, so they differ between runs.`,
+		Example: `  pomerium config dump --config config.yaml > pomerium-config.json`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+			setupCommandLogger()
+
+			cfg, err := loadConfig(ctx, *configFile)
+			if err != nil {
+				return err
+			}
+			res, err := buildEnvoyResources(ctx, cfg)
+			if err != nil {
+				return err
+			}
+
+			options := cfg.Options.ToProto()
+			redactSecrets(options.ProtoReflect())
+			redactDataSources(res.bootstrap.ProtoReflect())
+			for _, l := range res.listeners {
+				redactDataSources(l.ProtoReflect())
+			}
+			for _, c := range res.clusters {
+				redactDataSources(c.ProtoReflect())
+			}
+			for _, rc := range res.routeConfigurations {
+				redactDataSources(rc.ProtoReflect())
+			}
+
+			var dump struct {
+				Options             json.RawMessage   `json:"options"`
+				Bootstrap           json.RawMessage   `json:"bootstrap"`
+				Listeners           []json.RawMessage `json:"listeners"`
+				Clusters            []json.RawMessage `json:"clusters"`
+				RouteConfigurations []json.RawMessage `json:"routeConfigurations"`
+			}
+			if dump.Options, err = protojson.Marshal(options); err != nil {
+				return err
+			}
+			if dump.Bootstrap, err = protojson.Marshal(res.bootstrap); err != nil {
+				return err
+			}
+			if dump.Listeners, err = marshalProtoList(res.listeners); err != nil {
+				return err
+			}
+			if dump.Clusters, err = marshalProtoList(res.clusters); err != nil {
+				return err
+			}
+			if dump.RouteConfigurations, err = marshalProtoList(res.routeConfigurations); err != nil {
+				return err
+			}
+
+			bs, err := json.MarshalIndent(dump, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bs))
+			return err
+		},
+	}
+}
+
+func marshalProtoList[T proto.Message](msgs []T) ([]json.RawMessage, error) {
+	out := make([]json.RawMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		bs, err := protojson.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, bs)
+	}
+	return out, nil
+}
+
+const redacted = "REDACTED"
+
+// unredactedFields are the fields in the config protobuf messages which are
+// known not to hold secrets, relative to the config package. The values of any
+// other string or bytes fields, including lists and maps, are redacted, so
+// new options are redacted until they're added here.
+var unredactedFields = map[protoreflect.FullName]bool{
+	"AuditSink.File.path": true,
+
+	"AuditSink.Syslog.address": true,
+	"AuditSink.Syslog.ca":      true,
+	"AuditSink.Syslog.tag":     true,
+
+	"Config.name": true,
+
+	"DownstreamMtlsSettings.ca":  true,
+	"DownstreamMtlsSettings.crl": true,
+
+	"LdapSettings.bind_dn":              true,
+	"LdapSettings.ca":                   true,
+	"LdapSettings.group_name_attribute": true,
+	"LdapSettings.group_search_base":    true,
+	"LdapSettings.group_search_filter":  true,
+	"LdapSettings.url":                  true,
+	"LdapSettings.user_attributes":      true,
+	"LdapSettings.user_search_base":     true,
+	"LdapSettings.user_search_filter":   true,
+
+	"OtlpSettings.ca":                  true,
+	"OtlpSettings.endpoint":            true,
+	"OtlpSettings.protocol":            true,
+	"OtlpSettings.resource_attributes": true,
+
+	"PPLPolicy.raw": true,
+
+	"Policy.allowed_domains": true,
+	"Policy.allowed_users":   true,
+	"Policy.explanation":     true,
+	"Policy.id":              true,
+	"Policy.name":            true,
+	"Policy.rego":            true,
+	"Policy.remediation":     true,
+
+	"Route.allowed_domains":                       true,
+	"Route.allowed_users":                         true,
+	"Route.from":                                  true,
+	"Route.host_path_regex_rewrite_pattern":       true,
+	"Route.host_path_regex_rewrite_substitution":  true,
+	"Route.host_rewrite":                          true,
+	"Route.host_rewrite_header":                   true,
+	"Route.id":                                    true,
+	"Route.idp_client_id":                         true,
+	"Route.kubernetes_service_account_token_file": true,
+	"Route.name":                                  true,
+	"Route.path":                                  true,
+	"Route.prefix":                                true,
+	"Route.prefix_rewrite":                        true,
+	"Route.regex":                                 true,
+	"Route.regex_rewrite_pattern":                 true,
+	"Route.regex_rewrite_substitution":            true,
+	"Route.remove_request_headers":                true,
+	"Route.set_response_headers":                  true,
+	"Route.tls_client_cert":                       true,
+	"Route.tls_client_cert_file":                  true,
+	"Route.tls_client_key_file":                   true,
+	"Route.tls_custom_ca":                         true,
+	"Route.tls_custom_ca_file":                    true,
+	"Route.tls_downstream_client_ca":              true,
+	"Route.tls_downstream_client_ca_file":         true,
+	"Route.tls_downstream_server_name":            true,
+	"Route.tls_server_name":                       true,
+	"Route.tls_upstream_server_name":              true,
+	"Route.to":                                    true,
+
+	"RouteDirectResponse.body": true,
+
+	"RouteHealthCheck.expected_statuses": true,
+	"RouteHealthCheck.grpc_service_name": true,
+	"RouteHealthCheck.host":              true,
+	"RouteHealthCheck.path":              true,
+	"RouteHealthCheck.type":              true,
+
+	"RouteLoadBalancingPolicy.algorithm":   true,
+	"RouteLoadBalancingPolicy.cookie_name": true,
+	"RouteLoadBalancingPolicy.cookie_path": true,
+	"RouteLoadBalancingPolicy.header_name": true,
+	"RouteLoadBalancingPolicy.type":        true,
+
+	"RouteRateLimit.header_name": true,
+	"RouteRateLimit.key":         true,
+
+	"RouteRedirect.host_redirect":   true,
+	"RouteRedirect.path_redirect":   true,
+	"RouteRedirect.prefix_rewrite":  true,
+	"RouteRedirect.scheme_redirect": true,
+
+	"RouteRetryPolicy.retry_on": true,
+
+	"RouteRewriteHeader.header": true,
+	"RouteRewriteHeader.prefix": true,
+	"RouteRewriteHeader.value":  true,
+
+	"SANMatcher.pattern": true,
+
+	"Settings.Certificate.cert_bytes": true,
+	"Settings.Certificate.id":         true,
+
+	"Settings.IdentityProvider.display_name":        true,
+	"Settings.IdentityProvider.domains":             true,
+	"Settings.IdentityProvider.idp_client_id":       true,
+	"Settings.IdentityProvider.idp_provider":        true,
+	"Settings.IdentityProvider.idp_provider_url":    true,
+	"Settings.IdentityProvider.idp_saml_attributes": true,
+	"Settings.IdentityProvider.name":                true,
+	"Settings.IdentityProvider.request_params":      true,
+	"Settings.IdentityProvider.scopes":              true,
+
+	"Settings.StringList.values": true,
+
+	"Settings.address":                                true,
+	"Settings.authenticate_callback_path":             true,
+	"Settings.authenticate_internal_service_url":      true,
+	"Settings.authenticate_service_url":               true,
+	"Settings.authorize_internal_service_url":         true,
+	"Settings.authorize_service_urls":                 true,
+	"Settings.autocert_ca":                            true,
+	"Settings.autocert_dir":                           true,
+	"Settings.autocert_eab_key_id":                    true,
+	"Settings.autocert_email":                         true,
+	"Settings.autocert_trusted_ca":                    true,
+	"Settings.certificate_authority":                  true,
+	"Settings.cookie_domain":                          true,
+	"Settings.cookie_name":                            true,
+	"Settings.cookie_same_site":                       true,
+	"Settings.darkmode_primary_color":                 true,
+	"Settings.darkmode_secondary_color":               true,
+	"Settings.databroker_internal_service_url":        true,
+	"Settings.databroker_service_urls":                true,
+	"Settings.databroker_storage_type":                true,
+	"Settings.derive_tls":                             true,
+	"Settings.dns_lookup_family":                      true,
+	"Settings.envoy_admin_access_log_path":            true,
+	"Settings.envoy_admin_address":                    true,
+	"Settings.envoy_admin_profile_path":               true,
+	"Settings.envoy_bind_config_source_address":       true,
+	"Settings.error_message_first_paragraph":          true,
+	"Settings.favicon_url":                            true,
+	"Settings.geoip_database_file":                    true,
+	"Settings.grpc_address":                           true,
+	"Settings.http_redirect_addr":                     true,
+	"Settings.idp_client_id":                          true,
+	"Settings.idp_provider":                           true,
+	"Settings.idp_provider_url":                       true,
+	"Settings.idp_saml_attributes":                    true,
+	"Settings.installation_id":                        true,
+	"Settings.jwt_claims_headers":                     true,
+	"Settings.log_level":                              true,
+	"Settings.logo_url":                               true,
+	"Settings.metrics_address":                        true,
+	"Settings.metrics_client_ca":                      true,
+	"Settings.override_certificate_name":              true,
+	"Settings.primary_color":                          true,
+	"Settings.programmatic_redirect_domain_whitelist": true,
+	"Settings.proxy_log_level":                        true,
+	"Settings.request_params":                         true,
+	"Settings.runtime_flags":                          true,
+	"Settings.scopes":                                 true,
+	"Settings.secondary_color":                        true,
+	"Settings.services":                               true,
+	"Settings.set_response_headers":                   true,
+	"Settings.signout_redirect_url":                   true,
+	"Settings.tracing_datadog_address":                true,
+	"Settings.tracing_jaeger_agent_endpoint":          true,
+	"Settings.tracing_jaeger_collector_endpoint":      true,
+	"Settings.tracing_provider":                       true,
+	"Settings.tracing_zipkin_endpoint":                true,
+
+	"pomerium.crypt.PublicKeyEncryptionKey.data": true,
+	"pomerium.crypt.PublicKeyEncryptionKey.id":   true,
+}
+
+// configPackage is the protobuf package of the config messages.
+const configPackage protoreflect.FullName = "pomerium.config"
+
+func isUnredactedField(fd protoreflect.FieldDescriptor) bool {
+	name := fd.FullName()
+	if fd.ParentFile().Package() == configPackage {
+		name = name[len(configPackage)+1:]
+	}
+	return unredactedFields[name]
+}
+
+// redactSecrets replaces any secrets in the message, and the messages it
+// contains, with a placeholder.
+func redactSecrets(m protoreflect.Message) {
+	var secrets []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				redactMessage(v.Message())
+				return true
+			})
+		case fd.IsMap():
+			if !isUnredactedField(fd) {
+				secrets = append(secrets, fd)
+			}
+		case fd.Message() != nil && fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				redactMessage(v.List().Get(i).Message())
+			}
+		case fd.Message() != nil:
+			redactMessage(v.Message())
+		case !isUnredactedField(fd):
+			secrets = append(secrets, fd)
+		}
+		return true
+	})
+	for _, fd := range secrets {
+		switch {
+		case fd.IsMap():
+			redactMapValues(fd, m.Mutable(fd).Map())
+		case fd.IsList():
+			redactListValues(fd, m.Mutable(fd).List())
+		default:
+			if v, ok := redactedValue(fd.Kind()); ok {
+				m.Set(fd, v)
+			}
+		}
+	}
+}
+
+// redactMessage redacts the secrets in a message contained in the config.
+// Messages from other packages, such as Envoy cluster options, only have
+// their inline data sources redacted.
+func redactMessage(m protoreflect.Message) {
+	if !strings.HasPrefix(string(m.Descriptor().FullName()), "pomerium.") {
+		redactDataSources(m)
+		return
+	}
+	redactSecrets(m)
+}
+
+func redactedValue(kind protoreflect.Kind) (protoreflect.Value, bool) {
+	switch kind {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(redacted), true
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(redacted)), true
+	}
+	return protoreflect.Value{}, false
+}
+
+func redactListValues(fd protoreflect.FieldDescriptor, l protoreflect.List) {
+	v, ok := redactedValue(fd.Kind())
+	if !ok {
+		return
+	}
+	for i := 0; i < l.Len(); i++ {
+		l.Set(i, v)
+	}
+}
+
+func redactMapValues(fd protoreflect.FieldDescriptor, m protoreflect.Map) {
+	v, ok := redactedValue(fd.MapValue().Kind())
+	if !ok {
+		return
+	}
+	var keys []protoreflect.MapKey
+	m.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	for _, k := range keys {
+		m.Set(k, v)
+	}
+}
+
+// dataSourceName is the name of the Envoy message holding inline data, which
+// may be a private key if it couldn't be written to a file.
+const dataSourceName protoreflect.FullName = "envoy.config.core.v3.DataSource"
+
+// redactDataSources replaces the inline data of any Envoy data sources in the
+// message, and the messages it contains, with a placeholder.
+func redactDataSources(m protoreflect.Message) {
+	if !m.IsValid() {
+		return
+	}
+
+	switch {
+	case m.Descriptor().FullName() == dataSourceName:
+		fields := m.Descriptor().Fields()
+		if fd := fields.ByName("inline_bytes"); m.Has(fd) {
+			m.Set(fd, protoreflect.ValueOfBytes([]byte(redacted)))
+		}
+		if fd := fields.ByName("inline_string"); m.Has(fd) {
+			m.Set(fd, protoreflect.ValueOfString(redacted))
+		}
+		return
+	case m.Descriptor().FullName() == "google.protobuf.Any":
+		// TLS contexts are stored in typed configs
+		a, ok := m.Interface().(*anypb.Any)
+		if !ok {
+			return
+		}
+		msg, err := a.UnmarshalNew()
+		if err != nil {
+			return
+		}
+		redactDataSources(msg.ProtoReflect())
+		_ = a.MarshalFrom(msg)
+		return
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					redactDataSources(v.Message())
+					return true
+				})
+			}
+		case fd.Message() != nil && fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				redactDataSources(v.List().Get(i).Message())
+			}
+		case fd.Message() != nil:
+			redactDataSources(v.Message())
+		}
+		return true
+	})
+}
@@ -23,17 +23,29 @@ import (
 func main() {
 	convertOldStyleFlags()
 	var configFile string
+	var remoteConfig remoteConfigFlags
 	root := &cobra.Command{
 		Use:          "pomerium",
 		Version:      fmt.Sprintf("pomerium: %s\nenvoy: %s", version.FullVersion(), files.FullVersion()),
 		SilenceUsage: true,
 	}
 	root.AddCommand(zero_cmd.BuildRootCmd())
-	root.PersistentFlags().StringVar(&configFile, "config", "", "Specify configuration file location")
+	root.AddCommand(policyCommand(&configFile))
+	root.AddCommand(auditCommand())
+	root.AddCommand(sessionsCommand(&configFile))
+	root.AddCommand(serviceAccountsCommand(&configFile))
+	root.AddCommand(passkeysCommand(&configFile))
+	root.AddCommand(usersCommand(&configFile))
+	root.AddCommand(validateCommand(&configFile))
+	root.AddCommand(configCommand(&configFile))
+	root.PersistentFlags().StringVar(&configFile, "config", "", "Specify configuration file location, or an https url to retrieve it from")
+	remoteConfig.addFlags(root.Flags())
 
 	ctx := context.Background()
 	log.SetLevel(zerolog.InfoLevel)
-	runFn := run
+	runFn := func(ctx context.Context, configFile string) error {
+		return run(ctx, configFile, &remoteConfig)
+	}
 	if zero_cmd.IsManagedMode(configFile) {
 		runFn = zero_cmd.Run
 	}
@@ -47,14 +59,18 @@ func main() {
 	}
 }
 
-func run(ctx context.Context, configFile string) error {
+func run(ctx context.Context, configFile string, remoteConfig *remoteConfigFlags) error {
 	ctx = log.WithContext(ctx, func(c zerolog.Context) zerolog.Context {
 		return c.Str("config_file_source", configFile).Bool("bootstrap", true)
 	})
 
 	var src config.Source
-
-	src, err := config.NewFileOrEnvironmentSource(ctx, configFile, files.FullVersion())
+	var err error
+	if isRemoteConfig(configFile) {
+		src, err = newRemoteConfigSource(ctx, configFile, remoteConfig)
+	} else {
+		src, err = config.NewFileOrEnvironmentSource(ctx, configFile, files.FullVersion())
+	}
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+func passkeysCommand(configFile *string) *cobra.Command {
+	var databrokerURL string
+	cmd := &cobra.Command{
+		Use:   "passkey",
+		Short: "Manage users of the passkey identity provider",
+		Long: `Manage users of the passkey identity provider, who sign in with passkeys
+stored in the databroker of a running pomerium rather than with an upstream
+identity provider.`,
+	}
+	cmd.PersistentFlags().StringVar(&databrokerURL, "databroker-url", "", "databroker url, defaults to the databroker_service_url from the configuration")
+	cmd.AddCommand(passkeysInviteCommand(configFile, &databrokerURL))
+	return cmd
+}
+
+func passkeysInviteCommand(configFile *string, databrokerURL *string) *cobra.Command {
+	var flags struct {
+		userID    string
+		email     string
+		name      string
+		expiresIn time.Duration
+		json      bool
+	}
+	cmd := &cobra.Command{
+		Use:   "invite",
+		Short: "Create a user and print a one-time passkey enrollment link",
+		Long: `Create or update a user and print a one-time link the user can open to
+enroll a passkey. The link can only be used once and expires after
+--expires-in.`,
+		Example: `  pomerium passkey invite --config config.yaml --email alice@example.com --name Alice`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			req := &user.CreatePasskeyEnrollmentRequest{
+				UserId: flags.userID,
+				Email:  flags.email,
+				Name:   flags.name,
+			}
+			if flags.expiresIn != 0 {
+				req.ExpiresIn = durationpb.New(flags.expiresIn)
+			}
+
+			cc, err := dialDataBroker(cmd.Context(), *configFile, *databrokerURL)
+			if err != nil {
+				return err
+			}
+			defer cc.Close()
+
+			res, err := user.NewPasskeyAdminClient(cc).CreatePasskeyEnrollment(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			if flags.json {
+				return printProtoJSON(cmd.OutOrStdout(), res)
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), res.GetEnrollmentUrl())
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&flags.userID, "user-id", "", "the id of the user, defaults to the email")
+	cmd.Flags().StringVar(&flags.email, "email", "", "the email of the user")
+	cmd.Flags().StringVar(&flags.name, "name", "", "the name of the user")
+	cmd.Flags().DurationVar(&flags.expiresIn, "expires-in", 0, "how long the enrollment link is valid for, defaults to 24h")
+	cmd.Flags().BoolVar(&flags.json, "json", false, "print the user and enrollment link as JSON")
+	return cmd
+}
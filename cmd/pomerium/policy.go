@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pomerium/pomerium/authorize"
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/envoy/files"
+	policypb "github.com/pomerium/pomerium/pkg/grpc/policy"
+)
+
+func policyCommand(configFile *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy",
+		Short: "Work with route policies",
+	}
+	cmd.AddCommand(policyEvalCommand(configFile))
+	return cmd
+}
+
+func policyEvalCommand(configFile *string) *cobra.Command {
+	var flags struct {
+		input    string
+		routeID  string
+		url      string
+		method   string
+		headers  []string
+		ip       string
+		userID   string
+		email    string
+		name     string
+		idpID    string
+		claims   []string
+		groups   []string
+		devices  []string
+		approved bool
+	}
+	cmd := &cobra.Command{
+		Use:   "eval",
+		Short: "Evaluate a route's policy for a synthetic request",
+		Long: `Evaluate a route's policy for a synthetic request and print the decision,
+the reasons for it and the identity headers which would be set.
+
+The request is described with flags, or with a JSON file containing an
+EvaluateRequest. Flags override the values in the file. No session is created
+and the databroker is not used.`,
+		Example: `  pomerium policy eval --config config.yaml --url https://admin.example.com/ \
+    --user-id u1 --email jane@example.com --group admins --claim department=engineering`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			req := new(policypb.EvaluateRequest)
+			if flags.input != "" {
+				bs, err := os.ReadFile(flags.input)
+				if err != nil {
+					return err
+				}
+				if err := protojson.Unmarshal(bs, req); err != nil {
+					return fmt.Errorf("invalid input: %w", err)
+				}
+			}
+
+			setString := func(name string, dst *string, value string) {
+				if cmd.Flags().Changed(name) {
+					*dst = value
+				}
+			}
+			setString("route", &req.RouteId, flags.routeID)
+			setString("url", &req.Url, flags.url)
+			setString("method", &req.Method, flags.method)
+			setString("ip", &req.Ip, flags.ip)
+			for _, h := range flags.headers {
+				k, v, ok := strings.Cut(h, ":")
+				if !ok {
+					return fmt.Errorf("invalid header, expected NAME:VALUE: %s", h)
+				}
+				if req.Headers == nil {
+					req.Headers = make(map[string]string)
+				}
+				req.Headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+			}
+
+			if flags.userID != "" || req.Identity != nil {
+				if req.Identity == nil {
+					req.Identity = new(policypb.Identity)
+				}
+				id := req.Identity
+				setString("user-id", &id.UserId, flags.userID)
+				setString("email", &id.Email, flags.email)
+				setString("name", &id.Name, flags.name)
+				setString("idp-id", &id.IdpId, flags.idpID)
+				for _, c := range flags.claims {
+					k, v, ok := strings.Cut(c, "=")
+					if !ok {
+						return fmt.Errorf("invalid claim, expected KEY=VALUE: %s", c)
+					}
+					if id.Claims == nil {
+						id.Claims = new(structpb.Struct)
+					}
+					if id.Claims.Fields == nil {
+						id.Claims.Fields = make(map[string]*structpb.Value)
+					}
+					id.Claims.Fields[k] = structpb.NewStringValue(v)
+				}
+				for _, g := range flags.groups {
+					groupID, name, ok := strings.Cut(g, "=")
+					if !ok {
+						name = groupID
+					}
+					id.Groups = append(id.Groups, &policypb.Group{Id: groupID, Name: name})
+				}
+				for _, d := range flags.devices {
+					typeID, credentialID, ok := strings.Cut(d, ":")
+					if !ok {
+						typeID, credentialID = "", d
+					}
+					id.Devices = append(id.Devices, &policypb.Device{
+						TypeId:       typeID,
+						CredentialId: credentialID,
+						Approved:     flags.approved,
+					})
+				}
+			}
+
+			res, err := evaluatePolicy(cmd.Context(), *configFile, req)
+			if err != nil {
+				return err
+			}
+			bs, err := protojson.MarshalOptions{Multiline: true, EmitUnpopulated: true}.Marshal(res)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bs))
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&flags.input, "input", "", "JSON file containing the request")
+	cmd.Flags().StringVar(&flags.routeID, "route", "", "id, or generated route id, of the route to evaluate, defaults to the route matching the url")
+	cmd.Flags().StringVar(&flags.url, "url", "", "request url")
+	cmd.Flags().StringVar(&flags.method, "method", "", "request method (default GET)")
+	cmd.Flags().StringArrayVar(&flags.headers, "header", nil, "request header as NAME:VALUE")
+	cmd.Flags().StringVar(&flags.ip, "ip", "", "client IP address")
+	cmd.Flags().StringVar(&flags.userID, "user-id", "", "user id, the request is unauthenticated if not set")
+	cmd.Flags().StringVar(&flags.email, "email", "", "user email")
+	cmd.Flags().StringVar(&flags.name, "name", "", "user name")
+	cmd.Flags().StringVar(&flags.idpID, "idp-id", "", "identity provider id")
+	cmd.Flags().StringArrayVar(&flags.claims, "claim", nil, "identity provider claim as KEY=VALUE")
+	cmd.Flags().StringArrayVar(&flags.groups, "group", nil, "directory group as ID or ID=NAME")
+	cmd.Flags().StringArrayVar(&flags.devices, "device", nil, "device credential as ID or TYPE:ID")
+	cmd.Flags().BoolVar(&flags.approved, "device-approved", false, "treat the devices as approved")
+	return cmd
+}
+
+func evaluatePolicy(ctx context.Context, configFile string, req *policypb.EvaluateRequest) (*policypb.EvaluateResponse, error) {
+	setupCommandLogger()
+
+	src, err := config.NewFileOrEnvironmentSource(ctx, configFile, files.FullVersion())
+	if err != nil {
+		return nil, err
+	}
+	cfg := src.GetConfig()
+
+	a, err := authorize.New(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	a.OnConfigChange(ctx, cfg)
+
+	return a.DryRun(ctx, req)
+}
+
+// setupCommandLogger sends logs to stderr, so they don't mix with command
+// output, and only logs warnings and errors.
+func setupCommandLogger() {
+	log.Writer.Remove(os.Stdout)
+	log.Writer.Add(os.Stderr)
+	log.SetLevel(zerolog.WarnLevel)
+}
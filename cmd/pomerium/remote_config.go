@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/envoy/files"
+)
+
+// remoteConfigFlags are the flags used when the configuration is retrieved
+// from a remote https server, since they can't be part of the configuration.
+type remoteConfigFlags struct {
+	publicKey      string
+	signatureURL   string
+	clientCertFile string
+	clientKeyFile  string
+	caFile         string
+	pollInterval   time.Duration
+}
+
+func (f *remoteConfigFlags) addFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&f.publicKey, "config-public-key", "",
+		"ed25519 public key, base64 or PEM encoded, or a file containing it, used to verify a remote config")
+	flags.StringVar(&f.signatureURL, "config-signature-url", "",
+		"url of the detached signature of a remote config (default config url + \".sig\")")
+	flags.StringVar(&f.clientCertFile, "config-client-cert", "", "client certificate file used to retrieve a remote config")
+	flags.StringVar(&f.clientKeyFile, "config-client-key", "", "client certificate key file used to retrieve a remote config")
+	flags.StringVar(&f.caFile, "config-ca-file", "", "CA file used to verify the remote config server")
+	flags.DurationVar(&f.pollInterval, "config-poll-interval", config.DefaultRemoteSourcePollInterval,
+		"how often a remote config is checked for changes")
+}
+
+func isRemoteConfig(configFile string) bool {
+	return strings.HasPrefix(configFile, "https://")
+}
+
+func newRemoteConfigSource(ctx context.Context, configURL string, f *remoteConfigFlags) (*config.RemoteSource, error) {
+	options := config.RemoteSourceOptions{
+		URL:          configURL,
+		SignatureURL: f.signatureURL,
+		PollInterval: f.pollInterval,
+	}
+
+	var err error
+	options.PublicKey, err = parseEd25519PublicKey(f.publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --config-public-key: %w", err)
+	}
+
+	if f.clientCertFile != "" || f.clientKeyFile != "" {
+		options.ClientCertificate, err = cryptutil.CertificateFromFile(f.clientCertFile, f.clientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid remote config client certificate: %w", err)
+		}
+	}
+
+	if f.caFile != "" {
+		options.RootCAs, err = cryptutil.GetCertPool("", f.caFile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --config-ca-file: %w", err)
+		}
+	}
+
+	return config.NewRemoteSource(ctx, options, files.FullVersion())
+}
+
+// parseEd25519PublicKey parses a raw base64 encoded or PKIX PEM encoded
+// ed25519 public key, or reads it from a file.
+func parseEd25519PublicKey(raw string) (ed25519.PublicKey, error) {
+	if raw == "" {
+		return nil, errors.New("a public key is required to verify a remote config")
+	}
+	if bs, err := os.ReadFile(raw); err == nil {
+		raw = string(bs)
+	}
+	raw = strings.TrimSpace(raw)
+
+	if block, _ := pem.Decode([]byte(raw)); block != nil {
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		publicKey, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("unsupported public key type: %T", key)
+		}
+		return publicKey, nil
+	}
+
+	bs, err := base64.StdEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(bs) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("expected %d bytes, got %d", ed25519.PublicKeySize, len(bs))
+	}
+	return ed25519.PublicKey(bs), nil
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+type serviceAccountsFlags struct {
+	databrokerURL string
+}
+
+func serviceAccountsCommand(configFile *string) *cobra.Command {
+	var flags serviceAccountsFlags
+	cmd := &cobra.Command{
+		Use:   "service-account",
+		Short: "Create, list, rotate and revoke service accounts",
+		Long: `Create, list, rotate and revoke service accounts stored in the databroker of a
+running pomerium. Requests are signed with the shared secret from the
+configuration, which is also used to sign the service account JWTs.`,
+	}
+	cmd.PersistentFlags().StringVar(&flags.databrokerURL, "databroker-url", "", "databroker url, defaults to the databroker_service_url from the configuration")
+	cmd.AddCommand(
+		serviceAccountsCreateCommand(configFile, &flags),
+		serviceAccountsListCommand(configFile, &flags),
+		serviceAccountsRotateCommand(configFile, &flags),
+		serviceAccountsRevokeCommand(configFile, &flags),
+	)
+	return cmd
+}
+
+func serviceAccountsCreateCommand(configFile *string, serviceAccountsFlags *serviceAccountsFlags) *cobra.Command {
+	var flags struct {
+		userID      string
+		namespaceID string
+		description string
+		expiresIn   time.Duration
+		json        bool
+	}
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a service account and print its JWT",
+		Long: `Create a service account and print its JWT. The JWT is only shown once: it
+is not stored and cannot be retrieved later.`,
+		Example: `  pomerium service-account create --config config.yaml --user-id ci-bot --description "CI deploys" --expires-in 720h`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			req := &user.CreateServiceAccountRequest{
+				UserId: flags.userID,
+			}
+			if flags.namespaceID != "" {
+				req.NamespaceId = &flags.namespaceID
+			}
+			if flags.description != "" {
+				req.Description = &flags.description
+			}
+			if flags.expiresIn != 0 {
+				req.ExpiresIn = durationpb.New(flags.expiresIn)
+			}
+			return withServiceAccountAdminClient(cmd.Context(), *configFile, serviceAccountsFlags, func(client user.ServiceAccountAdminClient) error {
+				res, err := client.CreateServiceAccount(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				if flags.json {
+					return printProtoJSON(cmd.OutOrStdout(), res)
+				}
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), res.GetJwt())
+				return err
+			})
+		},
+	}
+	cmd.Flags().StringVar(&flags.userID, "user-id", "", "the user id the service account acts as")
+	cmd.Flags().StringVar(&flags.namespaceID, "namespace", "", "the namespace of the service account")
+	cmd.Flags().StringVar(&flags.description, "description", "", "a description of the service account")
+	cmd.Flags().DurationVar(&flags.expiresIn, "expires-in", 0, "how long the service account is valid for, defaults to never expiring")
+	cmd.Flags().BoolVar(&flags.json, "json", false, "print the service account and its JWT as JSON")
+	_ = cmd.MarkFlagRequired("user-id")
+	return cmd
+}
+
+func serviceAccountsListCommand(configFile *string, serviceAccountsFlags *serviceAccountsFlags) *cobra.Command {
+	var flags struct {
+		userID      string
+		namespaceID string
+		unusedFor   time.Duration
+		json        bool
+	}
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List service accounts",
+		Long: `List service accounts, least recently accessed first. Use --unused-for to find
+service accounts that have not been used recently and can be revoked.`,
+		Example: `  pomerium service-account list --config config.yaml --unused-for 2160h`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			req := &user.ListServiceAccountsRequest{
+				UserId:      flags.userID,
+				NamespaceId: flags.namespaceID,
+			}
+			if flags.unusedFor != 0 {
+				req.NotAccessedSince = timestamppb.New(time.Now().Add(-flags.unusedFor))
+			}
+			return withServiceAccountAdminClient(cmd.Context(), *configFile, serviceAccountsFlags, func(client user.ServiceAccountAdminClient) error {
+				res, err := client.ListServiceAccounts(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				if flags.json {
+					return printProtoJSON(cmd.OutOrStdout(), res)
+				}
+				return printServiceAccounts(cmd.OutOrStdout(), res.GetServiceAccounts())
+			})
+		},
+	}
+	cmd.Flags().StringVar(&flags.userID, "user-id", "", "only list service accounts for the user with this id")
+	cmd.Flags().StringVar(&flags.namespaceID, "namespace", "", "only list service accounts in this namespace")
+	cmd.Flags().DurationVar(&flags.unusedFor, "unused-for", 0, "only list service accounts that have not been accessed for this long")
+	cmd.Flags().BoolVar(&flags.json, "json", false, "print the service accounts as JSON")
+	return cmd
+}
+
+func serviceAccountsRotateCommand(configFile *string, serviceAccountsFlags *serviceAccountsFlags) *cobra.Command {
+	var flags struct {
+		json bool
+	}
+	cmd := &cobra.Command{
+		Use:   "rotate SERVICE_ACCOUNT_ID",
+		Short: "Replace a service account and print the new JWT",
+		Long: `Replace a service account with a new one with the same user, namespace,
+description and validity period, and print the new JWT. The old JWT stops
+working immediately.`,
+		Example: `  pomerium service-account rotate --config config.yaml 8c1b4d1e-0d2a-4b8e-9a55-6f2d1c3b7a90`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withServiceAccountAdminClient(cmd.Context(), *configFile, serviceAccountsFlags, func(client user.ServiceAccountAdminClient) error {
+				res, err := client.RotateServiceAccount(cmd.Context(), &user.RotateServiceAccountRequest{
+					ServiceAccountId: args[0],
+				})
+				if err != nil {
+					return err
+				}
+				if flags.json {
+					return printProtoJSON(cmd.OutOrStdout(), res)
+				}
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), res.GetJwt())
+				return err
+			})
+		},
+	}
+	cmd.Flags().BoolVar(&flags.json, "json", false, "print the new service account and its JWT as JSON")
+	return cmd
+}
+
+func serviceAccountsRevokeCommand(configFile *string, serviceAccountsFlags *serviceAccountsFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:     "revoke SERVICE_ACCOUNT_ID...",
+		Short:   "Revoke service accounts",
+		Example: `  pomerium service-account revoke --config config.yaml 8c1b4d1e-0d2a-4b8e-9a55-6f2d1c3b7a90`,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withServiceAccountAdminClient(cmd.Context(), *configFile, serviceAccountsFlags, func(client user.ServiceAccountAdminClient) error {
+				res, err := client.RevokeServiceAccounts(cmd.Context(), &user.RevokeServiceAccountsRequest{
+					ServiceAccountIds: args,
+				})
+				if err != nil {
+					return err
+				}
+				for _, id := range res.GetServiceAccountIds() {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s: revoked\n", id)
+				}
+				return nil
+			})
+		},
+	}
+}
+
+// withServiceAccountAdminClient connects to the databroker using the
+// configuration and calls fn with a service account admin client.
+func withServiceAccountAdminClient(
+	ctx context.Context,
+	configFile string,
+	flags *serviceAccountsFlags,
+	fn func(client user.ServiceAccountAdminClient) error,
+) error {
+	cc, err := dialDataBroker(ctx, configFile, flags.databrokerURL)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	return fn(user.NewServiceAccountAdminClient(cc))
+}
+
+func printServiceAccounts(w io.Writer, serviceAccounts []*user.ServiceAccount) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tUSER ID\tNAMESPACE\tDESCRIPTION\tISSUED\tACCESSED\tEXPIRES")
+	for _, sa := range serviceAccounts {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			sa.GetId(),
+			sa.GetUserId(),
+			orDash(sa.GetNamespaceId()),
+			orDash(sa.GetDescription()),
+			formatSessionTime(sa.GetIssuedAt()),
+			formatSessionTime(sa.GetAccessedAt()),
+			formatSessionTime(sa.GetExpiresAt()))
+	}
+	return tw.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/envoy/files"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+type sessionsFlags struct {
+	databrokerURL string
+}
+
+func sessionsCommand(configFile *string) *cobra.Command {
+	var flags sessionsFlags
+	cmd := &cobra.Command{
+		Use:   "sessions",
+		Short: "List, inspect and revoke user sessions",
+		Long: `List, inspect and revoke user sessions stored in the databroker of a running
+pomerium. Requests are signed with the shared secret from the configuration.`,
+	}
+	cmd.PersistentFlags().StringVar(&flags.databrokerURL, "databroker-url", "", "databroker url, defaults to the databroker_service_url from the configuration")
+	cmd.AddCommand(
+		sessionsListCommand(configFile, &flags),
+		sessionsShowCommand(configFile, &flags),
+		sessionsRevokeCommand(configFile, &flags),
+	)
+	return cmd
+}
+
+func sessionsListCommand(configFile *string, sessionsFlags *sessionsFlags) *cobra.Command {
+	var flags struct {
+		userID string
+		email  string
+		json   bool
+	}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List sessions",
+		Example: `  pomerium sessions list --config config.yaml --email jane@example.com`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withSessionAdminClient(cmd.Context(), *configFile, sessionsFlags, func(client session.SessionAdminClient) error {
+				res, err := client.ListSessions(cmd.Context(), &session.ListSessionsRequest{
+					UserId: flags.userID,
+					Email:  flags.email,
+				})
+				if err != nil {
+					return err
+				}
+				if flags.json {
+					return printProtoJSON(cmd.OutOrStdout(), res)
+				}
+				return printSessions(cmd.OutOrStdout(), res.GetSessions())
+			})
+		},
+	}
+	cmd.Flags().StringVar(&flags.userID, "user-id", "", "only list sessions for the user with this id")
+	cmd.Flags().StringVar(&flags.email, "email", "", "only list sessions for users with this email")
+	cmd.Flags().BoolVar(&flags.json, "json", false, "print the sessions as JSON")
+	return cmd
+}
+
+func sessionsShowCommand(configFile *string, sessionsFlags *sessionsFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:     "show SESSION_ID",
+		Short:   "Show a session as JSON",
+		Example: `  pomerium sessions show --config config.yaml 8c1b4d1e-0d2a-4b8e-9a55-6f2d1c3b7a90`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withSessionAdminClient(cmd.Context(), *configFile, sessionsFlags, func(client session.SessionAdminClient) error {
+				res, err := client.GetSession(cmd.Context(), &session.GetSessionRequest{
+					SessionId: args[0],
+				})
+				if err != nil {
+					return err
+				}
+				return printProtoJSON(cmd.OutOrStdout(), res)
+			})
+		},
+	}
+}
+
+func sessionsRevokeCommand(configFile *string, sessionsFlags *sessionsFlags) *cobra.Command {
+	var flags struct {
+		userID string
+		email  string
+	}
+	cmd := &cobra.Command{
+		Use:   "revoke [SESSION_ID]...",
+		Short: "Revoke sessions",
+		Long: `Revoke sessions by id, or all the sessions of a user. The OAuth refresh and
+access tokens of each session are revoked with its identity provider, and the
+session is deleted. A session is deleted even if its tokens could not be
+revoked.`,
+		Example: `  pomerium sessions revoke --config config.yaml --user-id 3f8b2c1a`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && flags.userID == "" && flags.email == "" {
+				return errors.New("a session id, --user-id or --email is required")
+			}
+			return withSessionAdminClient(cmd.Context(), *configFile, sessionsFlags, func(client session.SessionAdminClient) error {
+				res, err := client.RevokeSessions(cmd.Context(), &session.RevokeSessionsRequest{
+					SessionIds: args,
+					UserId:     flags.userID,
+					Email:      flags.email,
+				})
+				if err != nil {
+					return err
+				}
+				for _, result := range res.GetResults() {
+					msg := "deleted"
+					var revoked []string
+					if result.GetRefreshTokenRevoked() {
+						revoked = append(revoked, "refresh token")
+					}
+					if result.GetTokenRevoked() {
+						revoked = append(revoked, "access token")
+					}
+					if len(revoked) > 0 {
+						msg += ", revoked " + strings.Join(revoked, " and ")
+					}
+					if result.GetError() != "" {
+						msg += fmt.Sprintf(", failed to revoke tokens: %s", result.GetError())
+					}
+					fmt.Fprintf(cmd.OutOrStdout(), "%s: %s\n", result.GetSessionId(), msg)
+				}
+				return nil
+			})
+		},
+	}
+	cmd.Flags().StringVar(&flags.userID, "user-id", "", "revoke all sessions for the user with this id")
+	cmd.Flags().StringVar(&flags.email, "email", "", "revoke all sessions for users with this email")
+	return cmd
+}
+
+// withSessionAdminClient connects to the databroker using the configuration
+// and calls fn with a session admin client.
+func withSessionAdminClient(
+	ctx context.Context,
+	configFile string,
+	flags *sessionsFlags,
+	fn func(client session.SessionAdminClient) error,
+) error {
+	cc, err := dialDataBroker(ctx, configFile, flags.databrokerURL)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	return fn(session.NewSessionAdminClient(cc))
+}
+
+func dialDataBroker(ctx context.Context, configFile, rawURL string) (*grpc.ClientConn, error) {
+	setupCommandLogger()
+
+	src, err := config.NewFileOrEnvironmentSource(ctx, configFile, files.FullVersion())
+	if err != nil {
+		return nil, err
+	}
+	options := src.GetConfig().Options
+
+	sharedKey, err := options.GetSharedKey()
+	if err != nil {
+		return nil, err
+	}
+
+	urls, err := options.GetDataBrokerURLs()
+	if err != nil {
+		return nil, err
+	}
+	if rawURL != "" {
+		u, err := urlutil.ParseAndValidateURL(rawURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid databroker url: %w", err)
+		}
+		urls = append(urls[:0], u)
+	}
+	if len(urls) == 0 {
+		return nil, errors.New("no databroker url configured")
+	}
+
+	return grpcutil.NewGRPCClientConn(ctx, &grpcutil.Options{
+		Address:                 urls[0],
+		OverrideCertificateName: options.OverrideCertificateName,
+		CA:                      options.CA,
+		CAFile:                  options.CAFile,
+		RequestTimeout:          options.GRPCClientTimeout,
+		ServiceName:             "databroker",
+		SignedJWTKey:            sharedKey,
+	})
+}
+
+func printSessions(w io.Writer, sessions []*session.SessionInfo) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tUSER ID\tEMAIL\tIDP ID\tISSUED\tACCESSED\tEXPIRES\tDEVICES")
+	for _, s := range sessions {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\n",
+			s.GetId(),
+			s.GetUserId(),
+			s.GetEmail(),
+			s.GetIdpId(),
+			formatSessionTime(s.GetIssuedAt()),
+			formatSessionTime(s.GetAccessedAt()),
+			formatSessionTime(s.GetExpiresAt()),
+			len(s.GetDeviceCredentials()))
+	}
+	return tw.Flush()
+}
+
+func formatSessionTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return "-"
+	}
+	return ts.AsTime().Local().Format(time.RFC3339)
+}
+
+func printProtoJSON(w io.Writer, msg proto.Message) error {
+	bs, err := protojson.MarshalOptions{Multiline: true}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(bs))
+	return err
+}
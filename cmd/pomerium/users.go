@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/identity/local"
+)
+
+type usersFlags struct {
+	databrokerURL string
+}
+
+type usersPasswordFlags struct {
+	passwordHash string
+	totp         bool
+}
+
+func usersCommand(configFile *string) *cobra.Command {
+	var flags usersFlags
+	cmd := &cobra.Command{
+		Use:   "users",
+		Short: "Manage users of the local identity provider",
+		Long: `Manage users of the local identity provider, who sign in with a username,
+password and optional TOTP code stored in the databroker of a running pomerium.
+
+Passwords are read from stdin and hashed with argon2id before they are sent to
+the databroker. Use --password-hash to set an existing argon2id or bcrypt hash
+instead.`,
+	}
+	cmd.PersistentFlags().StringVar(&flags.databrokerURL, "databroker-url", "", "databroker url, defaults to the databroker_service_url from the configuration")
+	cmd.AddCommand(
+		usersAddCommand(configFile, &flags),
+		usersPasswdCommand(configFile, &flags),
+	)
+	return cmd
+}
+
+func usersAddCommand(configFile *string, usersFlags *usersFlags) *cobra.Command {
+	var flags struct {
+		usersPasswordFlags
+		email  string
+		name   string
+		groups []string
+	}
+	cmd := &cobra.Command{
+		Use:   "add USER_ID",
+		Short: "Add a user",
+		Long: `Add a user, whose id is the username used to sign in. With --totp a TOTP
+secret is generated and its otpauth URL is printed, to be added to an
+authenticator app.`,
+		Example: `  echo -n "$PASSWORD" | pomerium users add --config config.yaml --email alice@example.com --group admins --totp alice`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			passwordHash, totpSecret, err := flags.usersPasswordFlags.credentials(cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			return withLocalUserAdminClient(cmd.Context(), *configFile, usersFlags, func(client user.LocalUserAdminClient) error {
+				res, err := client.AddLocalUser(cmd.Context(), &user.AddLocalUserRequest{
+					UserId:       args[0],
+					Email:        flags.email,
+					Name:         flags.name,
+					Groups:       flags.groups,
+					PasswordHash: passwordHash,
+					TotpSecret:   totpSecret,
+				})
+				if err != nil {
+					return err
+				}
+				return printTOTPURL(cmd.OutOrStdout(), res.GetUser().GetId(), totpSecret)
+			})
+		},
+	}
+	flags.usersPasswordFlags.register(cmd)
+	cmd.Flags().StringVar(&flags.email, "email", "", "the email of the user")
+	cmd.Flags().StringVar(&flags.name, "name", "", "the name of the user")
+	cmd.Flags().StringSliceVar(&flags.groups, "group", nil, "a group the user is a member of, may be repeated")
+	return cmd
+}
+
+func usersPasswdCommand(configFile *string, usersFlags *usersFlags) *cobra.Command {
+	var flags struct {
+		usersPasswordFlags
+		disableTOTP bool
+	}
+	cmd := &cobra.Command{
+		Use:   "passwd USER_ID",
+		Short: "Change the password of a user",
+		Long: `Change the password of a user, which also unlocks them if they were locked
+out after too many failed sign-in attempts. With --totp a new TOTP secret is
+generated and its otpauth URL is printed.`,
+		Example: `  echo -n "$PASSWORD" | pomerium users passwd --config config.yaml alice`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if flags.totp && flags.disableTOTP {
+				return errors.New("--totp and --disable-totp are mutually exclusive")
+			}
+			passwordHash, totpSecret, err := flags.usersPasswordFlags.credentials(cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			return withLocalUserAdminClient(cmd.Context(), *configFile, usersFlags, func(client user.LocalUserAdminClient) error {
+				_, err := client.SetLocalUserPassword(cmd.Context(), &user.SetLocalUserPasswordRequest{
+					UserId:       args[0],
+					PasswordHash: passwordHash,
+					TotpSecret:   totpSecret,
+					DisableTotp:  flags.disableTOTP,
+				})
+				if err != nil {
+					return err
+				}
+				return printTOTPURL(cmd.OutOrStdout(), args[0], totpSecret)
+			})
+		},
+	}
+	flags.usersPasswordFlags.register(cmd)
+	cmd.Flags().BoolVar(&flags.disableTOTP, "disable-totp", false, "stop requiring a TOTP code")
+	return cmd
+}
+
+func (flags *usersPasswordFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&flags.passwordHash, "password-hash", "", "an argon2id or bcrypt password hash, instead of reading the password from stdin")
+	cmd.Flags().BoolVar(&flags.totp, "totp", false, "generate a TOTP secret and require a TOTP code to sign in")
+}
+
+// credentials returns the password hash, read from the flags or hashed from
+// the password on stdin, and a new TOTP secret if requested.
+func (flags *usersPasswordFlags) credentials(stdin io.Reader) (passwordHash string, totpSecret *string, err error) {
+	passwordHash = flags.passwordHash
+	if passwordHash == "" {
+		password, err := bufio.NewReader(stdin).ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return "", nil, fmt.Errorf("error reading password: %w", err)
+		}
+		password = strings.TrimRight(password, "\r\n")
+		if password == "" {
+			return "", nil, errors.New("a password must be provided on stdin")
+		}
+		passwordHash, err = local.HashPassword(password)
+		if err != nil {
+			return "", nil, fmt.Errorf("error hashing password: %w", err)
+		}
+	} else if err := local.ValidatePasswordHash(passwordHash); err != nil {
+		return "", nil, err
+	}
+
+	if flags.totp {
+		secret, err := local.GenerateTOTPSecret()
+		if err != nil {
+			return "", nil, fmt.Errorf("error generating totp secret: %w", err)
+		}
+		totpSecret = &secret
+	}
+	return passwordHash, totpSecret, nil
+}
+
+func printTOTPURL(w io.Writer, userID string, totpSecret *string) error {
+	if totpSecret == nil {
+		return nil
+	}
+	_, err := fmt.Fprintln(w, local.TOTPURL("Pomerium", userID, *totpSecret))
+	return err
+}
+
+// withLocalUserAdminClient connects to the databroker using the
+// configuration and calls fn with a local user admin client.
+func withLocalUserAdminClient(
+	ctx context.Context,
+	configFile string,
+	flags *usersFlags,
+	fn func(client user.LocalUserAdminClient) error,
+) error {
+	cc, err := dialDataBroker(ctx, configFile, flags.databrokerURL)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	return fn(user.NewLocalUserAdminClient(cc))
+}
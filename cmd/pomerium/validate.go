@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+
+	envoy_config_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/spf13/cobra"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/config/envoyconfig"
+	"github.com/pomerium/pomerium/config/envoyconfig/filemgr"
+	"github.com/pomerium/pomerium/pkg/envoy/files"
+)
+
+func validateCommand(configFile *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the configuration",
+		Long: `Validate the configuration without starting pomerium.
+
+The options and every route are validated, each route's policy is compiled to
+rego and the Envoy configuration is generated and validated. All problems found
+are printed and the command exits non-zero if there are any.`,
+		Example: `  pomerium validate --config config.yaml`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			errs := validateConfig(cmd.Context(), *configFile)
+			if len(errs) > 0 {
+				for _, err := range errs {
+					fmt.Fprintln(cmd.ErrOrStderr(), err)
+				}
+				return fmt.Errorf("configuration is invalid: %d error(s)", len(errs))
+			}
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), "configuration is valid")
+			return err
+		},
+	}
+}
+
+func validateConfig(ctx context.Context, configFile string) []error {
+	setupCommandLogger()
+
+	cfg, err := loadConfig(ctx, configFile)
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+
+	// policies are compiled the same way the authorize service does
+	addDefaultClientCertificateRule := cfg.Options.HasAnyDownstreamMTLSClientCA() &&
+		cfg.Options.DownstreamMTLS.GetEnforcement() != config.MTLSEnforcementPolicy
+	validatePolicies := func(kind string, policies []config.Policy) {
+		for i := range policies {
+			p := &policies[i]
+			err := evaluator.ValidatePolicy(ctx, p, addDefaultClientCertificateRule)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s %d (%s): invalid policy: %w", kind, i, p.From, err))
+			}
+		}
+	}
+	validatePolicies("policy", cfg.Options.Policies)
+	validatePolicies("route", cfg.Options.Routes)
+	validatePolicies("additional policy", cfg.Options.AdditionalPolicies)
+
+	res, err := buildEnvoyResources(ctx, cfg)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if res.bootstrap != nil {
+		if err := res.bootstrap.ValidateAll(); err != nil {
+			errs = append(errs, fmt.Errorf("envoy: invalid bootstrap: %w", err))
+		}
+	}
+	for _, l := range res.listeners {
+		if err := l.ValidateAll(); err != nil {
+			errs = append(errs, fmt.Errorf("envoy: invalid listener %s: %w", l.GetName(), err))
+		}
+	}
+	for _, c := range res.clusters {
+		if err := c.ValidateAll(); err != nil {
+			errs = append(errs, fmt.Errorf("envoy: invalid cluster %s: %w", c.GetName(), err))
+		}
+	}
+	for _, rc := range res.routeConfigurations {
+		if err := rc.ValidateAll(); err != nil {
+			errs = append(errs, fmt.Errorf("envoy: invalid route configuration %s: %w", rc.GetName(), err))
+		}
+	}
+
+	return errs
+}
+
+// loadConfig loads and validates the configuration the same way pomerium does
+// on startup.
+func loadConfig(ctx context.Context, configFile string) (*config.Config, error) {
+	src, err := config.NewFileOrEnvironmentSource(ctx, configFile, files.FullVersion())
+	if err != nil {
+		return nil, err
+	}
+	return src.GetConfig(), nil
+}
+
+type envoyResources struct {
+	bootstrap           *envoy_config_bootstrap_v3.Bootstrap
+	listeners           []*envoy_config_listener_v3.Listener
+	clusters            []*envoy_config_cluster_v3.Cluster
+	routeConfigurations []*envoy_config_route_v3.RouteConfiguration
+}
+
+// buildEnvoyResources builds the Envoy bootstrap and the resources the
+// control plane would serve to Envoy for the config. Every resource type is
+// built even if another fails, so all errors are reported.
+//
+// Certificates and keys are written to a temporary directory, which is
+// removed before returning, rather than to the cache directory of a running
+// Envoy.
+func buildEnvoyResources(ctx context.Context, cfg *config.Config) (*envoyResources, error) {
+	cacheDir, err := os.MkdirTemp("", "pomerium-envoy-files-")
+	if err != nil {
+		return new(envoyResources), fmt.Errorf("envoy: error creating temporary directory: %w", err)
+	}
+	defer os.RemoveAll(cacheDir)
+
+	builder := envoyconfig.New(
+		net.JoinHostPort("127.0.0.1", cfg.GRPCPort),
+		net.JoinHostPort("127.0.0.1", cfg.HTTPPort),
+		net.JoinHostPort("127.0.0.1", cfg.MetricsPort),
+		filemgr.NewManager(filemgr.WithCacheDir(cacheDir)),
+		nil,
+	)
+
+	var res envoyResources
+	var errs []error
+	res.bootstrap, err = builder.BuildBootstrap(ctx, cfg, false)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("envoy: error building bootstrap: %w", err))
+	}
+	res.listeners, err = builder.BuildListeners(ctx, cfg, false)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("envoy: error building listeners: %w", err))
+	}
+	res.clusters, err = builder.BuildClusters(ctx, cfg)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("envoy: error building clusters: %w", err))
+	}
+	res.routeConfigurations, err = builder.BuildRouteConfigurations(ctx, cfg)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("envoy: error building route configurations: %w", err))
+	}
+	return &res, errors.Join(errs...)
+}
@@ -0,0 +1,298 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/config"
+)
+
+// Default audit sink settings.
+const (
+	DefaultAuditFileMaxSize        = 100 // megabytes
+	DefaultAuditSyslogTag          = "pomerium"
+	DefaultAuditWebhookBatchSize   = 100
+	DefaultAuditWebhookFlushPeriod = 5 * time.Second
+	DefaultAuditWebhookMaxRetries  = 5
+)
+
+// An AuditSink is a destination for audit records. Exactly one of File,
+// Syslog or Webhook must be set.
+type AuditSink struct {
+	File    *AuditFileSink    `mapstructure:"file" yaml:"file,omitempty"`
+	Syslog  *AuditSyslogSink  `mapstructure:"syslog" yaml:"syslog,omitempty"`
+	Webhook *AuditWebhookSink `mapstructure:"webhook" yaml:"webhook,omitempty"`
+}
+
+// AuditFileSink appends audit records to a file, one JSON object per line.
+type AuditFileSink struct {
+	// Path is the path of the file.
+	Path string `mapstructure:"path" yaml:"path,omitempty"`
+
+	// MaxSize is the size in megabytes at which the file is rotated.
+	MaxSize int `mapstructure:"max_size" yaml:"max_size,omitempty"`
+
+	// MaxBackups is the number of rotated files to keep. If zero, all rotated
+	// files are kept.
+	MaxBackups int `mapstructure:"max_backups" yaml:"max_backups,omitempty"`
+}
+
+// AuditSyslogSink sends audit records to a syslog server over TCP, framed
+// according to RFC 5425.
+type AuditSyslogSink struct {
+	// Address is the host:port of the syslog server.
+	Address string `mapstructure:"address" yaml:"address,omitempty"`
+
+	// TLS enables TLS for the connection.
+	TLS bool `mapstructure:"tls" yaml:"tls,omitempty"`
+
+	// CA is the base64-encoded certificate authority used to verify the
+	// syslog server's certificate.
+	CA string `mapstructure:"ca" yaml:"ca,omitempty"`
+
+	// CAFile is the path to a file containing the certificate authority used
+	// to verify the syslog server's certificate.
+	CAFile string `mapstructure:"ca_file" yaml:"ca_file,omitempty"`
+
+	// TLSSkipVerify disables verification of the syslog server's certificate.
+	TLSSkipVerify bool `mapstructure:"tls_skip_verify" yaml:"tls_skip_verify,omitempty"`
+
+	// Tag is the syslog app name.
+	Tag string `mapstructure:"tag" yaml:"tag,omitempty"`
+}
+
+// AuditWebhookSink posts batches of audit records to an HTTP endpoint as
+// newline-delimited JSON.
+type AuditWebhookSink struct {
+	// URL is the endpoint to post records to.
+	URL string `mapstructure:"url" yaml:"url,omitempty"`
+
+	// Headers are added to each request.
+	Headers map[string]string `mapstructure:"headers" yaml:"headers,omitempty"`
+
+	// BatchSize is the maximum number of records sent in a single request.
+	BatchSize int `mapstructure:"batch_size" yaml:"batch_size,omitempty"`
+
+	// FlushInterval is the maximum time a record is buffered before it is
+	// sent.
+	FlushInterval time.Duration `mapstructure:"flush_interval" yaml:"flush_interval,omitempty"`
+
+	// MaxRetries is the number of times a failed request is retried before
+	// the batch is dropped.
+	MaxRetries int `mapstructure:"max_retries" yaml:"max_retries,omitempty"`
+}
+
+// GetMaxSize returns the size in bytes at which the file is rotated.
+func (s *AuditFileSink) GetMaxSize() int64 {
+	if s.MaxSize <= 0 {
+		return DefaultAuditFileMaxSize * 1024 * 1024
+	}
+	return int64(s.MaxSize) * 1024 * 1024
+}
+
+// GetCA returns the certificate authority (or nil if unset).
+func (s *AuditSyslogSink) GetCA() ([]byte, error) {
+	if s.CA != "" {
+		ca, err := base64.StdEncoding.DecodeString(s.CA)
+		if err != nil {
+			return nil, fmt.Errorf("CA: %w", err)
+		}
+		return ca, nil
+	}
+	if s.CAFile != "" {
+		ca, err := os.ReadFile(s.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("CA file: %w", err)
+		}
+		return ca, nil
+	}
+	return nil, nil
+}
+
+// GetTLSConfig returns the TLS config used to connect to the syslog server,
+// or nil if TLS is disabled.
+func (s *AuditSyslogSink) GetTLSConfig() (*tls.Config, error) {
+	if !s.TLS {
+		return nil, nil
+	}
+
+	host, _, err := net.SplitHostPort(s.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         host,
+		InsecureSkipVerify: s.TLSSkipVerify, //nolint:gosec
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	ca, err := s.GetCA()
+	if err != nil {
+		return nil, err
+	}
+	if len(ca) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, errors.New("CA: no certificates found")
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// GetTag returns the syslog app name.
+func (s *AuditSyslogSink) GetTag() string {
+	if s.Tag == "" {
+		return DefaultAuditSyslogTag
+	}
+	return s.Tag
+}
+
+// GetBatchSize returns the maximum number of records sent in a single request.
+func (s *AuditWebhookSink) GetBatchSize() int {
+	if s.BatchSize <= 0 {
+		return DefaultAuditWebhookBatchSize
+	}
+	return s.BatchSize
+}
+
+// GetFlushInterval returns the maximum time a record is buffered.
+func (s *AuditWebhookSink) GetFlushInterval() time.Duration {
+	if s.FlushInterval <= 0 {
+		return DefaultAuditWebhookFlushPeriod
+	}
+	return s.FlushInterval
+}
+
+// GetMaxRetries returns the number of times a failed request is retried.
+func (s *AuditWebhookSink) GetMaxRetries() int {
+	if s.MaxRetries <= 0 {
+		return DefaultAuditWebhookMaxRetries
+	}
+	return s.MaxRetries
+}
+
+func (s *AuditSink) validate() error {
+	n := 0
+	if s.File != nil {
+		n++
+		if s.File.Path == "" {
+			return errors.New("file: path is required")
+		}
+		if s.File.MaxSize < 0 || s.File.MaxBackups < 0 {
+			return errors.New("file: max_size and max_backups must not be negative")
+		}
+	}
+	if s.Syslog != nil {
+		n++
+		if _, _, err := net.SplitHostPort(s.Syslog.Address); err != nil {
+			return fmt.Errorf("syslog: invalid address: %w", err)
+		}
+		if s.Syslog.CA != "" && s.Syslog.CAFile != "" {
+			return errors.New("syslog: cannot set both ca and ca_file")
+		} else if _, err := s.Syslog.GetTLSConfig(); err != nil {
+			return fmt.Errorf("syslog: %w", err)
+		}
+	}
+	if s.Webhook != nil {
+		n++
+		u, err := url.Parse(s.Webhook.URL)
+		if err != nil {
+			return fmt.Errorf("webhook: invalid url: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("webhook: unsupported url scheme: %q", u.Scheme)
+		}
+		if s.Webhook.BatchSize < 0 || s.Webhook.FlushInterval < 0 || s.Webhook.MaxRetries < 0 {
+			return errors.New("webhook: batch_size, flush_interval and max_retries must not be negative")
+		}
+	}
+	if n != 1 {
+		return errors.New("exactly one of file, syslog or webhook must be set")
+	}
+	return nil
+}
+
+func validateAuditSinks(sinks []AuditSink) error {
+	for i := range sinks {
+		if err := sinks[i].validate(); err != nil {
+			return fmt.Errorf("audit sink %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// NewAuditSinkFromProto converts an audit sink protobuf message to an
+// AuditSink.
+func NewAuditSinkFromProto(p *config.AuditSink) AuditSink {
+	var s AuditSink
+	if f := p.GetFile(); f != nil {
+		s.File = &AuditFileSink{
+			Path:       f.GetPath(),
+			MaxSize:    int(f.GetMaxSize()),
+			MaxBackups: int(f.GetMaxBackups()),
+		}
+	}
+	if sl := p.GetSyslog(); sl != nil {
+		s.Syslog = &AuditSyslogSink{
+			Address:       sl.GetAddress(),
+			TLS:           sl.GetTls(),
+			CA:            sl.GetCa(),
+			TLSSkipVerify: sl.GetTlsSkipVerify(),
+			Tag:           sl.GetTag(),
+		}
+	}
+	if w := p.GetWebhook(); w != nil {
+		s.Webhook = &AuditWebhookSink{
+			URL:           w.GetUrl(),
+			Headers:       w.GetHeaders(),
+			BatchSize:     int(w.GetBatchSize()),
+			FlushInterval: w.GetFlushInterval().AsDuration(),
+			MaxRetries:    int(w.GetMaxRetries()),
+		}
+	}
+	return s
+}
+
+// ToProto converts the audit sink to a protobuf message.
+func (s *AuditSink) ToProto() *config.AuditSink {
+	p := new(config.AuditSink)
+	if s.File != nil {
+		p.File = &config.AuditSink_File{
+			Path:       s.File.Path,
+			MaxSize:    int32(s.File.MaxSize),
+			MaxBackups: int32(s.File.MaxBackups),
+		}
+	}
+	if s.Syslog != nil {
+		p.Syslog = &config.AuditSink_Syslog{
+			Address:       s.Syslog.Address,
+			Tls:           s.Syslog.TLS,
+			Ca:            *valueOrFromFileBase64(s.Syslog.CA, s.Syslog.CAFile),
+			TlsSkipVerify: s.Syslog.TLSSkipVerify,
+			Tag:           s.Syslog.Tag,
+		}
+	}
+	if s.Webhook != nil {
+		p.Webhook = &config.AuditSink_Webhook{
+			Url:        s.Webhook.URL,
+			Headers:    s.Webhook.Headers,
+			BatchSize:  int32(s.Webhook.BatchSize),
+			MaxRetries: int32(s.Webhook.MaxRetries),
+		}
+		if s.Webhook.FlushInterval != 0 {
+			p.Webhook.FlushInterval = durationpb.New(s.Webhook.FlushInterval)
+		}
+	}
+	return p
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditSinks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("validate", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tc := range []struct {
+			name   string
+			sink   AuditSink
+			expect string
+		}{
+			{"empty", AuditSink{}, "exactly one of file, syslog or webhook must be set"},
+			{"multiple", AuditSink{
+				File:    &AuditFileSink{Path: "audit.log"},
+				Webhook: &AuditWebhookSink{URL: "https://audit.example.com"},
+			}, "exactly one of file, syslog or webhook must be set"},
+			{"file", AuditSink{File: &AuditFileSink{Path: "audit.log", MaxSize: 10}}, ""},
+			{"file without path", AuditSink{File: &AuditFileSink{}}, "file: path is required"},
+			{"syslog", AuditSink{Syslog: &AuditSyslogSink{Address: "syslog.example.com:6514", TLS: true}}, ""},
+			{"syslog without port", AuditSink{Syslog: &AuditSyslogSink{Address: "syslog.example.com"}}, "syslog: invalid address: address syslog.example.com: missing port in address"},
+			{"syslog invalid ca", AuditSink{Syslog: &AuditSyslogSink{Address: "syslog.example.com:6514", TLS: true, CA: "Zm9v"}}, "syslog: CA: no certificates found"},
+			{"webhook", AuditSink{Webhook: &AuditWebhookSink{URL: "https://audit.example.com"}}, ""},
+			{"webhook invalid scheme", AuditSink{Webhook: &AuditWebhookSink{URL: "ftp://audit.example.com"}}, `webhook: unsupported url scheme: "ftp"`},
+		} {
+			err := tc.sink.validate()
+			if tc.expect == "" {
+				assert.NoError(t, err, tc.name)
+			} else {
+				assert.EqualError(t, err, tc.expect, tc.name)
+			}
+		}
+	})
+	t.Run("proto", func(t *testing.T) {
+		t.Parallel()
+
+		sinks := []AuditSink{
+			{File: &AuditFileSink{Path: "audit.log", MaxSize: 10, MaxBackups: 3}},
+			{Syslog: &AuditSyslogSink{Address: "syslog.example.com:6514", TLS: true, Tag: "pomerium-test"}},
+			{Webhook: &AuditWebhookSink{
+				URL:           "https://audit.example.com",
+				Headers:       map[string]string{"Authorization": "Bearer TOKEN"},
+				BatchSize:     10,
+				FlushInterval: time.Minute,
+				MaxRetries:    2,
+			}},
+		}
+		for _, sink := range sinks {
+			assert.Equal(t, sink, NewAuditSinkFromProto(sink.ToProto()))
+		}
+
+		o := NewDefaultOptions()
+		o.AuditSinks = sinks
+		o2 := NewDefaultOptions()
+		o2.ApplySettings(context.Background(), nil, o.ToProto().GetSettings())
+		require.Len(t, o2.AuditSinks, 3)
+		assert.Equal(t, sinks, o2.AuditSinks)
+	})
+}
@@ -290,6 +290,7 @@ func getAllConfigFilePaths(cfg *Config) []string {
 		cfg.Options.MetricsCertificateKeyFile,
 		cfg.Options.MetricsClientCAFile,
 		cfg.Options.PolicyFile,
+		cfg.Options.SAMLKeyPairFile,
 		cfg.Options.SharedSecretFile,
 		cfg.Options.SigningKeyFile,
 	}
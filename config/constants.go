@@ -9,8 +9,12 @@ import (
 )
 
 const (
-	toKey        = "to"
-	envoyOptsKey = "_envoy_opts"
+	toKey                  = "to"
+	envoyOptsKey           = "_envoy_opts"
+	healthChecksKey        = "health_checks"
+	outlierDetectionKey    = "outlier_detection"
+	loadBalancingPolicyKey = "load_balancing_policy"
+	circuitBreakersKey     = "circuit_breakers"
 )
 
 var (
@@ -435,7 +435,9 @@ func DecodePolicyHookFunc() mapstructure.DecodeHookFunc {
 
 func parsePolicy(src map[string]any) (out map[string]any, err error) {
 	out = make(map[string]any, len(src))
+	envoySrc := make(map[string]any, len(src))
 	for k, v := range src {
+		envoySrc[k] = v
 		if k == toKey {
 			if v, err = parseTo(v); err != nil {
 				return nil, err
@@ -444,8 +446,23 @@ func parsePolicy(src map[string]any) (out map[string]any, err error) {
 		out[k] = v
 	}
 
+	// health checks, outlier detection, load balancing policies and circuit
+	// breakers written in Envoy's format are passed through to the cluster as is,
+	// otherwise they are first-class policy options
+	for _, k := range []string{healthChecksKey, outlierDetectionKey, loadBalancingPolicyKey, circuitBreakersKey} {
+		v, ok := src[k]
+		if !ok {
+			continue
+		}
+		if isEnvoyClusterOption(k, v) {
+			delete(out, k)
+		} else {
+			delete(envoySrc, k)
+		}
+	}
+
 	// also, interpret the entire policy as Envoy's Cluster document to derive its options
-	out[envoyOptsKey], err = parseEnvoyClusterOpts(src)
+	out[envoyOptsKey], err = parseEnvoyClusterOpts(envoySrc)
 	if err != nil {
 		return nil, err
 	}
@@ -453,6 +470,52 @@ func parsePolicy(src map[string]any) (out map[string]any, err error) {
 	return out, nil
 }
 
+// isEnvoyClusterOption reports whether the health checks, outlier detection,
+// load balancing policy or circuit breakers are written in Envoy's format rather
+// than Pomerium's.
+func isEnvoyClusterOption(key string, raw any) bool {
+	switch key {
+	case healthChecksKey:
+		items, _ := raw.([]any)
+		for _, item := range items {
+			m, _ := item.(map[string]any)
+			for k := range m {
+				switch k {
+				case "http_health_check", "httpHealthCheck",
+					"tcp_health_check", "tcpHealthCheck",
+					"grpc_health_check", "grpcHealthCheck",
+					"custom_health_check", "customHealthCheck":
+					return true
+				}
+			}
+		}
+	case outlierDetectionKey:
+		m, _ := raw.(map[string]any)
+		for k := range m {
+			switch k {
+			case "consecutive_5xx", "consecutive_gateway_failure", "interval",
+				"base_ejection_time", "max_ejection_percent",
+				"enforcing_consecutive_gateway_failure":
+			default:
+				return true
+			}
+		}
+	case loadBalancingPolicyKey:
+		m, _ := raw.(map[string]any)
+		_, ok := m["policies"]
+		return ok
+	case circuitBreakersKey:
+		m, _ := raw.(map[string]any)
+		for k := range m {
+			switch k {
+			case "thresholds", "per_host_thresholds", "perHostThresholds":
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func parseTo(raw any) ([]WeightedURL, error) {
 	rawBS, err := json.Marshal(raw)
 	if err != nil {
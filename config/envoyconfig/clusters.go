@@ -104,7 +104,7 @@ func (b *Builder) BuildClusters(ctx context.Context, cfg *config.Config) ([]*env
 		envoyAdminCluster,
 	}
 
-	tracingCluster, err := buildTracingCluster(cfg.Options)
+	tracingCluster, err := b.buildTracingCluster(ctx, cfg)
 	if err != nil {
 		return nil, err
 	} else if tracingCluster != nil {
@@ -209,6 +209,24 @@ func (b *Builder) buildPolicyCluster(ctx context.Context, cfg *config.Config, po
 		cluster.DnsLookupFamily = envoy_config_cluster_v3.Cluster_V4_ONLY
 	}
 
+	if len(policy.HealthChecks) > 0 {
+		cluster.HealthChecks, err = buildPolicyHealthChecks(policy)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if policy.OutlierDetection != nil {
+		cluster.OutlierDetection = buildPolicyOutlierDetection(policy.OutlierDetection)
+	}
+	if policy.LoadBalancingPolicy != nil {
+		cluster.LbPolicy = buildPolicyLbPolicy(policy.LoadBalancingPolicy)
+		// envoy prefers the typed load balancing policy over the lb policy
+		cluster.LoadBalancingPolicy = nil
+	}
+	if policy.CircuitBreakers != nil {
+		cluster.CircuitBreakers = buildPolicyCircuitBreakers(policy.CircuitBreakers)
+	}
+
 	if err := b.buildCluster(cluster, name, endpoints, upstreamProtocol, Keepalive(false)); err != nil {
 		return nil, err
 	}
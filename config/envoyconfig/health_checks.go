@@ -0,0 +1,97 @@
+package envoyconfig
+
+import (
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+// buildPolicyHealthChecks builds the active health checks for a policy's
+// cluster.
+func buildPolicyHealthChecks(policy *config.Policy) ([]*envoy_config_core_v3.HealthCheck, error) {
+	var healthChecks []*envoy_config_core_v3.HealthCheck
+	for i := range policy.HealthChecks {
+		hc := &policy.HealthChecks[i]
+		ehc := &envoy_config_core_v3.HealthCheck{
+			Timeout:            durationpb.New(hc.GetTimeout()),
+			Interval:           durationpb.New(hc.GetInterval()),
+			UnhealthyThreshold: wrapperspb.UInt32(hc.GetUnhealthyThreshold()),
+			HealthyThreshold:   wrapperspb.UInt32(hc.GetHealthyThreshold()),
+		}
+
+		switch hc.GetType() {
+		case config.HealthCheckTypeHTTP:
+			statuses, err := hc.GetExpectedStatuses()
+			if err != nil {
+				return nil, err
+			}
+			httpHealthCheck := &envoy_config_core_v3.HealthCheck_HttpHealthCheck{
+				Host: hc.Host,
+				Path: hc.Path,
+			}
+			// envoy uses the cluster name as the host by default
+			if httpHealthCheck.Host == "" && len(policy.To) > 0 {
+				httpHealthCheck.Host = policy.To[0].URL.Hostname()
+			}
+			if len(policy.To) > 0 && policy.To[0].URL.Scheme == "h2c" {
+				httpHealthCheck.CodecClientType = envoy_type_v3.CodecClientType_HTTP2
+			}
+			for _, s := range statuses {
+				// envoy ranges are half-open
+				httpHealthCheck.ExpectedStatuses = append(httpHealthCheck.ExpectedStatuses, &envoy_type_v3.Int64Range{
+					Start: int64(s.Start),
+					End:   int64(s.End) + 1,
+				})
+			}
+			ehc.HealthChecker = &envoy_config_core_v3.HealthCheck_HttpHealthCheck_{
+				HttpHealthCheck: httpHealthCheck,
+			}
+		case config.HealthCheckTypeTCP:
+			ehc.HealthChecker = &envoy_config_core_v3.HealthCheck_TcpHealthCheck_{
+				TcpHealthCheck: &envoy_config_core_v3.HealthCheck_TcpHealthCheck{},
+			}
+		case config.HealthCheckTypeGRPC:
+			ehc.HealthChecker = &envoy_config_core_v3.HealthCheck_GrpcHealthCheck_{
+				GrpcHealthCheck: &envoy_config_core_v3.HealthCheck_GrpcHealthCheck{
+					ServiceName: hc.GRPCServiceName,
+				},
+			}
+		}
+
+		healthChecks = append(healthChecks, ehc)
+	}
+	return healthChecks, nil
+}
+
+// buildPolicyOutlierDetection builds the outlier detection for a policy's
+// cluster. Unset fields are left for envoy to default.
+func buildPolicyOutlierDetection(od *config.OutlierDetection) *envoy_config_cluster_v3.OutlierDetection {
+	if od == nil {
+		return nil
+	}
+
+	eod := new(envoy_config_cluster_v3.OutlierDetection)
+	if od.Consecutive5xx != 0 {
+		eod.Consecutive_5Xx = wrapperspb.UInt32(od.Consecutive5xx)
+	}
+	if od.ConsecutiveGatewayFailure != 0 {
+		eod.ConsecutiveGatewayFailure = wrapperspb.UInt32(od.ConsecutiveGatewayFailure)
+	}
+	if od.EnforcingConsecutiveGatewayFailure != 0 {
+		eod.EnforcingConsecutiveGatewayFailure = wrapperspb.UInt32(od.EnforcingConsecutiveGatewayFailure)
+	}
+	if od.Interval != 0 {
+		eod.Interval = durationpb.New(od.Interval)
+	}
+	if od.BaseEjectionTime != 0 {
+		eod.BaseEjectionTime = durationpb.New(od.BaseEjectionTime)
+	}
+	if od.MaxEjectionPercent != 0 {
+		eod.MaxEjectionPercent = wrapperspb.UInt32(od.MaxEjectionPercent)
+	}
+	return eod
+}
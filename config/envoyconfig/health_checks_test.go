@@ -0,0 +1,86 @@
+package envoyconfig
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/testutil"
+)
+
+func Test_buildPolicyHealthChecks(t *testing.T) {
+	t.Parallel()
+
+	healthChecks, err := buildPolicyHealthChecks(&config.Policy{
+		To: mustParseWeightedURLs(t, "h2c://to.example.com:8080"),
+		HealthChecks: []config.HealthCheck{
+			{Path: "/healthz", ExpectedStatuses: []string{"200", "300-399"}},
+			{Type: config.HealthCheckTypeTCP, Timeout: time.Second, UnhealthyThreshold: 5},
+			{Type: config.HealthCheckTypeGRPC, Interval: time.Minute, GRPCServiceName: "example.Service"},
+		},
+	})
+	require.NoError(t, err)
+	testutil.AssertProtoJSONEqual(t, `[
+		{
+			"timeout": "5s",
+			"interval": "10s",
+			"unhealthyThreshold": 3,
+			"healthyThreshold": 2,
+			"httpHealthCheck": {
+				"host": "to.example.com",
+				"path": "/healthz",
+				"codecClientType": "HTTP2",
+				"expectedStatuses": [
+					{ "start": "200", "end": "201" },
+					{ "start": "300", "end": "400" }
+				]
+			}
+		},
+		{
+			"timeout": "1s",
+			"interval": "10s",
+			"unhealthyThreshold": 5,
+			"healthyThreshold": 2,
+			"tcpHealthCheck": {}
+		},
+		{
+			"timeout": "5s",
+			"interval": "60s",
+			"unhealthyThreshold": 3,
+			"healthyThreshold": 2,
+			"grpcHealthCheck": { "serviceName": "example.Service" }
+		}
+	]`, healthChecks)
+}
+
+func Test_buildPolicyOutlierDetection(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, buildPolicyOutlierDetection(nil))
+
+	testutil.AssertProtoJSONEqual(t, `{
+		"consecutive5xx": 3,
+		"consecutiveGatewayFailure": 2,
+		"enforcingConsecutiveGatewayFailure": 100,
+		"interval": "5s",
+		"baseEjectionTime": "60s",
+		"maxEjectionPercent": 50
+	}`, buildPolicyOutlierDetection(&config.OutlierDetection{
+		Consecutive5xx:                     3,
+		ConsecutiveGatewayFailure:          2,
+		EnforcingConsecutiveGatewayFailure: 100,
+		Interval:                           5 * time.Second,
+		BaseEjectionTime:                   time.Minute,
+		MaxEjectionPercent:                 50,
+	}))
+
+	// like envoy, gateway failures aren't enforced unless configured
+	testutil.AssertProtoJSONEqual(t, `{
+		"consecutiveGatewayFailure": 5
+	}`, buildPolicyOutlierDetection(&config.OutlierDetection{
+		ConsecutiveGatewayFailure: 5,
+	}))
+}
@@ -0,0 +1,71 @@
+package envoyconfig
+
+import (
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/httputil"
+)
+
+// buildPolicyLbPolicy returns the consistent hashing load balancer of a load
+// balancing policy.
+func buildPolicyLbPolicy(lbp *config.LoadBalancingPolicy) envoy_config_cluster_v3.Cluster_LbPolicy {
+	if lbp.GetAlgorithm() == config.LoadBalancingAlgorithmMaglev {
+		return envoy_config_cluster_v3.Cluster_MAGLEV
+	}
+	return envoy_config_cluster_v3.Cluster_RING_HASH
+}
+
+// buildRouteHashPolicy builds the hash policies used by consistent hashing load
+// balancers to pick an upstream endpoint for a request.
+func buildRouteHashPolicy(lbp *config.LoadBalancingPolicy) []*envoy_config_route_v3.RouteAction_HashPolicy {
+	var hashPolicy *envoy_config_route_v3.RouteAction_HashPolicy
+	switch lbp.GetType() {
+	case config.LoadBalancingPolicyTypeCookie:
+		// envoy sets the cookie if it's missing
+		hashPolicy = &envoy_config_route_v3.RouteAction_HashPolicy{
+			PolicySpecifier: &envoy_config_route_v3.RouteAction_HashPolicy_Cookie_{
+				Cookie: &envoy_config_route_v3.RouteAction_HashPolicy_Cookie{
+					Name: lbp.GetCookieName(),
+					Ttl:  durationpb.New(lbp.CookieTTL),
+					Path: lbp.CookiePath,
+				},
+			},
+			Terminal: true,
+		}
+	case config.LoadBalancingPolicyTypeHeader:
+		hashPolicy = &envoy_config_route_v3.RouteAction_HashPolicy{
+			PolicySpecifier: &envoy_config_route_v3.RouteAction_HashPolicy_Header_{
+				Header: &envoy_config_route_v3.RouteAction_HashPolicy_Header{
+					HeaderName: lbp.HeaderName,
+				},
+			},
+			Terminal: true,
+		}
+	default:
+		// hash by the routing key, which is added by authorize.
+		hashPolicy = &envoy_config_route_v3.RouteAction_HashPolicy{
+			PolicySpecifier: &envoy_config_route_v3.RouteAction_HashPolicy_Header_{
+				Header: &envoy_config_route_v3.RouteAction_HashPolicy_Header{
+					HeaderName: httputil.HeaderPomeriumRoutingKey,
+				},
+			},
+			Terminal: true,
+		}
+	}
+
+	return []*envoy_config_route_v3.RouteAction_HashPolicy{
+		hashPolicy,
+		// if the key is missing, hash by the ip.
+		{
+			PolicySpecifier: &envoy_config_route_v3.RouteAction_HashPolicy_ConnectionProperties_{
+				ConnectionProperties: &envoy_config_route_v3.RouteAction_HashPolicy_ConnectionProperties{
+					SourceIp: true,
+				},
+			},
+			Terminal: true,
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package envoyconfig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/config/envoyconfig/filemgr"
+	"github.com/pomerium/pomerium/internal/testutil"
+)
+
+func Test_buildRouteHashPolicy(t *testing.T) {
+	t.Parallel()
+
+	sourceIP := `{ "connectionProperties": { "sourceIp": true }, "terminal": true }`
+
+	expectRoutingKey := `[
+		{ "header": { "headerName": "x-pomerium-routing-key" }, "terminal": true },
+		` + sourceIP + `
+	]`
+	testutil.AssertProtoJSONEqual(t, expectRoutingKey, buildRouteHashPolicy(nil))
+	testutil.AssertProtoJSONEqual(t, expectRoutingKey, buildRouteHashPolicy(&config.LoadBalancingPolicy{
+		Type: config.LoadBalancingPolicyTypeUser,
+	}))
+
+	testutil.AssertProtoJSONEqual(t, `[
+		{ "cookie": { "name": "_pomerium_upstream", "ttl": "0s" }, "terminal": true },
+		`+sourceIP+`
+	]`, buildRouteHashPolicy(&config.LoadBalancingPolicy{
+		Type: config.LoadBalancingPolicyTypeCookie,
+	}))
+
+	testutil.AssertProtoJSONEqual(t, `[
+		{ "cookie": { "name": "lb", "ttl": "3600s", "path": "/" }, "terminal": true },
+		`+sourceIP+`
+	]`, buildRouteHashPolicy(&config.LoadBalancingPolicy{
+		Type:       config.LoadBalancingPolicyTypeCookie,
+		CookieName: "lb",
+		CookieTTL:  time.Hour,
+		CookiePath: "/",
+	}))
+
+	testutil.AssertProtoJSONEqual(t, `[
+		{ "header": { "headerName": "x-tenant" }, "terminal": true },
+		`+sourceIP+`
+	]`, buildRouteHashPolicy(&config.LoadBalancingPolicy{
+		Type:       config.LoadBalancingPolicyTypeHeader,
+		HeaderName: "x-tenant",
+	}))
+}
+
+func Test_buildPolicyCluster_LoadBalancingPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := New("local-grpc", "local-http", "local-metrics", filemgr.NewManager(), nil)
+
+	cluster, err := b.buildPolicyCluster(ctx, &config.Config{Options: &config.Options{}}, &config.Policy{
+		To: mustParseWeightedURLs(t, "http://to1.example.com", "http://to2.example.com"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, envoy_config_cluster_v3.Cluster_ROUND_ROBIN, cluster.GetLbPolicy())
+
+	cluster, err = b.buildPolicyCluster(ctx, &config.Config{Options: &config.Options{}}, &config.Policy{
+		To: mustParseWeightedURLs(t, "http://to1.example.com", "http://to2.example.com"),
+		EnvoyOpts: &envoy_config_cluster_v3.Cluster{
+			LoadBalancingPolicy: &envoy_config_cluster_v3.LoadBalancingPolicy{},
+		},
+		LoadBalancingPolicy: &config.LoadBalancingPolicy{Type: config.LoadBalancingPolicyTypeUser},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, envoy_config_cluster_v3.Cluster_RING_HASH, cluster.GetLbPolicy())
+	assert.Nil(t, cluster.GetLoadBalancingPolicy())
+
+	cluster, err = b.buildPolicyCluster(ctx, &config.Config{Options: &config.Options{}}, &config.Policy{
+		To: mustParseWeightedURLs(t, "http://to1.example.com", "http://to2.example.com"),
+		LoadBalancingPolicy: &config.LoadBalancingPolicy{
+			Type:      config.LoadBalancingPolicyTypeSession,
+			Algorithm: config.LoadBalancingAlgorithmMaglev,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, envoy_config_cluster_v3.Cluster_MAGLEV, cluster.GetLbPolicy())
+}
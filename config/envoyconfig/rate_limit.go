@@ -0,0 +1,94 @@
+package envoyconfig
+
+import (
+	"strconv"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_metadata_v3 "github.com/envoyproxy/go-control-plane/envoy/type/metadata/v3"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+// RateLimitDomain is the domain used for rate limit requests.
+const RateLimitDomain = "pomerium"
+
+// Rate limit descriptor keys.
+const (
+	RateLimitDescriptorKeyRouteID       = "route_id"
+	RateLimitDescriptorKeyRemoteAddress = "remote_address"
+	RateLimitDescriptorKeyHeader        = "header"
+	RateLimitDescriptorKeyUserID        = "user_id"
+	RateLimitDescriptorKeySessionID     = "session_id"
+)
+
+func hasRateLimits(options *config.Options) bool {
+	for p := range options.GetAllPolicies() {
+		if p.RateLimit != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// buildRouteRateLimits builds the rate limit actions for a route. Each request
+// produces a single descriptor containing the route id and the value of the
+// rate limit key. When the key is missing from the request the descriptor
+// only contains the route id.
+func buildRouteRateLimits(routeID uint64, rateLimit *config.RateLimit) []*envoy_config_route_v3.RateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+
+	actions := []*envoy_config_route_v3.RateLimit_Action{{
+		ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_GenericKey_{
+			GenericKey: &envoy_config_route_v3.RateLimit_Action_GenericKey{
+				DescriptorKey:   RateLimitDescriptorKeyRouteID,
+				DescriptorValue: strconv.FormatUint(routeID, 10),
+			},
+		},
+	}}
+	switch rateLimit.GetKey() {
+	case config.RateLimitKeySourceIP:
+		actions = append(actions, &envoy_config_route_v3.RateLimit_Action{
+			ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_RemoteAddress_{
+				RemoteAddress: &envoy_config_route_v3.RateLimit_Action_RemoteAddress{},
+			},
+		})
+	case config.RateLimitKeyHeader:
+		actions = append(actions, &envoy_config_route_v3.RateLimit_Action{
+			ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_RequestHeaders_{
+				RequestHeaders: &envoy_config_route_v3.RateLimit_Action_RequestHeaders{
+					HeaderName:    rateLimit.HeaderName,
+					DescriptorKey: RateLimitDescriptorKeyHeader,
+					SkipIfAbsent:  true,
+				},
+			},
+		})
+	case config.RateLimitKeyUserID:
+		actions = append(actions, buildExtAuthzMetadataRateLimitAction(RateLimitDescriptorKeyUserID))
+	case config.RateLimitKeySessionID:
+		actions = append(actions, buildExtAuthzMetadataRateLimitAction(RateLimitDescriptorKeySessionID))
+	}
+
+	return []*envoy_config_route_v3.RateLimit{{Actions: actions}}
+}
+
+// buildExtAuthzMetadataRateLimitAction builds a rate limit action which uses
+// the dynamic metadata set by the authorize service.
+func buildExtAuthzMetadataRateLimitAction(key string) *envoy_config_route_v3.RateLimit_Action {
+	return &envoy_config_route_v3.RateLimit_Action{
+		ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_Metadata{
+			Metadata: &envoy_config_route_v3.RateLimit_Action_MetaData{
+				DescriptorKey: key,
+				MetadataKey: &envoy_type_metadata_v3.MetadataKey{
+					Key: "envoy.filters.http.ext_authz",
+					Path: []*envoy_type_metadata_v3.MetadataKey_PathSegment{{
+						Segment: &envoy_type_metadata_v3.MetadataKey_PathSegment_Key{Key: key},
+					}},
+				},
+				Source:       envoy_config_route_v3.RateLimit_Action_MetaData_DYNAMIC,
+				SkipIfAbsent: true,
+			},
+		},
+	}
+}
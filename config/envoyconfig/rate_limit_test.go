@@ -0,0 +1,50 @@
+package envoyconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/testutil"
+)
+
+func Test_buildRouteRateLimits(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, buildRouteRateLimits(1, nil))
+
+	testutil.AssertProtoJSONEqual(t, `[{
+		"actions": [
+			{ "genericKey": { "descriptorKey": "route_id", "descriptorValue": "1" } }
+		]
+	}]`, buildRouteRateLimits(1, &config.RateLimit{Requests: 1, Key: config.RateLimitKeyRoute}))
+
+	testutil.AssertProtoJSONEqual(t, `[{
+		"actions": [
+			{ "genericKey": { "descriptorKey": "route_id", "descriptorValue": "2" } },
+			{ "remoteAddress": {} }
+		]
+	}]`, buildRouteRateLimits(2, &config.RateLimit{Requests: 1}))
+
+	testutil.AssertProtoJSONEqual(t, `[{
+		"actions": [
+			{ "genericKey": { "descriptorKey": "route_id", "descriptorValue": "3" } },
+			{ "requestHeaders": { "headerName": "x-api-key", "descriptorKey": "header", "skipIfAbsent": true } }
+		]
+	}]`, buildRouteRateLimits(3, &config.RateLimit{Requests: 1, Key: config.RateLimitKeyHeader, HeaderName: "x-api-key"}))
+
+	testutil.AssertProtoJSONEqual(t, `[{
+		"actions": [
+			{ "genericKey": { "descriptorKey": "route_id", "descriptorValue": "4" } },
+			{ "metadata": {
+				"descriptorKey": "user_id",
+				"metadataKey": {
+					"key": "envoy.filters.http.ext_authz",
+					"path": [{ "key": "user_id" }]
+				},
+				"skipIfAbsent": true
+			} }
+		]
+	}]`, buildRouteRateLimits(4, &config.RateLimit{Requests: 1, Key: config.RateLimitKeyUserID}))
+}
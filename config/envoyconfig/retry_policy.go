@@ -0,0 +1,75 @@
+package envoyconfig
+
+import (
+	"strings"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+// buildRouteRetryPolicy builds the retry policy for a policy's route.
+func buildRouteRetryPolicy(rp *config.RetryPolicy) *envoy_config_route_v3.RetryPolicy {
+	if rp == nil {
+		return nil
+	}
+
+	erp := &envoy_config_route_v3.RetryPolicy{
+		RetryOn:              strings.Join(rp.GetRetryOn(), ","),
+		NumRetries:           wrapperspb.UInt32(rp.GetNumRetries()),
+		RetriableStatusCodes: rp.RetriableStatusCodes,
+	}
+	if rp.PerTryTimeout != 0 {
+		erp.PerTryTimeout = durationpb.New(rp.PerTryTimeout)
+	}
+	if rp.BackoffBaseInterval != 0 {
+		erp.RetryBackOff = &envoy_config_route_v3.RetryPolicy_RetryBackOff{
+			BaseInterval: durationpb.New(rp.BackoffBaseInterval),
+		}
+		if rp.BackoffMaxInterval != 0 {
+			erp.RetryBackOff.MaxInterval = durationpb.New(rp.BackoffMaxInterval)
+		}
+	}
+	return erp
+}
+
+// buildRouteHedgePolicy builds the hedge policy for a policy's route.
+func buildRouteHedgePolicy(rp *config.RetryPolicy) *envoy_config_route_v3.HedgePolicy {
+	if rp == nil || !rp.HedgeOnPerTryTimeout {
+		return nil
+	}
+	return &envoy_config_route_v3.HedgePolicy{
+		HedgeOnPerTryTimeout: true,
+	}
+}
+
+// buildPolicyCircuitBreakers builds the circuit breakers for a policy's
+// cluster. Unset limits are left for envoy to default.
+func buildPolicyCircuitBreakers(cb *config.CircuitBreakers) *envoy_config_cluster_v3.CircuitBreakers {
+	if cb == nil {
+		return nil
+	}
+
+	thresholds := &envoy_config_cluster_v3.CircuitBreakers_Thresholds{
+		Priority: envoy_config_core_v3.RoutingPriority_DEFAULT,
+	}
+	if cb.MaxConnections != 0 {
+		thresholds.MaxConnections = wrapperspb.UInt32(cb.MaxConnections)
+	}
+	if cb.MaxPendingRequests != 0 {
+		thresholds.MaxPendingRequests = wrapperspb.UInt32(cb.MaxPendingRequests)
+	}
+	if cb.MaxRequests != 0 {
+		thresholds.MaxRequests = wrapperspb.UInt32(cb.MaxRequests)
+	}
+	if cb.MaxRetries != 0 {
+		thresholds.MaxRetries = wrapperspb.UInt32(cb.MaxRetries)
+	}
+	return &envoy_config_cluster_v3.CircuitBreakers{
+		Thresholds: []*envoy_config_cluster_v3.CircuitBreakers_Thresholds{thresholds},
+	}
+}
@@ -0,0 +1,59 @@
+package envoyconfig
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/testutil"
+)
+
+func Test_buildRouteRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, buildRouteRetryPolicy(nil))
+	assert.Nil(t, buildRouteHedgePolicy(nil))
+
+	testutil.AssertProtoJSONEqual(t, `{
+		"retryOn": "connect-failure,refused-stream,gateway-error",
+		"numRetries": 1
+	}`, buildRouteRetryPolicy(&config.RetryPolicy{}))
+
+	rp := &config.RetryPolicy{
+		RetryOn:              []string{"5xx", "reset"},
+		NumRetries:           3,
+		PerTryTimeout:        2 * time.Second,
+		BackoffBaseInterval:  100 * time.Millisecond,
+		BackoffMaxInterval:   time.Second,
+		RetriableStatusCodes: []uint32{409, 429},
+		HedgeOnPerTryTimeout: true,
+	}
+	testutil.AssertProtoJSONEqual(t, `{
+		"retryOn": "5xx,reset,retriable-status-codes",
+		"numRetries": 3,
+		"perTryTimeout": "2s",
+		"retryBackOff": { "baseInterval": "0.100s", "maxInterval": "1s" },
+		"retriableStatusCodes": [409, 429]
+	}`, buildRouteRetryPolicy(rp))
+	testutil.AssertProtoJSONEqual(t, `{ "hedgeOnPerTryTimeout": true }`, buildRouteHedgePolicy(rp))
+}
+
+func Test_buildPolicyCircuitBreakers(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, buildPolicyCircuitBreakers(nil))
+
+	testutil.AssertProtoJSONEqual(t, `{
+		"thresholds": [{
+			"maxConnections": 100,
+			"maxRequests": 50,
+			"maxRetries": 3
+		}]
+	}`, buildPolicyCircuitBreakers(&config.CircuitBreakers{
+		MaxConnections: 100,
+		MaxRequests:    50,
+		MaxRetries:     3,
+	}))
+}
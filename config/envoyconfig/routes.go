@@ -289,6 +289,7 @@ func (b *Builder) buildRouteForPolicyAndMatch(
 		if err != nil {
 			return nil, err
 		}
+		action.RateLimits = buildRouteRateLimits(routeID, policy.RateLimit)
 		route.Action = &envoy_config_route_v3.Route_Route{Route: action}
 	}
 
@@ -435,26 +436,9 @@ func (b *Builder) buildPolicyRouteRouteAction(options *config.Options, policy *c
 		IdleTimeout:   idleTimeout,
 		PrefixRewrite: prefixRewrite,
 		RegexRewrite:  regexRewrite,
-		HashPolicy: []*envoy_config_route_v3.RouteAction_HashPolicy{
-			// hash by the routing key, which is added by authorize.
-			{
-				PolicySpecifier: &envoy_config_route_v3.RouteAction_HashPolicy_Header_{
-					Header: &envoy_config_route_v3.RouteAction_HashPolicy_Header{
-						HeaderName: httputil.HeaderPomeriumRoutingKey,
-					},
-				},
-				Terminal: true,
-			},
-			// if the routing key is missing, hash by the ip.
-			{
-				PolicySpecifier: &envoy_config_route_v3.RouteAction_HashPolicy_ConnectionProperties_{
-					ConnectionProperties: &envoy_config_route_v3.RouteAction_HashPolicy_ConnectionProperties{
-						SourceIp: true,
-					},
-				},
-				Terminal: true,
-			},
-		},
+		HashPolicy:    buildRouteHashPolicy(policy.LoadBalancingPolicy),
+		RetryPolicy:   buildRouteRetryPolicy(policy.RetryPolicy),
+		HedgePolicy:   buildRouteHedgePolicy(policy.RetryPolicy),
 	}
 	setHostRewriteOptions(policy, action)
 
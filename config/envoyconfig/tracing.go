@@ -1,20 +1,34 @@
 package envoyconfig
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"sort"
+	"strings"
+	"time"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	envoy_config_trace_v3 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
+	envoy_extensions_tracers_opentelemetry_resource_detectors_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/tracers/opentelemetry/resource_detectors/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/telemetry/otlp"
 	"github.com/pomerium/pomerium/internal/telemetry/trace"
 	"github.com/pomerium/pomerium/pkg/protoutil"
 )
 
-func buildTracingCluster(options *config.Options) (*envoy_config_cluster_v3.Cluster, error) {
+// otlpTracingTimeout is the timeout for envoy's requests to the OTLP
+// collector.
+var otlpTracingTimeout = durationpb.New(5 * time.Second)
+
+func (b *Builder) buildTracingCluster(ctx context.Context, cfg *config.Config) (*envoy_config_cluster_v3.Cluster, error) {
+	options := cfg.Options
 	tracingOptions, err := config.NewTracingOptions(options)
 	if err != nil {
 		return nil, fmt.Errorf("envoyconfig: invalid tracing config: %w", err)
@@ -89,11 +103,97 @@ func buildTracingCluster(options *config.Options) (*envoy_config_cluster_v3.Clus
 				}},
 			},
 		}, nil
+	case trace.OTLPTracingProviderName:
+		return b.buildOTLPTracingCluster(ctx, cfg, tracingOptions.OTLP)
 	default:
 		return nil, nil
 	}
 }
 
+func (b *Builder) buildOTLPTracingCluster(
+	ctx context.Context,
+	cfg *config.Config,
+	options *otlp.Options,
+) (*envoy_config_cluster_v3.Cluster, error) {
+	addr, err := parseAddress(options.Endpoint.Host)
+	if err != nil {
+		return nil, fmt.Errorf("envoyconfig: invalid tracing otlp address: %w", err)
+	}
+
+	endpoints := []*envoy_config_endpoint_v3.LbEndpoint{{
+		HostIdentifier: &envoy_config_endpoint_v3.LbEndpoint_Endpoint{
+			Endpoint: &envoy_config_endpoint_v3.Endpoint{
+				Address: addr,
+			},
+		},
+	}}
+	cluster := &envoy_config_cluster_v3.Cluster{
+		Name: "otlp",
+		ConnectTimeout: &durationpb.Duration{
+			Seconds: 5,
+		},
+		ClusterDiscoveryType: getClusterDiscoveryType(endpoints),
+		LbPolicy:             envoy_config_cluster_v3.Cluster_ROUND_ROBIN,
+		LoadAssignment: &envoy_config_endpoint_v3.ClusterLoadAssignment{
+			ClusterName: "otlp",
+			Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{
+				LbEndpoints: endpoints,
+			}},
+		},
+		TransportSocket: b.buildOTLPTransportSocket(ctx, cfg, options),
+	}
+	if options.GetProtocol() == otlp.ProtocolGRPC {
+		cluster.TypedExtensionProtocolOptions = buildTypedExtensionProtocolOptions(nil, upstreamProtocolHTTP2, Keepalive(false))
+	}
+	return cluster, nil
+}
+
+func (b *Builder) buildOTLPTransportSocket(
+	ctx context.Context,
+	cfg *config.Config,
+	options *otlp.Options,
+) *envoy_config_core_v3.TransportSocket {
+	if options.Endpoint.Scheme != "https" {
+		return nil
+	}
+
+	validationContext := &envoy_extensions_transport_sockets_tls_v3.CertificateValidationContext{
+		MatchTypedSubjectAltNames: []*envoy_extensions_transport_sockets_tls_v3.SubjectAltNameMatcher{
+			b.buildSubjectAltNameMatcher(options.Endpoint, ""),
+		},
+	}
+	if len(options.CA) > 0 {
+		validationContext.TrustedCa = b.filemgr.BytesDataSource("otlp-ca.pem", options.CA)
+	} else if bs, err := getCombinedCertificateAuthority(ctx, cfg); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("unable to enable certificate verification because no root CAs were found")
+	} else {
+		validationContext.TrustedCa = b.filemgr.BytesDataSource("ca.pem", bs)
+	}
+	if options.TLSSkipVerify {
+		validationContext.TrustChainVerification = envoy_extensions_transport_sockets_tls_v3.CertificateValidationContext_ACCEPT_UNTRUSTED
+	}
+
+	alpnProtocols := []string{"http/1.1"}
+	if options.GetProtocol() == otlp.ProtocolGRPC {
+		alpnProtocols = []string{"h2"}
+	}
+	tlsContext := &envoy_extensions_transport_sockets_tls_v3.UpstreamTlsContext{
+		CommonTlsContext: &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext{
+			AlpnProtocols: alpnProtocols,
+			ValidationContextType: &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext_ValidationContext{
+				ValidationContext: validationContext,
+			},
+		},
+		Sni: b.buildSubjectNameIndication(options.Endpoint, ""),
+	}
+	return &envoy_config_core_v3.TransportSocket{
+		Name: "tls",
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{
+			TypedConfig: marshalAny(tlsContext),
+		},
+	}
+}
+
 func buildTracingHTTP(options *config.Options) (*envoy_config_trace_v3.Tracing_Http, error) {
 	tracingOptions, err := config.NewTracingOptions(options)
 	if err != nil {
@@ -128,7 +228,77 @@ func buildTracingHTTP(options *config.Options) (*envoy_config_trace_v3.Tracing_H
 				TypedConfig: tracingTC,
 			},
 		}, nil
+	case trace.OTLPTracingProviderName:
+		tracingTC := protoutil.NewAny(buildOTLPTracingConfig(tracingOptions.Service, tracingOptions.OTLP))
+		return &envoy_config_trace_v3.Tracing_Http{
+			Name: "envoy.tracers.opentelemetry",
+			ConfigType: &envoy_config_trace_v3.Tracing_Http_TypedConfig{
+				TypedConfig: tracingTC,
+			},
+		}, nil
 	default:
 		return nil, nil
 	}
 }
+
+func buildOTLPTracingConfig(serviceName string, options *otlp.Options) *envoy_config_trace_v3.OpenTelemetryConfig {
+	// sort the headers so the generated config is stable
+	headerKeys := make([]string, 0, len(options.Headers))
+	for k := range options.Headers {
+		headerKeys = append(headerKeys, k)
+	}
+	sort.Strings(headerKeys)
+
+	cfg := &envoy_config_trace_v3.OpenTelemetryConfig{
+		ServiceName: serviceName,
+	}
+	switch options.GetProtocol() {
+	case otlp.ProtocolGRPC:
+		grpcService := &envoy_config_core_v3.GrpcService{
+			TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+					ClusterName: "otlp",
+					Authority:   options.Endpoint.Host,
+				},
+			},
+			Timeout: otlpTracingTimeout,
+		}
+		for _, k := range headerKeys {
+			grpcService.InitialMetadata = append(grpcService.InitialMetadata, &envoy_config_core_v3.HeaderValue{
+				// gRPC metadata keys must be lowercase
+				Key:   strings.ToLower(k),
+				Value: options.Headers[k],
+			})
+		}
+		cfg.GrpcService = grpcService
+	case otlp.ProtocolHTTP:
+		httpService := &envoy_config_core_v3.HttpService{
+			HttpUri: &envoy_config_core_v3.HttpUri{
+				Uri: options.SignalURL(otlp.TracesPath),
+				HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+					Cluster: "otlp",
+				},
+				Timeout: otlpTracingTimeout,
+			},
+		}
+		for _, k := range headerKeys {
+			httpService.RequestHeadersToAdd = append(httpService.RequestHeadersToAdd, &envoy_config_core_v3.HeaderValueOption{
+				Header: &envoy_config_core_v3.HeaderValue{
+					Key:   k,
+					Value: options.Headers[k],
+				},
+			})
+		}
+		cfg.HttpService = httpService
+	}
+
+	if len(options.ResourceAttributes) > 0 {
+		cfg.ResourceDetectors = []*envoy_config_core_v3.TypedExtensionConfig{{
+			Name: "envoy.tracers.opentelemetry.resource_detectors.static_config",
+			TypedConfig: protoutil.NewAny(&envoy_extensions_tracers_opentelemetry_resource_detectors_v3.StaticConfigResourceDetectorConfig{
+				Attributes: options.ResourceAttributes,
+			}),
+		}}
+	}
+	return cfg
+}
@@ -1,19 +1,26 @@
 package envoyconfig
 
 import (
+	"context"
 	"testing"
 
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/config/envoyconfig/filemgr"
 	"github.com/pomerium/pomerium/internal/testutil"
 )
 
 func TestBuildTracingCluster(t *testing.T) {
+	ctx := context.Background()
+	b := New("local-grpc", "local-http", "local-metrics", filemgr.NewManager(), nil)
+
 	t.Run("datadog", func(t *testing.T) {
-		c, err := buildTracingCluster(&config.Options{
+		c, err := b.buildTracingCluster(ctx, &config.Config{Options: &config.Options{
 			TracingProvider: "datadog",
-		})
+		}})
 		require.NoError(t, err)
 		testutil.AssertProtoJSONEqual(t, `
 			{
@@ -38,10 +45,10 @@ func TestBuildTracingCluster(t *testing.T) {
 			}
 		`, c)
 
-		c, err = buildTracingCluster(&config.Options{
+		c, err = b.buildTracingCluster(ctx, &config.Config{Options: &config.Options{
 			TracingProvider:       "datadog",
 			TracingDatadogAddress: "example.com:8126",
-		})
+		}})
 		require.NoError(t, err)
 		testutil.AssertProtoJSONEqual(t, `
 			{
@@ -67,10 +74,10 @@ func TestBuildTracingCluster(t *testing.T) {
 		`, c)
 	})
 	t.Run("zipkin", func(t *testing.T) {
-		c, err := buildTracingCluster(&config.Options{
+		c, err := b.buildTracingCluster(ctx, &config.Config{Options: &config.Options{
 			TracingProvider: "zipkin",
 			ZipkinEndpoint:  "https://example.com/api/v2/spans",
-		})
+		}})
 		require.NoError(t, err)
 		testutil.AssertProtoJSONEqual(t, `
 			{
@@ -95,6 +102,71 @@ func TestBuildTracingCluster(t *testing.T) {
 			}
 		`, c)
 	})
+	t.Run("otlp grpc", func(t *testing.T) {
+		c, err := b.buildTracingCluster(ctx, &config.Config{Options: &config.Options{
+			TracingProvider: "otlp",
+			TracingOTLP: config.OTLPSettings{
+				Endpoint: "http://collector.example.com",
+			},
+		}})
+		require.NoError(t, err)
+		testutil.AssertProtoJSONEqual(t, `
+			{
+				"name": "otlp",
+				"type": "STRICT_DNS",
+				"connectTimeout": "5s",
+				"loadAssignment": {
+					"clusterName": "otlp",
+					"endpoints": [{
+						"lbEndpoints": [{
+							"endpoint": {
+								"address": {
+									"socketAddress": {
+										"address": "collector.example.com",
+										"portValue": 4317
+									}
+								}
+							}
+						}]
+					}]
+				},
+				"typedExtensionProtocolOptions": {
+					"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": {
+						"@type": "type.googleapis.com/envoy.extensions.upstreams.http.v3.HttpProtocolOptions",
+						"explicitHttpConfig": {
+							"http2ProtocolOptions": {
+								"allowConnect": true,
+								"initialConnectionWindowSize": 1048576,
+								"initialStreamWindowSize": 65536,
+								"maxConcurrentStreams": 100
+							}
+						}
+					}
+				}
+			}
+		`, c)
+	})
+	t.Run("otlp http with tls", func(t *testing.T) {
+		c, err := b.buildTracingCluster(ctx, &config.Config{Options: &config.Options{
+			TracingProvider: "otlp",
+			TracingOTLP: config.OTLPSettings{
+				Endpoint:      "https://collector.example.com/custom/traces",
+				Protocol:      "http/protobuf",
+				TLSSkipVerify: true,
+			},
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(4318), c.GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()[0].
+			GetEndpoint().GetAddress().GetSocketAddress().GetPortValue())
+		assert.Nil(t, c.TypedExtensionProtocolOptions)
+
+		var tlsContext envoy_extensions_transport_sockets_tls_v3.UpstreamTlsContext
+		require.NoError(t, c.GetTransportSocket().GetTypedConfig().UnmarshalTo(&tlsContext))
+		assert.Equal(t, "collector.example.com", tlsContext.GetSni())
+		assert.Equal(t, []string{"http/1.1"}, tlsContext.GetCommonTlsContext().GetAlpnProtocols())
+		assert.Equal(t, envoy_extensions_transport_sockets_tls_v3.CertificateValidationContext_ACCEPT_UNTRUSTED,
+			tlsContext.GetCommonTlsContext().GetValidationContext().GetTrustChainVerification())
+	})
 }
 
 func TestBuildTracingHTTP(t *testing.T) {
@@ -132,4 +204,75 @@ func TestBuildTracingHTTP(t *testing.T) {
 			}
 		`, h)
 	})
+	t.Run("otlp grpc", func(t *testing.T) {
+		h, err := buildTracingHTTP(&config.Options{
+			TracingProvider: "otlp",
+			TracingOTLP: config.OTLPSettings{
+				Endpoint: "https://collector.example.com",
+				Headers:  map[string]string{"X-API-Key": "secret"},
+				ResourceAttributes: map[string]string{
+					"deployment.environment": "production",
+				},
+			},
+		})
+		require.NoError(t, err)
+		testutil.AssertProtoJSONEqual(t, `
+			{
+				"name": "envoy.tracers.opentelemetry",
+				"typedConfig": {
+					"@type": "type.googleapis.com/envoy.config.trace.v3.OpenTelemetryConfig",
+					"grpcService": {
+						"envoyGrpc": {
+							"clusterName": "otlp",
+							"authority": "collector.example.com:4317"
+						},
+						"timeout": "5s",
+						"initialMetadata": [
+							{ "key": "x-api-key", "value": "secret" }
+						]
+					},
+					"serviceName": "pomerium",
+					"resourceDetectors": [{
+						"name": "envoy.tracers.opentelemetry.resource_detectors.static_config",
+						"typedConfig": {
+							"@type": "type.googleapis.com/envoy.extensions.tracers.opentelemetry.resource_detectors.v3.StaticConfigResourceDetectorConfig",
+							"attributes": {
+								"deployment.environment": "production"
+							}
+						}
+					}]
+				}
+			}
+		`, h)
+	})
+	t.Run("otlp http", func(t *testing.T) {
+		h, err := buildTracingHTTP(&config.Options{
+			TracingProvider: "otlp",
+			TracingOTLP: config.OTLPSettings{
+				Endpoint: "http://collector.example.com",
+				Protocol: "http/protobuf",
+				Headers:  map[string]string{"X-API-Key": "secret"},
+			},
+		})
+		require.NoError(t, err)
+		testutil.AssertProtoJSONEqual(t, `
+			{
+				"name": "envoy.tracers.opentelemetry",
+				"typedConfig": {
+					"@type": "type.googleapis.com/envoy.config.trace.v3.OpenTelemetryConfig",
+					"httpService": {
+						"httpUri": {
+							"uri": "http://collector.example.com:4318/v1/traces",
+							"cluster": "otlp",
+							"timeout": "5s"
+						},
+						"requestHeadersToAdd": [{
+							"header": { "key": "X-API-Key", "value": "secret" }
+						}]
+					},
+					"serviceName": "pomerium"
+				}
+			}
+		`, h)
+	})
 }
@@ -0,0 +1,320 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	configpb "github.com/pomerium/pomerium/pkg/grpc/config"
+)
+
+// A HealthCheckType is the kind of active health check performed against a
+// route's upstream endpoints.
+type HealthCheckType string
+
+// HealthCheckType values.
+const (
+	// HealthCheckTypeHTTP sends an HTTP request and checks the response status.
+	HealthCheckTypeHTTP HealthCheckType = "http"
+	// HealthCheckTypeTCP checks that a TCP connection can be established.
+	HealthCheckTypeTCP HealthCheckType = "tcp"
+	// HealthCheckTypeGRPC uses the gRPC health checking protocol.
+	HealthCheckTypeGRPC HealthCheckType = "grpc"
+)
+
+// Health check defaults.
+const (
+	DefaultHealthCheckTimeout            = 5 * time.Second
+	DefaultHealthCheckInterval           = 10 * time.Second
+	DefaultHealthCheckUnhealthyThreshold = 3
+	DefaultHealthCheckHealthyThreshold   = 2
+)
+
+// A HealthCheck actively checks the health of a route's upstream endpoints.
+// Unhealthy endpoints are not sent requests until they pass again.
+type HealthCheck struct {
+	// Type is the type of health check. Defaults to http.
+	Type HealthCheckType `mapstructure:"type" yaml:"type,omitempty" json:"type,omitempty"`
+	// Timeout is the time to wait for a health check response. Defaults to
+	// five seconds.
+	Timeout time.Duration `mapstructure:"timeout" yaml:"timeout,omitempty" json:"timeout,omitempty"`
+	// Interval is the interval between health checks. Defaults to ten
+	// seconds.
+	Interval time.Duration `mapstructure:"interval" yaml:"interval,omitempty" json:"interval,omitempty"`
+	// UnhealthyThreshold is the number of failed health checks before an
+	// endpoint is marked unhealthy. Defaults to three.
+	UnhealthyThreshold uint32 `mapstructure:"unhealthy_threshold" yaml:"unhealthy_threshold,omitempty" json:"unhealthy_threshold,omitempty"`
+	// HealthyThreshold is the number of successful health checks before an
+	// unhealthy endpoint is marked healthy. Defaults to two.
+	HealthyThreshold uint32 `mapstructure:"healthy_threshold" yaml:"healthy_threshold,omitempty" json:"healthy_threshold,omitempty"`
+
+	// Path is the request path for http health checks.
+	Path string `mapstructure:"path" yaml:"path,omitempty" json:"path,omitempty"`
+	// Host is the host header for http health checks. Defaults to the host
+	// of the first to URL.
+	Host string `mapstructure:"host" yaml:"host,omitempty" json:"host,omitempty"`
+	// ExpectedStatuses are the response statuses, or inclusive ranges of
+	// statuses such as "200-299", considered healthy by http health checks.
+	// Defaults to 200.
+	ExpectedStatuses []string `mapstructure:"expected_statuses" yaml:"expected_statuses,omitempty" json:"expected_statuses,omitempty"`
+
+	// GRPCServiceName is the service name sent in grpc health checks.
+	GRPCServiceName string `mapstructure:"grpc_service_name" yaml:"grpc_service_name,omitempty" json:"grpc_service_name,omitempty"`
+}
+
+// GetType returns the type of health check.
+func (hc *HealthCheck) GetType() HealthCheckType {
+	if hc.Type == "" {
+		return HealthCheckTypeHTTP
+	}
+	return hc.Type
+}
+
+// GetTimeout returns the time to wait for a health check response.
+func (hc *HealthCheck) GetTimeout() time.Duration {
+	if hc.Timeout <= 0 {
+		return DefaultHealthCheckTimeout
+	}
+	return hc.Timeout
+}
+
+// GetInterval returns the interval between health checks.
+func (hc *HealthCheck) GetInterval() time.Duration {
+	if hc.Interval <= 0 {
+		return DefaultHealthCheckInterval
+	}
+	return hc.Interval
+}
+
+// GetUnhealthyThreshold returns the number of failed health checks before an
+// endpoint is marked unhealthy.
+func (hc *HealthCheck) GetUnhealthyThreshold() uint32 {
+	if hc.UnhealthyThreshold == 0 {
+		return DefaultHealthCheckUnhealthyThreshold
+	}
+	return hc.UnhealthyThreshold
+}
+
+// GetHealthyThreshold returns the number of successful health checks before
+// an endpoint is marked healthy.
+func (hc *HealthCheck) GetHealthyThreshold() uint32 {
+	if hc.HealthyThreshold == 0 {
+		return DefaultHealthCheckHealthyThreshold
+	}
+	return hc.HealthyThreshold
+}
+
+// GetExpectedStatuses returns the inclusive ranges of response statuses
+// considered healthy.
+func (hc *HealthCheck) GetExpectedStatuses() ([]HTTPStatusRange, error) {
+	if len(hc.ExpectedStatuses) == 0 {
+		return []HTTPStatusRange{{Start: 200, End: 200}}, nil
+	}
+
+	ranges := make([]HTTPStatusRange, 0, len(hc.ExpectedStatuses))
+	for _, raw := range hc.ExpectedStatuses {
+		r, err := ParseHTTPStatusRange(raw)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+// Validate checks the validity of the health check.
+func (hc *HealthCheck) Validate() error {
+	if hc.Timeout < 0 {
+		return errors.New("timeout must be positive")
+	}
+	if hc.Interval < 0 {
+		return errors.New("interval must be positive")
+	}
+
+	switch hc.GetType() {
+	case HealthCheckTypeHTTP:
+		if hc.Path == "" {
+			return errors.New("path is required")
+		}
+		if !strings.HasPrefix(hc.Path, "/") {
+			return fmt.Errorf("invalid path: %q", hc.Path)
+		}
+		if _, err := hc.GetExpectedStatuses(); err != nil {
+			return err
+		}
+		if hc.GRPCServiceName != "" {
+			return fmt.Errorf("grpc_service_name is only supported with the %s type", HealthCheckTypeGRPC)
+		}
+	case HealthCheckTypeTCP, HealthCheckTypeGRPC:
+		if hc.Path != "" || hc.Host != "" || len(hc.ExpectedStatuses) > 0 {
+			return fmt.Errorf("path, host and expected_statuses are only supported with the %s type", HealthCheckTypeHTTP)
+		}
+		if hc.GetType() == HealthCheckTypeTCP && hc.GRPCServiceName != "" {
+			return fmt.Errorf("grpc_service_name is only supported with the %s type", HealthCheckTypeGRPC)
+		}
+	default:
+		return fmt.Errorf("unsupported type: %q", hc.Type)
+	}
+
+	return nil
+}
+
+// ToProto converts the health check to a protobuf type.
+func (hc *HealthCheck) ToProto() *configpb.RouteHealthCheck {
+	pb := &configpb.RouteHealthCheck{
+		Type:               string(hc.Type),
+		UnhealthyThreshold: hc.UnhealthyThreshold,
+		HealthyThreshold:   hc.HealthyThreshold,
+		Path:               hc.Path,
+		Host:               hc.Host,
+		ExpectedStatuses:   hc.ExpectedStatuses,
+		GrpcServiceName:    hc.GRPCServiceName,
+	}
+	if hc.Timeout != 0 {
+		pb.Timeout = durationpb.New(hc.Timeout)
+	}
+	if hc.Interval != 0 {
+		pb.Interval = durationpb.New(hc.Interval)
+	}
+	return pb
+}
+
+// NewHealthCheckFromProto creates a new HealthCheck from a protobuf type.
+func NewHealthCheckFromProto(pb *configpb.RouteHealthCheck) HealthCheck {
+	hc := HealthCheck{
+		Type:               HealthCheckType(pb.GetType()),
+		UnhealthyThreshold: pb.GetUnhealthyThreshold(),
+		HealthyThreshold:   pb.GetHealthyThreshold(),
+		Path:               pb.GetPath(),
+		Host:               pb.GetHost(),
+		ExpectedStatuses:   pb.GetExpectedStatuses(),
+		GRPCServiceName:    pb.GetGrpcServiceName(),
+	}
+	if pb.Timeout != nil {
+		hc.Timeout = pb.GetTimeout().AsDuration()
+	}
+	if pb.Interval != nil {
+		hc.Interval = pb.GetInterval().AsDuration()
+	}
+	return hc
+}
+
+// An HTTPStatusRange is an inclusive range of HTTP response statuses.
+type HTTPStatusRange struct {
+	Start, End int
+}
+
+// ParseHTTPStatusRange parses a status, such as "204", or an inclusive range
+// of statuses, such as "200-299".
+func ParseHTTPStatusRange(raw string) (HTTPStatusRange, error) {
+	parse := func(s string) (int, error) {
+		status, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || status < 100 || status > 599 {
+			return 0, fmt.Errorf("invalid expected status: %q", raw)
+		}
+		return status, nil
+	}
+
+	start, end, isRange := strings.Cut(raw, "-")
+	var r HTTPStatusRange
+	var err error
+	if r.Start, err = parse(start); err != nil {
+		return r, err
+	}
+	r.End = r.Start
+	if isRange {
+		if r.End, err = parse(end); err != nil {
+			return r, err
+		}
+		if r.End < r.Start {
+			return r, fmt.Errorf("invalid expected status: %q", raw)
+		}
+	}
+	return r, nil
+}
+
+// An OutlierDetection passively detects unhealthy upstream endpoints from the
+// responses to proxied requests, and temporarily ejects them from load
+// balancing. Unset fields use Envoy's defaults.
+type OutlierDetection struct {
+	// Consecutive5xx is the number of consecutive 5xx responses before an
+	// endpoint is ejected.
+	Consecutive5xx uint32 `mapstructure:"consecutive_5xx" yaml:"consecutive_5xx,omitempty" json:"consecutive_5xx,omitempty"`
+	// ConsecutiveGatewayFailure is the number of consecutive 502, 503 or 504
+	// responses before an endpoint is ejected.
+	ConsecutiveGatewayFailure uint32 `mapstructure:"consecutive_gateway_failure" yaml:"consecutive_gateway_failure,omitempty" json:"consecutive_gateway_failure,omitempty"`
+	// EnforcingConsecutiveGatewayFailure is the percentage chance an endpoint
+	// is ejected after ConsecutiveGatewayFailure is reached. As in Envoy, it
+	// defaults to zero, so gateway failures are only tracked.
+	EnforcingConsecutiveGatewayFailure uint32 `mapstructure:"enforcing_consecutive_gateway_failure" yaml:"enforcing_consecutive_gateway_failure,omitempty" json:"enforcing_consecutive_gateway_failure,omitempty"`
+	// Interval is the interval between ejection sweeps.
+	Interval time.Duration `mapstructure:"interval" yaml:"interval,omitempty" json:"interval,omitempty"`
+	// BaseEjectionTime is how long an endpoint is ejected for, multiplied by
+	// the number of times it has been ejected.
+	BaseEjectionTime time.Duration `mapstructure:"base_ejection_time" yaml:"base_ejection_time,omitempty" json:"base_ejection_time,omitempty"`
+	// MaxEjectionPercent is the maximum percentage of endpoints which may be
+	// ejected at once.
+	MaxEjectionPercent uint32 `mapstructure:"max_ejection_percent" yaml:"max_ejection_percent,omitempty" json:"max_ejection_percent,omitempty"`
+}
+
+// Validate checks the validity of the outlier detection.
+func (od *OutlierDetection) Validate() error {
+	if od.Interval < 0 {
+		return errors.New("interval must be positive")
+	}
+	if od.BaseEjectionTime < 0 {
+		return errors.New("base_ejection_time must be positive")
+	}
+	if od.MaxEjectionPercent > 100 {
+		return errors.New("max_ejection_percent must be at most 100")
+	}
+	if od.EnforcingConsecutiveGatewayFailure > 100 {
+		return errors.New("enforcing_consecutive_gateway_failure must be at most 100")
+	}
+	return nil
+}
+
+// ToProto converts the outlier detection to a protobuf type.
+func (od *OutlierDetection) ToProto() *configpb.RouteOutlierDetection {
+	if od == nil {
+		return nil
+	}
+	pb := &configpb.RouteOutlierDetection{
+		Consecutive_5Xx:                    od.Consecutive5xx,
+		ConsecutiveGatewayFailure:          od.ConsecutiveGatewayFailure,
+		MaxEjectionPercent:                 od.MaxEjectionPercent,
+		EnforcingConsecutiveGatewayFailure: od.EnforcingConsecutiveGatewayFailure,
+	}
+	if od.Interval != 0 {
+		pb.Interval = durationpb.New(od.Interval)
+	}
+	if od.BaseEjectionTime != 0 {
+		pb.BaseEjectionTime = durationpb.New(od.BaseEjectionTime)
+	}
+	return pb
+}
+
+// NewOutlierDetectionFromProto creates a new OutlierDetection from a protobuf
+// type.
+func NewOutlierDetectionFromProto(pb *configpb.RouteOutlierDetection) *OutlierDetection {
+	if pb == nil {
+		return nil
+	}
+	od := &OutlierDetection{
+		Consecutive5xx:                     pb.GetConsecutive_5Xx(),
+		ConsecutiveGatewayFailure:          pb.GetConsecutiveGatewayFailure(),
+		MaxEjectionPercent:                 pb.GetMaxEjectionPercent(),
+		EnforcingConsecutiveGatewayFailure: pb.GetEnforcingConsecutiveGatewayFailure(),
+	}
+	if pb.Interval != nil {
+		od.Interval = pb.GetInterval().AsDuration()
+	}
+	if pb.BaseEjectionTime != nil {
+		od.BaseEjectionTime = pb.GetBaseEjectionTime().AsDuration()
+	}
+	return od
+}
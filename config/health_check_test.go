@@ -0,0 +1,164 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthCheck_Validate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		healthCheck HealthCheck
+		expect      string
+	}{
+		{"valid http", HealthCheck{Path: "/healthz", ExpectedStatuses: []string{"200-299", "418"}}, ""},
+		{"valid tcp", HealthCheck{Type: HealthCheckTypeTCP}, ""},
+		{"valid grpc", HealthCheck{Type: HealthCheckTypeGRPC, GRPCServiceName: "example"}, ""},
+		{"missing path", HealthCheck{}, "path is required"},
+		{"invalid path", HealthCheck{Path: "healthz"}, `invalid path: "healthz"`},
+		{"invalid status", HealthCheck{Path: "/", ExpectedStatuses: []string{"ok"}}, `invalid expected status: "ok"`},
+		{"invalid status range", HealthCheck{Path: "/", ExpectedStatuses: []string{"299-200"}}, `invalid expected status: "299-200"`},
+		{"out of range status", HealthCheck{Path: "/", ExpectedStatuses: []string{"600"}}, `invalid expected status: "600"`},
+		{"negative timeout", HealthCheck{Path: "/", Timeout: -time.Second}, "timeout must be positive"},
+		{"negative interval", HealthCheck{Path: "/", Interval: -time.Second}, "interval must be positive"},
+		{"tcp path", HealthCheck{Type: HealthCheckTypeTCP, Path: "/"}, "path, host and expected_statuses are only supported with the http type"},
+		{"http grpc service name", HealthCheck{Path: "/", GRPCServiceName: "example"}, "grpc_service_name is only supported with the grpc type"},
+		{"unsupported type", HealthCheck{Type: "udp"}, `unsupported type: "udp"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.healthCheck.Validate()
+			if tc.expect == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expect)
+			}
+		})
+	}
+}
+
+func TestHealthCheck_Defaults(t *testing.T) {
+	t.Parallel()
+
+	hc := &HealthCheck{Path: "/"}
+	assert.Equal(t, HealthCheckTypeHTTP, hc.GetType())
+	assert.Equal(t, 5*time.Second, hc.GetTimeout())
+	assert.Equal(t, 10*time.Second, hc.GetInterval())
+	assert.Equal(t, uint32(3), hc.GetUnhealthyThreshold())
+	assert.Equal(t, uint32(2), hc.GetHealthyThreshold())
+	statuses, err := hc.GetExpectedStatuses()
+	require.NoError(t, err)
+	assert.Equal(t, []HTTPStatusRange{{Start: 200, End: 200}}, statuses)
+}
+
+func TestHealthCheck_Proto(t *testing.T) {
+	t.Parallel()
+
+	hc := HealthCheck{
+		Type:               HealthCheckTypeHTTP,
+		Timeout:            time.Second,
+		Interval:           time.Minute,
+		UnhealthyThreshold: 5,
+		HealthyThreshold:   1,
+		Path:               "/healthz",
+		Host:               "example.com",
+		ExpectedStatuses:   []string{"200-299"},
+	}
+	assert.Equal(t, hc, NewHealthCheckFromProto(hc.ToProto()))
+}
+
+func TestOutlierDetection_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, (&OutlierDetection{Consecutive5xx: 3, MaxEjectionPercent: 100}).Validate())
+	assert.EqualError(t, (&OutlierDetection{MaxEjectionPercent: 101}).Validate(),
+		"max_ejection_percent must be at most 100")
+	assert.EqualError(t, (&OutlierDetection{EnforcingConsecutiveGatewayFailure: 101}).Validate(),
+		"enforcing_consecutive_gateway_failure must be at most 100")
+	assert.EqualError(t, (&OutlierDetection{Interval: -time.Second}).Validate(),
+		"interval must be positive")
+	assert.EqualError(t, (&OutlierDetection{BaseEjectionTime: -time.Second}).Validate(),
+		"base_ejection_time must be positive")
+}
+
+func TestOutlierDetection_Proto(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, (*OutlierDetection)(nil).ToProto())
+	assert.Nil(t, NewOutlierDetectionFromProto(nil))
+
+	od := &OutlierDetection{
+		Consecutive5xx:                     3,
+		ConsecutiveGatewayFailure:          2,
+		EnforcingConsecutiveGatewayFailure: 100,
+		Interval:                           time.Second,
+		BaseEjectionTime:                   time.Minute,
+		MaxEjectionPercent:                 50,
+	}
+	assert.Equal(t, od, NewOutlierDetectionFromProto(od.ToProto()))
+}
+
+func TestPolicy_HealthChecksFromYAML(t *testing.T) {
+	t.Parallel()
+
+	o := NewDefaultOptions()
+	o.viper.SetConfigType("yaml")
+	require.NoError(t, o.viper.ReadConfig(strings.NewReader(`
+routes:
+  - from: https://from.example.com
+    to: https://to.example.com
+    health_checks:
+      - path: /healthz
+        interval: 30s
+        expected_statuses: [200, "300-399"]
+      - type: tcp
+    outlier_detection:
+      consecutive_5xx: 3
+      base_ejection_time: 1m
+`)))
+	require.NoError(t, o.parsePolicy())
+	require.Len(t, o.Routes, 1)
+	assert.Equal(t, []HealthCheck{
+		{Path: "/healthz", Interval: 30 * time.Second, ExpectedStatuses: []string{"200", "300-399"}},
+		{Type: HealthCheckTypeTCP},
+	}, o.Routes[0].HealthChecks)
+	assert.Equal(t, &OutlierDetection{Consecutive5xx: 3, BaseEjectionTime: time.Minute}, o.Routes[0].OutlierDetection)
+
+	assert.Empty(t, o.Routes[0].EnvoyOpts.GetHealthChecks())
+	assert.Nil(t, o.Routes[0].EnvoyOpts.GetOutlierDetection())
+}
+
+func TestPolicy_EnvoyHealthChecksFromYAML(t *testing.T) {
+	t.Parallel()
+
+	// health checks in envoy's format are passed through to the cluster
+	o := NewDefaultOptions()
+	o.viper.SetConfigType("yaml")
+	require.NoError(t, o.viper.ReadConfig(strings.NewReader(`
+routes:
+  - from: https://from.example.com
+    to: https://to.example.com
+    health_checks:
+      - timeout: 10s
+        interval: 60s
+        healthy_threshold: 1
+        unhealthy_threshold: 2
+        http_health_check:
+          path: /
+    outlier_detection:
+      consecutive_5xx: 3
+      success_rate_minimum_hosts: 5
+`)))
+	require.NoError(t, o.parsePolicy())
+	require.Len(t, o.Routes, 1)
+	assert.Empty(t, o.Routes[0].HealthChecks)
+	assert.Nil(t, o.Routes[0].OutlierDetection)
+	require.Len(t, o.Routes[0].EnvoyOpts.GetHealthChecks(), 1)
+	assert.Equal(t, "/", o.Routes[0].EnvoyOpts.GetHealthChecks()[0].GetHttpHealthCheck().GetPath())
+	assert.Equal(t, uint32(5), o.Routes[0].EnvoyOpts.GetOutlierDetection().GetSuccessRateMinimumHosts().GetValue())
+}
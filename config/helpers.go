@@ -17,6 +17,10 @@ const (
 	StoragePostgresName = "postgres"
 	// StorageInMemoryName is the name of the in-memory storage backend
 	StorageInMemoryName = "memory"
+	// StorageRedisName is the name of the Redis storage backend
+	StorageRedisName = "redis"
+	// StorageFileName is the name of the file storage backend
+	StorageFileName = "file"
 )
 
 // IsValidService checks to see if a service is a valid service mode
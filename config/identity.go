@@ -1,10 +1,154 @@
 package config
 
 import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
 	"github.com/pomerium/pomerium/internal/urlutil"
+	configpb "github.com/pomerium/pomerium/pkg/grpc/config"
 	"github.com/pomerium/pomerium/pkg/grpc/identity"
 )
 
+// IdentityProvider is a named identity provider users can choose to sign in with.
+type IdentityProvider struct {
+	// Name uniquely identifies the identity provider in the configuration.
+	Name string `mapstructure:"name" yaml:"name"`
+	// DisplayName is shown to users on the identity provider selection page.
+	// Defaults to the name.
+	DisplayName string `mapstructure:"display_name" yaml:"display_name,omitempty"`
+
+	ClientID         string            `mapstructure:"idp_client_id" yaml:"idp_client_id,omitempty"`
+	ClientSecret     string            `mapstructure:"idp_client_secret" yaml:"idp_client_secret,omitempty"`
+	ClientSecretFile string            `mapstructure:"idp_client_secret_file" yaml:"idp_client_secret_file,omitempty"`
+	Provider         string            `mapstructure:"idp_provider" yaml:"idp_provider"`
+	ProviderURL      string            `mapstructure:"idp_provider_url" yaml:"idp_provider_url,omitempty"`
+	Scopes           []string          `mapstructure:"idp_scopes" yaml:"idp_scopes,omitempty"`
+	RequestParams    map[string]string `mapstructure:"idp_request_params" yaml:"idp_request_params,omitempty"`
+	// PushedAuthorizationRequests sends authorization requests to the
+	// identity provider's pushed authorization request endpoint. They are
+	// always pushed when the identity provider requires it.
+	PushedAuthorizationRequests *bool `mapstructure:"idp_pushed_authorization_requests" yaml:"idp_pushed_authorization_requests,omitempty"`
+	// SAMLKeyPair and SAMLAttributes configure a SAML identity provider, see
+	// Options.SAMLKeyPair and Options.SAMLAttributes.
+	SAMLKeyPair     string            `mapstructure:"idp_saml_key_pair" yaml:"idp_saml_key_pair,omitempty"`
+	SAMLKeyPairFile string            `mapstructure:"idp_saml_key_pair_file" yaml:"idp_saml_key_pair_file,omitempty"`
+	SAMLAttributes  map[string]string `mapstructure:"idp_saml_attributes" yaml:"idp_saml_attributes,omitempty"`
+
+	// Domains are email domains whose users are routed to this identity
+	// provider when a login hint is provided.
+	Domains []string `mapstructure:"domains" yaml:"domains,omitempty"`
+}
+
+// GetClientSecret gets the client secret for the identity provider.
+func (idp *IdentityProvider) GetClientSecret() (string, error) {
+	if idp.ClientSecretFile != "" {
+		bs, err := os.ReadFile(idp.ClientSecretFile)
+		if err != nil {
+			return "", err
+		}
+		return string(bs), nil
+	}
+	return idp.ClientSecret, nil
+}
+
+// GetSAMLKeyPair gets the SAML service provider key pair for the identity provider.
+func (idp *IdentityProvider) GetSAMLKeyPair() (string, error) {
+	if idp.SAMLKeyPairFile != "" {
+		bs, err := os.ReadFile(idp.SAMLKeyPairFile)
+		if err != nil {
+			return "", err
+		}
+		return string(bs), nil
+	}
+	return idp.SAMLKeyPair, nil
+}
+
+// GetDisplayName returns the display name of the identity provider.
+func (idp *IdentityProvider) GetDisplayName() string {
+	if idp.DisplayName != "" {
+		return idp.DisplayName
+	}
+	return idp.Name
+}
+
+// MatchesLoginHint returns true if the login hint (an email address or domain)
+// belongs to one of the identity provider's domains.
+func (idp *IdentityProvider) MatchesLoginHint(loginHint string) bool {
+	domain := loginHint
+	if _, after, ok := strings.Cut(loginHint, "@"); ok {
+		domain = after
+	}
+	if domain == "" {
+		return false
+	}
+	return slices.ContainsFunc(idp.Domains, func(d string) bool {
+		return strings.EqualFold(d, domain)
+	})
+}
+
+// ToProto converts the identity provider to a protobuf message.
+func (idp *IdentityProvider) ToProto() *configpb.Settings_IdentityProvider {
+	pb := &configpb.Settings_IdentityProvider{
+		Name:                           idp.Name,
+		IdpProvider:                    idp.Provider,
+		Scopes:                         idp.Scopes,
+		RequestParams:                  idp.RequestParams,
+		Domains:                        idp.Domains,
+		IdpPushedAuthorizationRequests: idp.PushedAuthorizationRequests,
+		IdpSamlAttributes:              idp.SAMLAttributes,
+	}
+	copySrcToOptionalDest(&pb.DisplayName, &idp.DisplayName)
+	copySrcToOptionalDest(&pb.IdpProviderUrl, &idp.ProviderURL)
+	copySrcToOptionalDest(&pb.IdpClientId, &idp.ClientID)
+	copySrcToOptionalDest(&pb.IdpClientSecret, valueOrFromFileBase64(idp.ClientSecret, idp.ClientSecretFile))
+	copySrcToOptionalDest(&pb.IdpSamlKeyPair, valueOrFromFileRaw(idp.SAMLKeyPair, idp.SAMLKeyPairFile))
+	return pb
+}
+
+// NewIdentityProviderFromProto creates a new IdentityProvider from a protobuf message.
+func NewIdentityProviderFromProto(pb *configpb.Settings_IdentityProvider) IdentityProvider {
+	return IdentityProvider{
+		Name:                        pb.GetName(),
+		DisplayName:                 pb.GetDisplayName(),
+		ClientID:                    pb.GetIdpClientId(),
+		ClientSecret:                pb.GetIdpClientSecret(),
+		Provider:                    pb.GetIdpProvider(),
+		ProviderURL:                 pb.GetIdpProviderUrl(),
+		Scopes:                      pb.GetScopes(),
+		RequestParams:               pb.GetRequestParams(),
+		Domains:                     pb.GetDomains(),
+		PushedAuthorizationRequests: pb.IdpPushedAuthorizationRequests,
+		SAMLKeyPair:                 pb.GetIdpSamlKeyPair(),
+		SAMLAttributes:              pb.GetIdpSamlAttributes(),
+	}
+}
+
+func validateIdentityProviders(idps []IdentityProvider) error {
+	seen := map[string]struct{}{}
+	for _, idp := range idps {
+		if idp.Name == "" {
+			return fmt.Errorf("identity provider name is required")
+		}
+		if _, ok := seen[idp.Name]; ok {
+			return fmt.Errorf("duplicate identity provider name: %s", idp.Name)
+		}
+		seen[idp.Name] = struct{}{}
+
+		if idp.Provider == "" {
+			return fmt.Errorf("identity provider %s: idp_provider is required", idp.Name)
+		}
+		if _, err := idp.GetClientSecret(); err != nil {
+			return fmt.Errorf("identity provider %s: invalid client secret: %w", idp.Name, err)
+		}
+		if _, err := idp.GetSAMLKeyPair(); err != nil {
+			return fmt.Errorf("identity provider %s: invalid saml key pair: %w", idp.Name, err)
+		}
+	}
+	return nil
+}
+
 // GetIdentityProviderForID returns the identity provider associated with the given IDP id.
 // If none is found the default provider is returned.
 func (o *Options) GetIdentityProviderForID(idpID string) (*identity.Provider, error) {
@@ -18,6 +162,16 @@ func (o *Options) GetIdentityProviderForID(idpID string) (*identity.Provider, er
 		}
 	}
 
+	idps, err := o.GetIdentityProviders()
+	if err != nil {
+		return nil, err
+	}
+	for _, idp := range idps {
+		if idp.GetId() == idpID {
+			return idp, nil
+		}
+	}
+
 	return o.GetIdentityProviderForPolicy(nil)
 }
 
@@ -29,19 +183,27 @@ func (o *Options) GetIdentityProviderForPolicy(policy *Policy) (*identity.Provid
 		return nil, err
 	}
 
+	samlKeyPair, err := o.GetSAMLKeyPair()
+	if err != nil {
+		return nil, err
+	}
+
 	authenticateURL, err := o.GetAuthenticateURL()
 	if err != nil {
 		return nil, err
 	}
 
 	idp := &identity.Provider{
-		AuthenticateServiceUrl: authenticateURL.String(),
-		ClientId:               o.ClientID,
-		ClientSecret:           clientSecret,
-		Type:                   o.Provider,
-		Scopes:                 o.Scopes,
-		Url:                    o.ProviderURL,
-		RequestParams:          o.RequestParams,
+		AuthenticateServiceUrl:      authenticateURL.String(),
+		ClientId:                    o.ClientID,
+		ClientSecret:                clientSecret,
+		Type:                        o.Provider,
+		Scopes:                      o.Scopes,
+		Url:                         o.ProviderURL,
+		RequestParams:               o.RequestParams,
+		PushedAuthorizationRequests: o.PushedAuthorizationRequests,
+		SamlKeyPair:                 samlKeyPair,
+		SamlAttributes:              o.SAMLAttributes,
 	}
 	if policy != nil {
 		if policy.IDPClientID != "" {
@@ -69,3 +231,130 @@ func (o *Options) GetIdentityProviderForRequestURL(requestURL string) (*identity
 	}
 	return o.GetIdentityProviderForPolicy(nil)
 }
+
+// GetIdentityProviders returns the named identity providers.
+func (o *Options) GetIdentityProviders() ([]*identity.Provider, error) {
+	if o == nil || len(o.IdentityProviders) == 0 {
+		return nil, nil
+	}
+
+	authenticateURL, err := o.GetAuthenticateURL()
+	if err != nil {
+		return nil, err
+	}
+
+	idps := make([]*identity.Provider, 0, len(o.IdentityProviders))
+	for i := range o.IdentityProviders {
+		cfg := &o.IdentityProviders[i]
+		clientSecret, err := cfg.GetClientSecret()
+		if err != nil {
+			return nil, err
+		}
+		samlKeyPair, err := cfg.GetSAMLKeyPair()
+		if err != nil {
+			return nil, err
+		}
+
+		idp := &identity.Provider{
+			AuthenticateServiceUrl:      authenticateURL.String(),
+			ClientId:                    cfg.ClientID,
+			ClientSecret:                clientSecret,
+			Type:                        cfg.Provider,
+			Scopes:                      cfg.Scopes,
+			Url:                         cfg.ProviderURL,
+			RequestParams:               cfg.RequestParams,
+			PushedAuthorizationRequests: cfg.PushedAuthorizationRequests,
+			SamlKeyPair:                 samlKeyPair,
+			SamlAttributes:              cfg.SAMLAttributes,
+			Name:                        cfg.GetDisplayName(),
+		}
+		idp.Id = idp.Hash()
+		idps = append(idps, idp)
+	}
+	return idps, nil
+}
+
+// GetIdentityProviderChoices returns the identity providers a user may sign in with
+// for the given IDP id. When named identity providers are configured, the default
+// identity provider may be replaced by any of them. Otherwise the only choice is the
+// identity provider itself.
+func (o *Options) GetIdentityProviderChoices(idpID string) ([]*identity.Provider, error) {
+	defaultIDP, err := o.GetIdentityProviderForPolicy(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if idpID == defaultIDP.GetId() && len(o.IdentityProviders) > 0 {
+		idps, err := o.GetIdentityProviders()
+		if err != nil {
+			return nil, err
+		}
+		if o.Provider != "" {
+			idps = append([]*identity.Provider{defaultIDP}, idps...)
+		}
+		return idps, nil
+	}
+
+	idp, err := o.GetIdentityProviderForID(idpID)
+	if err != nil {
+		return nil, err
+	}
+	return []*identity.Provider{idp}, nil
+}
+
+// IsIdentityProviderAllowed returns true if a session issued by the sessionIDPID
+// identity provider may be used where the idpID identity provider is required.
+func (o *Options) IsIdentityProviderAllowed(idpID, sessionIDPID string) bool {
+	if idpID == sessionIDPID {
+		return true
+	}
+	if o == nil || len(o.IdentityProviders) == 0 {
+		// without named identity providers the only choice is the identity
+		// provider itself
+		return false
+	}
+
+	// sessions from the named identity providers may only be used where the
+	// default identity provider is required
+	defaultIDP, err := o.GetIdentityProviderForPolicy(nil)
+	if err != nil || idpID != defaultIDP.GetId() {
+		return false
+	}
+	idps, err := o.GetIdentityProviderChoices(idpID)
+	if err != nil {
+		return false
+	}
+	return slices.ContainsFunc(idps, func(idp *identity.Provider) bool {
+		return idp.GetId() == sessionIDPID
+	})
+}
+
+// GetIdentityProviderForLoginHint returns the identity provider, from the choices
+// for the given IDP id, whose domains match the login hint. If none match, nil is
+// returned.
+func (o *Options) GetIdentityProviderForLoginHint(idpID, loginHint string) (*identity.Provider, error) {
+	if loginHint == "" || len(o.IdentityProviders) == 0 {
+		return nil, nil
+	}
+
+	choices, err := o.GetIdentityProviderChoices(idpID)
+	if err != nil {
+		return nil, err
+	}
+
+	idps, err := o.GetIdentityProviders()
+	if err != nil {
+		return nil, err
+	}
+	for i, idp := range idps {
+		if !o.IdentityProviders[i].MatchesLoginHint(loginHint) {
+			continue
+		}
+		if slices.ContainsFunc(choices, func(choice *identity.Provider) bool {
+			return choice.GetId() == idp.GetId()
+		}) {
+			return idp, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,170 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/pkg/grpc/identity"
+)
+
+func TestIdentityProviders(t *testing.T) {
+	t.Parallel()
+
+	o := NewDefaultOptions()
+	o.AuthenticateURLString = "https://authenticate.example.com"
+	o.Provider = "oidc"
+	o.ProviderURL = "https://idp.example.com"
+	o.IdentityProviders = []IdentityProvider{
+		{Name: "google", Provider: "google", Domains: []string{"example.com"}},
+		{Name: "okta", DisplayName: "Okta", Provider: "okta", ProviderURL: "https://okta.example.com"},
+	}
+	o.Policies = []Policy{{From: "https://from.example.com", IDPClientID: "CLIENT_ID"}}
+
+	defaultIDP, err := o.GetIdentityProviderForPolicy(nil)
+	require.NoError(t, err)
+	idps, err := o.GetIdentityProviders()
+	require.NoError(t, err)
+	require.Len(t, idps, 2)
+	assert.Equal(t, "google", idps[0].GetName())
+	assert.Equal(t, "Okta", idps[1].GetName())
+
+	policyIDP, err := o.GetIdentityProviderForPolicy(&o.Policies[0])
+	require.NoError(t, err)
+
+	t.Run("choices", func(t *testing.T) {
+		t.Parallel()
+
+		choices, err := o.GetIdentityProviderChoices(defaultIDP.GetId())
+		require.NoError(t, err)
+		assert.Equal(t, []string{defaultIDP.GetId(), idps[0].GetId(), idps[1].GetId()}, ids(choices))
+
+		choices, err = o.GetIdentityProviderChoices(idps[1].GetId())
+		require.NoError(t, err)
+		assert.Equal(t, []string{idps[1].GetId()}, ids(choices))
+
+		choices, err = o.GetIdentityProviderChoices(policyIDP.GetId())
+		require.NoError(t, err)
+		assert.Equal(t, []string{policyIDP.GetId()}, ids(choices),
+			"routes with their own identity provider should not offer a choice")
+	})
+	t.Run("allowed", func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, o.IsIdentityProviderAllowed(defaultIDP.GetId(), idps[0].GetId()))
+		assert.True(t, o.IsIdentityProviderAllowed(idps[0].GetId(), idps[0].GetId()))
+		assert.False(t, o.IsIdentityProviderAllowed(idps[0].GetId(), idps[1].GetId()))
+		assert.False(t, o.IsIdentityProviderAllowed(policyIDP.GetId(), idps[0].GetId()))
+		assert.False(t, o.IsIdentityProviderAllowed(defaultIDP.GetId(), "UNKNOWN"))
+	})
+	t.Run("login hint", func(t *testing.T) {
+		t.Parallel()
+
+		idp, err := o.GetIdentityProviderForLoginHint(defaultIDP.GetId(), "user@EXAMPLE.com")
+		require.NoError(t, err)
+		assert.Equal(t, idps[0].GetId(), idp.GetId())
+
+		idp, err = o.GetIdentityProviderForLoginHint(defaultIDP.GetId(), "example.com")
+		require.NoError(t, err)
+		assert.Equal(t, idps[0].GetId(), idp.GetId())
+
+		idp, err = o.GetIdentityProviderForLoginHint(defaultIDP.GetId(), "user@example.org")
+		require.NoError(t, err)
+		assert.Nil(t, idp)
+
+		idp, err = o.GetIdentityProviderForLoginHint(policyIDP.GetId(), "user@example.com")
+		require.NoError(t, err)
+		assert.Nil(t, idp)
+	})
+	t.Run("for id", func(t *testing.T) {
+		t.Parallel()
+
+		idp, err := o.GetIdentityProviderForID(idps[1].GetId())
+		require.NoError(t, err)
+		assert.Equal(t, "okta", idp.GetType())
+	})
+}
+
+func TestValidateIdentityProviders(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name string
+		idps []IdentityProvider
+		err  string
+	}{
+		{"valid", []IdentityProvider{{Name: "a", Provider: "oidc"}, {Name: "b", Provider: "google"}}, ""},
+		{"missing name", []IdentityProvider{{Provider: "oidc"}}, "identity provider name is required"},
+		{"duplicate name", []IdentityProvider{{Name: "a", Provider: "oidc"}, {Name: "a", Provider: "google"}}, "duplicate identity provider name: a"},
+		{"missing provider", []IdentityProvider{{Name: "a"}}, "identity provider a: idp_provider is required"},
+		{"missing secret file", []IdentityProvider{{Name: "a", Provider: "oidc", ClientSecretFile: "/does/not/exist"}}, "identity provider a: invalid client secret"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateIdentityProviders(tc.idps)
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func ids(idps []*identity.Provider) []string {
+	var ids []string
+	for _, idp := range idps {
+		ids = append(ids, idp.GetId())
+	}
+	return ids
+}
+
+func TestSAMLIdentityProviderOptions(t *testing.T) {
+	t.Parallel()
+
+	keyPairFile := filepath.Join(t.TempDir(), "saml.pem")
+	require.NoError(t, os.WriteFile(keyPairFile, []byte("KEY PAIR FROM FILE"), 0o600))
+
+	o := NewDefaultOptions()
+	o.AuthenticateURLString = "https://authenticate.example.com"
+	o.Provider = "saml"
+	o.ProviderURL = "https://idp.example.com/metadata"
+	o.SAMLKeyPair = "KEY PAIR"
+	o.SAMLAttributes = map[string]string{"department": "ou"}
+	o.IdentityProviders = []IdentityProvider{{
+		Name:            "other",
+		Provider:        "saml",
+		ProviderURL:     "https://other-idp.example.com/metadata",
+		SAMLKeyPairFile: keyPairFile,
+		SAMLAttributes:  map[string]string{"team": "group"},
+	}}
+
+	idp, err := o.GetIdentityProviderForPolicy(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "KEY PAIR", idp.GetSamlKeyPair())
+	assert.Equal(t, map[string]string{"department": "ou"}, idp.GetSamlAttributes())
+	assert.Empty(t, idp.GetClientSecret())
+	assert.Empty(t, idp.GetRequestParams())
+
+	idps, err := o.GetIdentityProviders()
+	require.NoError(t, err)
+	require.Len(t, idps, 1)
+	assert.Equal(t, "KEY PAIR FROM FILE", idps[0].GetSamlKeyPair())
+	assert.Equal(t, map[string]string{"team": "group"}, idps[0].GetSamlAttributes())
+
+	settings := o.ToProto().GetSettings()
+	assert.Equal(t, "KEY PAIR", settings.GetIdpSamlKeyPair())
+	assert.Equal(t, "KEY PAIR FROM FILE", settings.GetIdentityProviders()[0].GetIdpSamlKeyPair())
+
+	roundTripped := NewDefaultOptions()
+	roundTripped.ApplySettings(context.Background(), nil, settings)
+	assert.Equal(t, o.SAMLKeyPair, roundTripped.SAMLKeyPair)
+	assert.Equal(t, o.SAMLAttributes, roundTripped.SAMLAttributes)
+	assert.Equal(t, "KEY PAIR FROM FILE", roundTripped.IdentityProviders[0].SAMLKeyPair)
+	assert.Equal(t, map[string]string{"team": "group"}, roundTripped.IdentityProviders[0].SAMLAttributes)
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/config"
+	"github.com/pomerium/pomerium/pkg/policy"
+	"github.com/pomerium/pomerium/pkg/policy/parser"
+)
+
+// DefaultImpersonationDuration is the default maximum length of an
+// impersonation.
+const DefaultImpersonationDuration = time.Hour
+
+// ImpersonationSettings configures operators impersonating other users.
+type ImpersonationSettings struct {
+	// Policy is the PPL policy an operator must satisfy to impersonate a
+	// user. Impersonation is disabled if it is not set.
+	Policy *PPLPolicy `mapstructure:"policy" yaml:"policy,omitempty"`
+
+	// Duration is the maximum length of an impersonation. It is also limited
+	// by the expiry of the operator's session.
+	Duration time.Duration `mapstructure:"duration" yaml:"duration,omitempty"`
+}
+
+// Enabled returns true if impersonation is enabled.
+func (s *ImpersonationSettings) Enabled() bool {
+	return s != nil && s.Policy != nil && s.Policy.Policy != nil
+}
+
+// GetDuration returns the maximum length of an impersonation.
+func (s *ImpersonationSettings) GetDuration() time.Duration {
+	if s.Duration <= 0 {
+		return DefaultImpersonationDuration
+	}
+	return s.Duration
+}
+
+// GetPolicy returns the policy used to authorize starting an impersonation,
+// or nil if impersonation is disabled.
+func (s *ImpersonationSettings) GetPolicy() *Policy {
+	if !s.Enabled() {
+		return nil
+	}
+	return &Policy{Policy: s.Policy}
+}
+
+func (s *ImpersonationSettings) validate() error {
+	if s.Duration < 0 {
+		return errors.New("duration must not be negative")
+	}
+	if s.Enabled() {
+		if _, err := policy.GenerateRegoFromPolicy(s.Policy.Policy); err != nil {
+			return fmt.Errorf("invalid policy: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *ImpersonationSettings) applySettingsProto(p *config.ImpersonationSettings) {
+	if p == nil {
+		return
+	}
+	if raw := p.GetPolicy().GetRaw(); len(raw) > 0 {
+		if ppl, err := parser.ParseJSON(bytes.NewReader(raw)); err == nil {
+			s.Policy = &PPLPolicy{Policy: ppl}
+		}
+	}
+	if p.Duration != nil {
+		s.Duration = p.Duration.AsDuration()
+	}
+}
+
+// ToProto converts the impersonation settings to a protobuf message.
+func (s *ImpersonationSettings) ToProto() *config.ImpersonationSettings {
+	if s == nil {
+		return nil
+	}
+	p := new(config.ImpersonationSettings)
+	if s.Enabled() {
+		if raw, err := s.Policy.MarshalJSON(); err == nil {
+			p.Policy = &config.PPLPolicy{Raw: raw}
+		}
+	}
+	if s.Duration != 0 {
+		p.Duration = durationpb.New(s.Duration)
+	}
+	if proto.Size(p) == 0 {
+		return nil
+	}
+	return p
+}
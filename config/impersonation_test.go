@@ -0,0 +1,74 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/pkg/policy/parser"
+)
+
+func TestImpersonationSettings(t *testing.T) {
+	t.Parallel()
+
+	ppl, err := parser.ParseYAML(strings.NewReader(`
+allow:
+  or:
+    - groups:
+        has: admins
+`))
+	require.NoError(t, err)
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Parallel()
+
+		var settings ImpersonationSettings
+		assert.False(t, settings.Enabled())
+		assert.Nil(t, settings.GetPolicy())
+		assert.Equal(t, DefaultImpersonationDuration, settings.GetDuration())
+		assert.NoError(t, settings.validate())
+		assert.Nil(t, settings.ToProto())
+	})
+	t.Run("validate", func(t *testing.T) {
+		t.Parallel()
+
+		settings := ImpersonationSettings{Policy: &PPLPolicy{Policy: ppl}, Duration: -time.Minute}
+		assert.EqualError(t, settings.validate(), "duration must not be negative")
+	})
+	t.Run("proto", func(t *testing.T) {
+		t.Parallel()
+
+		settings := ImpersonationSettings{Policy: &PPLPolicy{Policy: ppl}, Duration: 15 * time.Minute}
+		assert.True(t, settings.Enabled())
+		assert.Equal(t, 15*time.Minute, settings.GetDuration())
+		assert.Equal(t, ppl, settings.GetPolicy().Policy.Policy)
+
+		var actual ImpersonationSettings
+		actual.applySettingsProto(settings.ToProto())
+		assert.Equal(t, settings, actual)
+	})
+	t.Run("yaml", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(cfg, []byte(`
+impersonation:
+  duration: 30m
+  policy:
+    allow:
+      or:
+        - email:
+            is: admin@example.com
+`), 0o644))
+
+		options, err := optionsFromViper(cfg)
+		require.NoError(t, err)
+		assert.True(t, options.Impersonation.Enabled())
+		assert.Equal(t, 30*time.Minute, options.Impersonation.GetDuration())
+	})
+}
@@ -0,0 +1,251 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pomerium/pomerium/pkg/grpc/config"
+)
+
+// Default LDAP search settings.
+const (
+	DefaultLDAPUserSearchFilter   = "(mail={email})"
+	DefaultLDAPGroupSearchFilter  = "(member={dn})"
+	DefaultLDAPGroupNameAttribute = "cn"
+)
+
+// LDAPSettings configures looking up a user's groups and attributes in an LDAP
+// directory (such as Active Directory) when they sign in.
+type LDAPSettings struct {
+	// URL is the address of the LDAP server, using either the ldap:// or
+	// ldaps:// scheme.
+	URL string `mapstructure:"url" yaml:"url,omitempty"`
+
+	// StartTLS upgrades an ldap:// connection to TLS.
+	StartTLS bool `mapstructure:"start_tls" yaml:"start_tls,omitempty"`
+
+	// CA is the base64-encoded certificate authority used to verify the LDAP
+	// server's certificate.
+	CA string `mapstructure:"ca" yaml:"ca,omitempty"`
+
+	// CAFile is the path to a file containing the certificate authority used
+	// to verify the LDAP server's certificate.
+	CAFile string `mapstructure:"ca_file" yaml:"ca_file,omitempty"`
+
+	// TLSSkipVerify disables verification of the LDAP server's certificate.
+	TLSSkipVerify bool `mapstructure:"tls_skip_verify" yaml:"tls_skip_verify,omitempty"`
+
+	// BindDN and BindPassword are the credentials used to search the
+	// directory. If BindDN is empty the searches are anonymous.
+	BindDN           string `mapstructure:"bind_dn" yaml:"bind_dn,omitempty"`
+	BindPassword     string `mapstructure:"bind_password" yaml:"bind_password,omitempty"`
+	BindPasswordFile string `mapstructure:"bind_password_file" yaml:"bind_password_file,omitempty"`
+
+	// UserSearchBase is the base DN to search for users.
+	UserSearchBase string `mapstructure:"user_search_base" yaml:"user_search_base,omitempty"`
+
+	// UserSearchFilter is the filter used to find the user. The placeholders
+	// {email}, {username} (the local part of the email) and {subject} are
+	// replaced with the escaped values from the user's session. The email is
+	// only used if the identity provider asserts it's verified, otherwise
+	// filters using {email} or {username} fail.
+	UserSearchFilter string `mapstructure:"user_search_filter" yaml:"user_search_filter,omitempty"`
+
+	// AllowUnverifiedEmail uses the user's email in the user search filter
+	// even if the identity provider doesn't assert it's verified with the
+	// email_verified claim. Only enable this if the identity provider's
+	// emails can't be chosen by users.
+	AllowUnverifiedEmail bool `mapstructure:"allow_unverified_email" yaml:"allow_unverified_email,omitempty"`
+
+	// UserAttributes are additional user attributes to store on the
+	// directory user record.
+	UserAttributes []string `mapstructure:"user_attributes" yaml:"user_attributes,omitempty"`
+
+	// GroupSearchBase is the base DN to search for the user's groups. If
+	// empty, the groups are taken from the user's memberOf attribute.
+	GroupSearchBase string `mapstructure:"group_search_base" yaml:"group_search_base,omitempty"`
+
+	// GroupSearchFilter is the filter used to find the user's groups. The
+	// placeholder {dn} is replaced with the escaped DN of the user.
+	GroupSearchFilter string `mapstructure:"group_search_filter" yaml:"group_search_filter,omitempty"`
+
+	// GroupNameAttribute is the group attribute used as the group name.
+	GroupNameAttribute string `mapstructure:"group_name_attribute" yaml:"group_name_attribute,omitempty"`
+}
+
+// Enabled returns true if an LDAP server is configured.
+func (s *LDAPSettings) Enabled() bool {
+	return s != nil && s.URL != ""
+}
+
+// GetBindPassword returns the password used to bind to the LDAP server.
+func (s *LDAPSettings) GetBindPassword() (string, error) {
+	if s.BindPasswordFile != "" {
+		bs, err := os.ReadFile(s.BindPasswordFile)
+		if err != nil {
+			return "", fmt.Errorf("bind password file: %w", err)
+		}
+		return string(bs), nil
+	}
+	return s.BindPassword, nil
+}
+
+// GetCA returns the certificate authority (or nil if unset).
+func (s *LDAPSettings) GetCA() ([]byte, error) {
+	if s.CA != "" {
+		ca, err := base64.StdEncoding.DecodeString(s.CA)
+		if err != nil {
+			return nil, fmt.Errorf("CA: %w", err)
+		}
+		return ca, nil
+	}
+	if s.CAFile != "" {
+		ca, err := os.ReadFile(s.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("CA file: %w", err)
+		}
+		return ca, nil
+	}
+	return nil, nil
+}
+
+// GetTLSConfig returns the TLS config used to connect to the LDAP server.
+func (s *LDAPSettings) GetTLSConfig() (*tls.Config, error) {
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: s.TLSSkipVerify, //nolint:gosec
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	ca, err := s.GetCA()
+	if err != nil {
+		return nil, err
+	}
+	if len(ca) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, errors.New("CA: no certificates found")
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// GetUserSearchFilter returns the user search filter.
+func (s *LDAPSettings) GetUserSearchFilter() string {
+	if s.UserSearchFilter == "" {
+		return DefaultLDAPUserSearchFilter
+	}
+	return s.UserSearchFilter
+}
+
+// GetGroupSearchFilter returns the group search filter.
+func (s *LDAPSettings) GetGroupSearchFilter() string {
+	if s.GroupSearchFilter == "" {
+		return DefaultLDAPGroupSearchFilter
+	}
+	return s.GroupSearchFilter
+}
+
+// GetGroupNameAttribute returns the group attribute used as the group name.
+func (s *LDAPSettings) GetGroupNameAttribute() string {
+	if s.GroupNameAttribute == "" {
+		return DefaultLDAPGroupNameAttribute
+	}
+	return s.GroupNameAttribute
+}
+
+func (s *LDAPSettings) validate() error {
+	if !s.Enabled() {
+		return nil
+	}
+
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	switch u.Scheme {
+	case "ldap":
+	case "ldaps":
+		if s.StartTLS {
+			return errors.New("start_tls is not supported with ldaps://")
+		}
+	default:
+		return fmt.Errorf("unsupported url scheme: %q", u.Scheme)
+	}
+
+	if s.UserSearchBase == "" {
+		return errors.New("user_search_base is required")
+	}
+
+	if s.CA != "" && s.CAFile != "" {
+		return errors.New("cannot set both ca and ca_file")
+	} else if _, err := s.GetTLSConfig(); err != nil {
+		return err
+	}
+
+	if s.BindPassword != "" && s.BindPasswordFile != "" {
+		return errors.New("cannot set both bind_password and bind_password_file")
+	} else if _, err := s.GetBindPassword(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *LDAPSettings) applySettingsProto(p *config.LdapSettings) {
+	if p == nil {
+		return
+	}
+	set(&s.URL, p.Url)
+	s.StartTLS = p.GetStartTls()
+	set(&s.CA, p.Ca)
+	s.TLSSkipVerify = p.GetTlsSkipVerify()
+	set(&s.BindDN, p.BindDn)
+	set(&s.BindPassword, p.BindPassword)
+	set(&s.UserSearchBase, p.UserSearchBase)
+	set(&s.UserSearchFilter, p.UserSearchFilter)
+	s.AllowUnverifiedEmail = p.GetAllowUnverifiedEmail()
+	setSlice(&s.UserAttributes, p.UserAttributes)
+	set(&s.GroupSearchBase, p.GroupSearchBase)
+	set(&s.GroupSearchFilter, p.GroupSearchFilter)
+	set(&s.GroupNameAttribute, p.GroupNameAttribute)
+}
+
+// ToProto converts the LDAP settings to a protobuf message.
+func (s *LDAPSettings) ToProto() *config.LdapSettings {
+	if s == nil {
+		return nil
+	}
+	p := &config.LdapSettings{
+		StartTls:             s.StartTLS,
+		TlsSkipVerify:        s.TLSSkipVerify,
+		UserAttributes:       s.UserAttributes,
+		AllowUnverifiedEmail: s.AllowUnverifiedEmail,
+	}
+	copySrcToOptionalDest(&p.Url, &s.URL)
+	copySrcToOptionalDest(&p.Ca, valueOrFromFileBase64(s.CA, s.CAFile))
+	copySrcToOptionalDest(&p.BindDn, &s.BindDN)
+	copySrcToOptionalDest(&p.BindPassword, valueOrFromFileRaw(s.BindPassword, s.BindPasswordFile))
+	copySrcToOptionalDest(&p.UserSearchBase, &s.UserSearchBase)
+	copySrcToOptionalDest(&p.UserSearchFilter, &s.UserSearchFilter)
+	copySrcToOptionalDest(&p.GroupSearchBase, &s.GroupSearchBase)
+	copySrcToOptionalDest(&p.GroupSearchFilter, &s.GroupSearchFilter)
+	copySrcToOptionalDest(&p.GroupNameAttribute, &s.GroupNameAttribute)
+	if proto.Size(p) == 0 {
+		return nil
+	}
+	return p
+}
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLDAPSettingsValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		settings LDAPSettings
+		err      string
+	}{
+		{"disabled", LDAPSettings{}, ""},
+		{"valid", LDAPSettings{URL: "ldaps://ldap.example.com", UserSearchBase: "dc=example,dc=com"}, ""},
+		{"start tls", LDAPSettings{URL: "ldap://ldap.example.com", StartTLS: true, UserSearchBase: "dc=example,dc=com"}, ""},
+		{"start tls with ldaps", LDAPSettings{URL: "ldaps://ldap.example.com", StartTLS: true, UserSearchBase: "dc=example,dc=com"}, "start_tls is not supported with ldaps://"},
+		{"invalid scheme", LDAPSettings{URL: "https://ldap.example.com", UserSearchBase: "dc=example,dc=com"}, `unsupported url scheme: "https"`},
+		{"missing user search base", LDAPSettings{URL: "ldap://ldap.example.com"}, "user_search_base is required"},
+		{"invalid ca", LDAPSettings{URL: "ldap://ldap.example.com", UserSearchBase: "dc=example,dc=com", CA: "Zm9v"}, "CA: no certificates found"},
+		{"both passwords", LDAPSettings{URL: "ldap://ldap.example.com", UserSearchBase: "dc=example,dc=com", BindPassword: "a", BindPasswordFile: "b"}, "cannot set both bind_password and bind_password_file"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.settings.validate()
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
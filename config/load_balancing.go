@@ -0,0 +1,179 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	configpb "github.com/pomerium/pomerium/pkg/grpc/config"
+)
+
+// A LoadBalancingPolicyType is what requests are hashed on to pick a route's
+// upstream endpoint.
+type LoadBalancingPolicyType string
+
+// LoadBalancingPolicyType values.
+const (
+	// LoadBalancingPolicyTypeUser sends all requests of an authenticated user
+	// to the same upstream endpoint.
+	LoadBalancingPolicyTypeUser LoadBalancingPolicyType = "user"
+	// LoadBalancingPolicyTypeSession sends all requests of a session to the
+	// same upstream endpoint.
+	LoadBalancingPolicyTypeSession LoadBalancingPolicyType = "session"
+	// LoadBalancingPolicyTypeCookie sends all requests with the same cookie
+	// to the same upstream endpoint. The cookie is set if it's missing.
+	LoadBalancingPolicyTypeCookie LoadBalancingPolicyType = "cookie"
+	// LoadBalancingPolicyTypeHeader sends all requests with the same header
+	// value to the same upstream endpoint.
+	LoadBalancingPolicyTypeHeader LoadBalancingPolicyType = "header"
+)
+
+// A LoadBalancingAlgorithm is the consistent hashing algorithm used to pick
+// an upstream endpoint.
+type LoadBalancingAlgorithm string
+
+// LoadBalancingAlgorithm values.
+const (
+	LoadBalancingAlgorithmRingHash LoadBalancingAlgorithm = "ring_hash"
+	LoadBalancingAlgorithmMaglev   LoadBalancingAlgorithm = "maglev"
+)
+
+// DefaultLoadBalancingCookieName is the default name of the cookie used by
+// cookie load balancing.
+const DefaultLoadBalancingCookieName = "_pomerium_upstream"
+
+// A LoadBalancingPolicy makes requests to a route with multiple upstream
+// endpoints sticky, by consistently hashing them to the same endpoint.
+// Requests which can't be hashed fall back to the client's IP address.
+type LoadBalancingPolicy struct {
+	// Type is what requests are hashed on.
+	Type LoadBalancingPolicyType `mapstructure:"type" yaml:"type,omitempty" json:"type,omitempty"`
+	// Algorithm is the consistent hashing algorithm. Defaults to ring_hash.
+	Algorithm LoadBalancingAlgorithm `mapstructure:"algorithm" yaml:"algorithm,omitempty" json:"algorithm,omitempty"`
+
+	// CookieName is the name of the cookie for the cookie type. Defaults to
+	// _pomerium_upstream.
+	CookieName string `mapstructure:"cookie_name" yaml:"cookie_name,omitempty" json:"cookie_name,omitempty"`
+	// CookieTTL is the lifetime of the cookie set for the cookie type. If
+	// zero, a session cookie is set.
+	CookieTTL time.Duration `mapstructure:"cookie_ttl" yaml:"cookie_ttl,omitempty" json:"cookie_ttl,omitempty"`
+	// CookiePath is the path of the cookie set for the cookie type.
+	CookiePath string `mapstructure:"cookie_path" yaml:"cookie_path,omitempty" json:"cookie_path,omitempty"`
+
+	// HeaderName is the name of the request header for the header type.
+	HeaderName string `mapstructure:"header_name" yaml:"header_name,omitempty" json:"header_name,omitempty"`
+}
+
+// GetType returns what requests are hashed on, or an empty string if lbp is
+// nil.
+func (lbp *LoadBalancingPolicy) GetType() LoadBalancingPolicyType {
+	if lbp == nil {
+		return ""
+	}
+	return lbp.Type
+}
+
+// GetAlgorithm returns the consistent hashing algorithm.
+func (lbp *LoadBalancingPolicy) GetAlgorithm() LoadBalancingAlgorithm {
+	if lbp.Algorithm == "" {
+		return LoadBalancingAlgorithmRingHash
+	}
+	return lbp.Algorithm
+}
+
+// GetCookieName returns the name of the cookie for the cookie type.
+func (lbp *LoadBalancingPolicy) GetCookieName() string {
+	if lbp.CookieName == "" {
+		return DefaultLoadBalancingCookieName
+	}
+	return lbp.CookieName
+}
+
+// UsesRoutingKey returns true if requests are hashed on the routing key
+// added by the authorize service.
+func (lbp *LoadBalancingPolicy) UsesRoutingKey() bool {
+	switch lbp.GetType() {
+	case LoadBalancingPolicyTypeUser, LoadBalancingPolicyTypeSession:
+		return true
+	}
+	return false
+}
+
+// Validate checks the validity of the load balancing policy.
+func (lbp *LoadBalancingPolicy) Validate() error {
+	switch lbp.GetAlgorithm() {
+	case LoadBalancingAlgorithmRingHash, LoadBalancingAlgorithmMaglev:
+	default:
+		return fmt.Errorf("unsupported algorithm: %q", lbp.Algorithm)
+	}
+
+	switch lbp.Type {
+	case LoadBalancingPolicyTypeUser, LoadBalancingPolicyTypeSession:
+		if lbp.CookieName != "" || lbp.CookieTTL != 0 || lbp.CookiePath != "" || lbp.HeaderName != "" {
+			return fmt.Errorf("cookie and header options are not supported with the %s type", lbp.Type)
+		}
+	case LoadBalancingPolicyTypeCookie:
+		if lbp.CookieTTL < 0 {
+			return errors.New("cookie_ttl must be positive")
+		}
+		if lbp.CookiePath != "" && !strings.HasPrefix(lbp.CookiePath, "/") {
+			return fmt.Errorf("invalid cookie_path: %q", lbp.CookiePath)
+		}
+		if lbp.HeaderName != "" {
+			return fmt.Errorf("header_name is only supported with the %s type", LoadBalancingPolicyTypeHeader)
+		}
+	case LoadBalancingPolicyTypeHeader:
+		if lbp.HeaderName == "" {
+			return errors.New("header_name is required")
+		}
+		if lbp.CookieName != "" || lbp.CookieTTL != 0 || lbp.CookiePath != "" {
+			return fmt.Errorf("cookie options are only supported with the %s type", LoadBalancingPolicyTypeCookie)
+		}
+	case "":
+		return errors.New("type is required")
+	default:
+		return fmt.Errorf("unsupported type: %q", lbp.Type)
+	}
+
+	return nil
+}
+
+// ToProto converts the load balancing policy to a protobuf type.
+func (lbp *LoadBalancingPolicy) ToProto() *configpb.RouteLoadBalancingPolicy {
+	if lbp == nil {
+		return nil
+	}
+	pb := &configpb.RouteLoadBalancingPolicy{
+		Type:       string(lbp.Type),
+		Algorithm:  string(lbp.Algorithm),
+		CookieName: lbp.CookieName,
+		CookiePath: lbp.CookiePath,
+		HeaderName: lbp.HeaderName,
+	}
+	if lbp.CookieTTL != 0 {
+		pb.CookieTtl = durationpb.New(lbp.CookieTTL)
+	}
+	return pb
+}
+
+// NewLoadBalancingPolicyFromProto creates a new LoadBalancingPolicy from a
+// protobuf type.
+func NewLoadBalancingPolicyFromProto(pb *configpb.RouteLoadBalancingPolicy) *LoadBalancingPolicy {
+	if pb == nil {
+		return nil
+	}
+	lbp := &LoadBalancingPolicy{
+		Type:       LoadBalancingPolicyType(pb.GetType()),
+		Algorithm:  LoadBalancingAlgorithm(pb.GetAlgorithm()),
+		CookieName: pb.GetCookieName(),
+		CookiePath: pb.GetCookiePath(),
+		HeaderName: pb.GetHeaderName(),
+	}
+	if pb.CookieTtl != nil {
+		lbp.CookieTTL = pb.GetCookieTtl().AsDuration()
+	}
+	return lbp
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadBalancingPolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		lbp    LoadBalancingPolicy
+		expect string
+	}{
+		{"valid user", LoadBalancingPolicy{Type: LoadBalancingPolicyTypeUser}, ""},
+		{"valid session maglev", LoadBalancingPolicy{Type: LoadBalancingPolicyTypeSession, Algorithm: LoadBalancingAlgorithmMaglev}, ""},
+		{"valid cookie", LoadBalancingPolicy{Type: LoadBalancingPolicyTypeCookie, CookieName: "lb", CookieTTL: time.Hour, CookiePath: "/"}, ""},
+		{"valid header", LoadBalancingPolicy{Type: LoadBalancingPolicyTypeHeader, HeaderName: "x-tenant"}, ""},
+		{"missing type", LoadBalancingPolicy{}, "type is required"},
+		{"unsupported type", LoadBalancingPolicy{Type: "random"}, `unsupported type: "random"`},
+		{"unsupported algorithm", LoadBalancingPolicy{Type: LoadBalancingPolicyTypeUser, Algorithm: "least_request"}, `unsupported algorithm: "least_request"`},
+		{"user header name", LoadBalancingPolicy{Type: LoadBalancingPolicyTypeUser, HeaderName: "x-tenant"}, "cookie and header options are not supported with the user type"},
+		{"negative cookie ttl", LoadBalancingPolicy{Type: LoadBalancingPolicyTypeCookie, CookieTTL: -time.Second}, "cookie_ttl must be positive"},
+		{"invalid cookie path", LoadBalancingPolicy{Type: LoadBalancingPolicyTypeCookie, CookiePath: "app"}, `invalid cookie_path: "app"`},
+		{"cookie header name", LoadBalancingPolicy{Type: LoadBalancingPolicyTypeCookie, HeaderName: "x-tenant"}, "header_name is only supported with the header type"},
+		{"missing header name", LoadBalancingPolicy{Type: LoadBalancingPolicyTypeHeader}, "header_name is required"},
+		{"header cookie name", LoadBalancingPolicy{Type: LoadBalancingPolicyTypeHeader, HeaderName: "x-tenant", CookieName: "lb"}, "cookie options are only supported with the cookie type"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.lbp.Validate()
+			if tc.expect == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expect)
+			}
+		})
+	}
+}
+
+func TestLoadBalancingPolicy_Defaults(t *testing.T) {
+	t.Parallel()
+
+	lbp := &LoadBalancingPolicy{Type: LoadBalancingPolicyTypeCookie}
+	assert.Equal(t, LoadBalancingAlgorithmRingHash, lbp.GetAlgorithm())
+	assert.Equal(t, "_pomerium_upstream", lbp.GetCookieName())
+	assert.False(t, lbp.UsesRoutingKey())
+	assert.True(t, (&LoadBalancingPolicy{Type: LoadBalancingPolicyTypeUser}).UsesRoutingKey())
+	assert.False(t, (*LoadBalancingPolicy)(nil).UsesRoutingKey())
+}
+
+func TestLoadBalancingPolicy_Proto(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, (*LoadBalancingPolicy)(nil).ToProto())
+	assert.Nil(t, NewLoadBalancingPolicyFromProto(nil))
+
+	lbp := &LoadBalancingPolicy{
+		Type:       LoadBalancingPolicyTypeCookie,
+		Algorithm:  LoadBalancingAlgorithmMaglev,
+		CookieName: "lb",
+		CookieTTL:  time.Hour,
+		CookiePath: "/app",
+	}
+	assert.Equal(t, lbp, NewLoadBalancingPolicyFromProto(lbp.ToProto()))
+}
+
+func TestPolicy_LoadBalancingPolicyFromYAML(t *testing.T) {
+	t.Parallel()
+
+	o := NewDefaultOptions()
+	o.viper.SetConfigType("yaml")
+	require.NoError(t, o.viper.ReadConfig(strings.NewReader(`
+routes:
+  - from: https://from1.example.com
+    to: [https://to1.example.com, https://to2.example.com]
+    load_balancing_policy:
+      type: cookie
+      cookie_ttl: 1h
+  - from: https://from2.example.com
+    to: [https://to1.example.com, https://to2.example.com]
+    lb_policy: LEAST_REQUEST
+    load_balancing_policy:
+      policies:
+        - typed_extension_config:
+            name: envoy.load_balancing_policies.round_robin
+`)))
+	require.NoError(t, o.parsePolicy())
+	require.Len(t, o.Routes, 2)
+
+	assert.Equal(t, &LoadBalancingPolicy{Type: LoadBalancingPolicyTypeCookie, CookieTTL: time.Hour}, o.Routes[0].LoadBalancingPolicy)
+	assert.Nil(t, o.Routes[0].EnvoyOpts.GetLoadBalancingPolicy())
+
+	// envoy's load balancing policy is passed through to the cluster
+	assert.Nil(t, o.Routes[1].LoadBalancingPolicy)
+	assert.Len(t, o.Routes[1].EnvoyOpts.GetLoadBalancingPolicy().GetPolicies(), 1)
+}
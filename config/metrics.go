@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
@@ -31,6 +32,11 @@ type MetricsManager struct {
 	basicAuth      string
 	handler        http.Handler
 	endpoints      []MetricsScrapeEndpoint
+
+	otlpSettings       OTLPSettings
+	otlpServiceName    string
+	otlpEnvoyScrapeURL string
+	otlpExporter       *metrics.OTLPExporter
 }
 
 // NewMetricsManager creates a new MetricsManager.
@@ -45,9 +51,21 @@ func NewMetricsManager(ctx context.Context, src Source) *MetricsManager {
 	return mgr
 }
 
-// Close closes any underlying http server.
+// Close closes any underlying http server and pushes any remaining metrics to
+// the OTLP collector.
 func (mgr *MetricsManager) Close() error {
-	return nil
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	if mgr.otlpExporter == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultMetricsTimeout)
+	defer cancel()
+	err := mgr.otlpExporter.Shutdown(ctx)
+	mgr.otlpExporter = nil
+	return err
 }
 
 // OnConfigChange updates the metrics manager when configuration is changed.
@@ -57,6 +75,7 @@ func (mgr *MetricsManager) OnConfigChange(ctx context.Context, cfg *Config) {
 
 	mgr.updateInfo(ctx, cfg)
 	mgr.updateServer(ctx, cfg)
+	mgr.updateOTLPExporter(ctx, cfg)
 }
 
 func (mgr *MetricsManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -119,6 +138,54 @@ func (mgr *MetricsManager) updateServer(ctx context.Context, cfg *Config) {
 	mgr.handler = handler
 }
 
+func (mgr *MetricsManager) updateOTLPExporter(ctx context.Context, cfg *Config) {
+	settings := cfg.Options.MetricsOTLP
+	serviceName := telemetry.ServiceName(cfg.Options.Services)
+	envoyScrapeURL := (&url.URL{
+		Scheme: "http",
+		Host:   net.JoinHostPort("localhost", cfg.OutboundPort),
+		Path:   "/envoy/stats/prometheus",
+	}).String()
+	if reflect.DeepEqual(settings, mgr.otlpSettings) &&
+		serviceName == mgr.otlpServiceName &&
+		envoyScrapeURL == mgr.otlpEnvoyScrapeURL {
+		return
+	}
+
+	if previous := mgr.otlpExporter; previous != nil {
+		// shutdown pushes the remaining metrics, so don't block the config
+		// change on the collector
+		go func() {
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), defaultMetricsTimeout)
+			defer cancel()
+			if err := previous.Shutdown(ctx); err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("metrics: failed to shutdown otlp exporter")
+			}
+		}()
+	}
+	mgr.otlpSettings = settings
+	mgr.otlpServiceName = serviceName
+	mgr.otlpEnvoyScrapeURL = envoyScrapeURL
+	mgr.otlpExporter = nil
+
+	if !settings.Enabled() {
+		return
+	}
+
+	options, err := settings.NewOptions()
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("metrics: invalid metrics_otlp settings")
+		return
+	}
+	exporter, err := metrics.NewOTLPExporter(context.WithoutCancel(ctx), options, serviceName, envoyScrapeURL, settings.GetInterval())
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("metrics: failed to create otlp exporter")
+		return
+	}
+	log.Ctx(ctx).Info().Str("endpoint", options.Endpoint.String()).Msg("metrics: pushing metrics to otlp collector")
+	mgr.otlpExporter = exporter
+}
+
 func (mgr *MetricsManager) configUnchanged(cfg *Config) bool {
 	return cfg.Options.MetricsAddr == mgr.addr &&
 		cfg.Options.MetricsBasicAuth == mgr.basicAuth &&
@@ -40,6 +40,7 @@ import (
 	"github.com/pomerium/pomerium/pkg/hpke"
 	"github.com/pomerium/pomerium/pkg/identity/oauth"
 	"github.com/pomerium/pomerium/pkg/identity/oauth/apple"
+	"github.com/pomerium/pomerium/pkg/identity/saml"
 	"github.com/pomerium/pomerium/pkg/policy/parser"
 )
 
@@ -165,6 +166,34 @@ type Options struct {
 	// https://www.iana.org/assignments/oauth-parameters/oauth-parameters.xhtml
 	// https://openid.net/specs/openid-connect-basic-1_0.html#RequestParameters
 	RequestParams map[string]string `mapstructure:"idp_request_params" yaml:"idp_request_params,omitempty"`
+	// PushedAuthorizationRequests sends authorization requests to the
+	// identity provider's pushed authorization request endpoint. They are
+	// always pushed when the identity provider requires it.
+	//
+	// https://datatracker.ietf.org/doc/html/rfc9126
+	PushedAuthorizationRequests *bool `mapstructure:"idp_pushed_authorization_requests" yaml:"idp_pushed_authorization_requests,omitempty"`
+	// SAMLKeyPair is the PEM encoded private key and certificate used to
+	// sign SAML requests and decrypt SAML assertions. It may be base64
+	// encoded.
+	SAMLKeyPair     string `mapstructure:"idp_saml_key_pair" yaml:"idp_saml_key_pair,omitempty"`
+	SAMLKeyPairFile string `mapstructure:"idp_saml_key_pair_file" yaml:"idp_saml_key_pair_file,omitempty"`
+	// SAMLAttributes maps claim names to the SAML attribute names they are
+	// read from, in addition to the well-known attribute names.
+	SAMLAttributes map[string]string `mapstructure:"idp_saml_attributes" yaml:"idp_saml_attributes,omitempty"`
+
+	// IdentityProviders are additional named identity providers. When set,
+	// users choose which identity provider to sign in with for routes which
+	// use the default identity provider.
+	IdentityProviders []IdentityProvider `mapstructure:"identity_providers" yaml:"identity_providers,omitempty"`
+
+	// LDAP configures looking up users' groups and attributes in an LDAP
+	// directory when they sign in.
+	LDAP LDAPSettings `mapstructure:"ldap" yaml:"ldap,omitempty"`
+
+	// SCIMBearerToken enables the SCIM 2.0 provisioning endpoint on the
+	// authenticate service. SCIM clients authenticate with the token.
+	SCIMBearerToken     string `mapstructure:"scim_bearer_token" yaml:"scim_bearer_token,omitempty"`
+	SCIMBearerTokenFile string `mapstructure:"scim_bearer_token_file" yaml:"scim_bearer_token_file,omitempty"`
 
 	// AuthorizeURLString is the routable destination of the authorize service's
 	// gRPC endpoint. NOTE: As many load balancers do not support
@@ -208,6 +237,10 @@ type Options struct {
 	MetricsClientCA           string `mapstructure:"metrics_client_ca" yaml:"metrics_client_ca,omitempty"`
 	MetricsClientCAFile       string `mapstructure:"metrics_client_ca_file" yaml:"metrics_client_ca_file,omitempty"`
 
+	// MetricsOTLP configures pushing pomerium and envoy metrics to an
+	// OpenTelemetry collector.
+	MetricsOTLP OTLPSettings `mapstructure:"metrics_otlp" yaml:"metrics_otlp,omitempty"`
+
 	// Tracing shared settings
 	TracingProvider   string  `mapstructure:"tracing_provider" yaml:"tracing_provider,omitempty"`
 	TracingSampleRate float64 `mapstructure:"tracing_sample_rate" yaml:"tracing_sample_rate,omitempty"`
@@ -230,6 +263,12 @@ type Options struct {
 	// Example: http://zipkin:9411/api/v2/spans
 	ZipkinEndpoint string `mapstructure:"tracing_zipkin_endpoint" yaml:"tracing_zipkin_endpoint"`
 
+	// OTLP
+
+	// TracingOTLP configures the OpenTelemetry collector spans are exported
+	// to when the tracing provider is otlp.
+	TracingOTLP OTLPSettings `mapstructure:"tracing_otlp" yaml:"tracing_otlp,omitempty"`
+
 	// GRPC Service Settings
 
 	// GRPCAddr specifies the host and port on which the server should serve
@@ -247,7 +286,8 @@ type Options struct {
 	DataBrokerURLStrings        []string `mapstructure:"databroker_service_urls" yaml:"databroker_service_urls,omitempty"`
 	DataBrokerInternalURLString string   `mapstructure:"databroker_internal_service_url" yaml:"databroker_internal_service_url,omitempty"`
 	// DataBrokerStorageType is the storage backend type that databroker will use.
-	// Supported type: memory, postgres
+	// Supported type: memory, postgres, redis, file
+	// For redis, only standalone servers are supported, not Redis Cluster.
 	DataBrokerStorageType string `mapstructure:"databroker_storage_type" yaml:"databroker_storage_type,omitempty"`
 	// DataBrokerStorageConnectionString is the data source name for storage backend.
 	DataBrokerStorageConnectionString     string `mapstructure:"databroker_storage_connection_string" yaml:"databroker_storage_connection_string,omitempty"`
@@ -260,6 +300,10 @@ type Options struct {
 	// If unset, the GCP metadata server will be used to query for identity tokens.
 	GoogleCloudServerlessAuthenticationServiceAccount string `mapstructure:"google_cloud_serverless_authentication_service_account" yaml:"google_cloud_serverless_authentication_service_account,omitempty"`
 
+	// GeoIPDatabaseFile is the path to a MaxMind-format database used to look up
+	// the country of the downstream client for the country policy criterion.
+	GeoIPDatabaseFile string `mapstructure:"geoip_database_file" yaml:"geoip_database_file,omitempty"`
+
 	// UseProxyProtocol configures the HTTP listener to require the HAProxy proxy protocol (either v1 or v2) on incoming requests.
 	UseProxyProtocol bool `mapstructure:"use_proxy_protocol" yaml:"use_proxy_protocol,omitempty" json:"use_proxy_protocol,omitempty"`
 
@@ -289,6 +333,13 @@ type Options struct {
 
 	AuditKey *PublicKeyEncryptionKeyOptions `mapstructure:"audit_key"`
 
+	// AuditSinks are the destinations for audit records. If empty, audit
+	// records are written to the log.
+	AuditSinks []AuditSink `mapstructure:"audit_sinks" yaml:"audit_sinks,omitempty"`
+
+	// Impersonation configures operators impersonating other users.
+	Impersonation ImpersonationSettings `mapstructure:"impersonation" yaml:"impersonation,omitempty"`
+
 	BrandingOptions httputil.BrandingOptions
 
 	PassIdentityHeaders *bool `mapstructure:"pass_identity_headers" yaml:"pass_identity_headers"`
@@ -365,7 +416,35 @@ func newOptionsFromConfig(configFile string) (*Options, error) {
 	return o, nil
 }
 
+// newOptionsFromYAML builds the configuration options by parsing environmental
+// variables and YAML configuration retrieved from name.
+func newOptionsFromYAML(name string, data []byte) (*Options, error) {
+	o, err := loadOptions(name, func(v *viper.Viper) error {
+		v.SetConfigType("yaml")
+		return v.ReadConfig(bytes.NewReader(data))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("config: options from %q: %w", name, err)
+	}
+	serviceName := telemetry.ServiceName(o.Services)
+	metrics.AddPolicyCountCallback(serviceName, func() int64 {
+		return int64(o.NumPolicies())
+	})
+
+	return o, nil
+}
+
 func optionsFromViper(configFile string) (*Options, error) {
+	return loadOptions(configFile, func(v *viper.Viper) error {
+		if configFile == "" {
+			return nil
+		}
+		v.SetConfigFile(configFile)
+		return v.ReadInConfig()
+	})
+}
+
+func loadOptions(configFile string, readConfig func(v *viper.Viper) error) (*Options, error) {
 	// start a copy of the default options
 	o := NewDefaultOptions()
 	v := o.viper
@@ -375,11 +454,8 @@ func optionsFromViper(configFile string) (*Options, error) {
 		return nil, fmt.Errorf("failed to bind options to env vars: %w", err)
 	}
 
-	if configFile != "" {
-		v.SetConfigFile(configFile)
-		if err := v.ReadInConfig(); err != nil {
-			return nil, fmt.Errorf("failed to read config: %w", err)
-		}
+	if err := readConfig(v); err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
 	}
 
 	var metadata mapstructure.Metadata
@@ -450,26 +526,28 @@ func (o *Options) parsePolicy() error {
 		o.Routes = routes
 	}
 
-	// Finish initializing policies
+	// Finish initializing policies, reporting every invalid policy along with
+	// its position so they can all be fixed at once
+	var errs []error
 	for i := range o.Policies {
 		p := &o.Policies[i]
 		if err := p.Validate(); err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("policy %d (%s): %w", i, p.From, err))
 		}
 	}
 	for i := range o.Routes {
 		p := &o.Routes[i]
 		if err := p.Validate(); err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("route %d (%s): %w", i, p.From, err))
 		}
 	}
 	for i := range o.AdditionalPolicies {
 		p := o.AdditionalPolicies[i]
 		if err := p.Validate(); err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("additional policy %d (%s): %w", i, p.From, err))
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 func (o *Options) viperSet(key string, value any) {
@@ -588,7 +666,11 @@ func (o *Options) Validate() error {
 
 	switch o.DataBrokerStorageType {
 	case StorageInMemoryName:
-	case StoragePostgresName:
+	case StorageFileName:
+		if o.DataBrokerStorageConnectionString == "" && o.DataBrokerStorageConnectionStringFile == "" {
+			return errors.New("config: missing databroker storage backend path")
+		}
+	case StoragePostgresName, StorageRedisName:
 		if o.DataBrokerStorageConnectionString == "" && o.DataBrokerStorageConnectionStringFile == "" {
 			return errors.New("config: missing databroker storage backend dsn")
 		}
@@ -647,6 +729,36 @@ func (o *Options) Validate() error {
 		}
 	}
 
+	if err := validateIdentityProviders(o.IdentityProviders); err != nil {
+		return fmt.Errorf("config: bad identity providers: %w", err)
+	}
+
+	if err := o.TracingOTLP.validate(); err != nil {
+		return fmt.Errorf("config: bad tracing_otlp settings: %w", err)
+	}
+
+	if err := o.MetricsOTLP.validate(); err != nil {
+		return fmt.Errorf("config: bad metrics_otlp settings: %w", err)
+	}
+
+	if err := o.LDAP.validate(); err != nil {
+		return fmt.Errorf("config: bad ldap settings: %w", err)
+	}
+
+	if err := validateAuditSinks(o.AuditSinks); err != nil {
+		return fmt.Errorf("config: bad audit sinks: %w", err)
+	}
+
+	if err := o.Impersonation.validate(); err != nil {
+		return fmt.Errorf("config: bad impersonation settings: %w", err)
+	}
+
+	if o.SCIMBearerToken != "" && o.SCIMBearerTokenFile != "" {
+		return errors.New("config: cannot set both scim_bearer_token and scim_bearer_token_file")
+	} else if _, err := o.GetSCIMBearerToken(); err != nil {
+		return fmt.Errorf("config: bad scim_bearer_token_file: %w", err)
+	}
+
 	if o.PolicyFile != "" {
 		return errors.New("config: policy file setting is deprecated")
 	}
@@ -696,6 +808,12 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("config: bad downstream mTLS settings: %w", err)
 	}
 
+	if o.GeoIPDatabaseFile != "" {
+		if _, err := os.Stat(o.GeoIPDatabaseFile); err != nil {
+			return fmt.Errorf("config: bad geoip database file: %w", err)
+		}
+	}
+
 	// strip quotes from redirect address (#811)
 	o.HTTPRedirectAddr = strings.Trim(o.HTTPRedirectAddr, `"'`)
 
@@ -824,7 +942,7 @@ func (o *Options) SupportsUserRefresh() bool {
 		return false
 	}
 
-	if o.Provider == "" {
+	if o.Provider == "" && len(o.IdentityProviders) == 0 {
 		return false
 	}
 
@@ -1337,6 +1455,34 @@ func (o *Options) GetClientSecret() (string, error) {
 	return o.ClientSecret, nil
 }
 
+// GetSAMLKeyPair gets the SAML service provider key pair.
+func (o *Options) GetSAMLKeyPair() (string, error) {
+	if o == nil {
+		return "", nil
+	}
+	if o.SAMLKeyPairFile != "" {
+		bs, err := os.ReadFile(o.SAMLKeyPairFile)
+		if err != nil {
+			return "", err
+		}
+		return string(bs), nil
+	}
+	return o.SAMLKeyPair, nil
+}
+
+// GetSCIMBearerToken returns the bearer token used to authenticate SCIM
+// requests. If empty, the SCIM endpoint is disabled.
+func (o *Options) GetSCIMBearerToken() (string, error) {
+	if o.SCIMBearerTokenFile != "" {
+		bs, err := os.ReadFile(o.SCIMBearerTokenFile)
+		if err != nil {
+			return "", err
+		}
+		return string(bs), nil
+	}
+	return o.SCIMBearerToken, nil
+}
+
 // GetCookieSecret gets the decoded cookie secret.
 func (o *Options) GetCookieSecret() ([]byte, error) {
 	cookieSecret := o.CookieSecret
@@ -1373,13 +1519,34 @@ func (o *Options) GetCookieSameSite() http.SameSite {
 	return http.SameSiteDefaultMode
 }
 
+// getAllProviderTypes returns the types of the default and all named identity providers.
+func (o *Options) getAllProviderTypes() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		if !yield(o.Provider) {
+			return
+		}
+		for i := range o.IdentityProviders {
+			if !yield(o.IdentityProviders[i].Provider) {
+				return
+			}
+		}
+	}
+}
+
 // GetCSRFSameSite gets the csrf same site option.
 func (o *Options) GetCSRFSameSite() csrf.SameSiteMode {
-	if o.Provider == apple.Name {
-		// csrf.SameSiteLaxMode will cause browsers to reset
-		// the session on POST. This breaks Appleid being able
-		// to verify the csrf token.
-		return csrf.SameSiteNoneMode
+	for provider := range o.getAllProviderTypes() {
+		if provider == apple.Name {
+			// csrf.SameSiteLaxMode will cause browsers to reset
+			// the session on POST. This breaks Appleid being able
+			// to verify the csrf token.
+			return csrf.SameSiteNoneMode
+		}
+		if provider == saml.Name {
+			// SAML responses are posted cross-site by the identity provider,
+			// so the csrf cookie must be sent on cross-site POST requests.
+			return csrf.SameSiteNoneMode
+		}
 	}
 
 	str := strings.ToLower(o.CookieSameSite)
@@ -1505,6 +1672,17 @@ func (o *Options) ApplySettings(ctx context.Context, certsIndex *cryptutil.Certi
 	set(&o.ProviderURL, settings.IdpProviderUrl)
 	setSlice(&o.Scopes, settings.Scopes)
 	setMap(&o.RequestParams, settings.RequestParams)
+	setOptional(&o.PushedAuthorizationRequests, settings.IdpPushedAuthorizationRequests)
+	set(&o.SAMLKeyPair, settings.IdpSamlKeyPair)
+	setMap(&o.SAMLAttributes, settings.IdpSamlAttributes)
+	if len(settings.IdentityProviders) > 0 {
+		o.IdentityProviders = make([]IdentityProvider, 0, len(settings.IdentityProviders))
+		for _, idp := range settings.IdentityProviders {
+			o.IdentityProviders = append(o.IdentityProviders, NewIdentityProviderFromProto(idp))
+		}
+	}
+	o.LDAP.applySettingsProto(settings.Ldap)
+	set(&o.SCIMBearerToken, settings.ScimBearerToken)
 	setSlice(&o.AuthorizeURLStrings, settings.AuthorizeServiceUrls)
 	set(&o.AuthorizeInternalURLString, settings.AuthorizeInternalServiceUrl)
 	set(&o.OverrideCertificateName, settings.OverrideCertificateName)
@@ -1518,12 +1696,14 @@ func (o *Options) ApplySettings(ctx context.Context, certsIndex *cryptutil.Certi
 	set(&o.MetricsBasicAuth, settings.MetricsBasicAuth)
 	setCertificate(&o.MetricsCertificate, &o.MetricsCertificateKey, settings.MetricsCertificate)
 	set(&o.MetricsClientCA, settings.MetricsClientCa)
+	o.MetricsOTLP.applySettingsProto(settings.MetricsOtlp)
 	set(&o.TracingProvider, settings.TracingProvider)
 	set(&o.TracingSampleRate, settings.TracingSampleRate)
 	set(&o.TracingDatadogAddress, settings.TracingDatadogAddress)
 	set(&o.TracingJaegerCollectorEndpoint, settings.TracingJaegerCollectorEndpoint)
 	set(&o.TracingJaegerAgentEndpoint, settings.TracingJaegerAgentEndpoint)
 	set(&o.ZipkinEndpoint, settings.TracingZipkinEndpoint)
+	o.TracingOTLP.applySettingsProto(settings.TracingOtlp)
 	set(&o.GRPCAddr, settings.GrpcAddress)
 	setOptional(&o.GRPCInsecure, settings.GrpcInsecure)
 	setDuration(&o.GRPCClientTimeout, settings.GrpcClientTimeout)
@@ -1533,6 +1713,7 @@ func (o *Options) ApplySettings(ctx context.Context, certsIndex *cryptutil.Certi
 	set(&o.DataBrokerStorageConnectionString, settings.DatabrokerStorageConnectionString)
 	o.DownstreamMTLS.applySettingsProto(ctx, settings.DownstreamMtls)
 	set(&o.GoogleCloudServerlessAuthenticationServiceAccount, settings.GoogleCloudServerlessAuthenticationServiceAccount)
+	set(&o.GeoIPDatabaseFile, settings.GeoipDatabaseFile)
 	set(&o.UseProxyProtocol, settings.UseProxyProtocol)
 	set(&o.AutocertOptions.Enable, settings.Autocert)
 	set(&o.AutocertOptions.CA, settings.AutocertCa)
@@ -1552,6 +1733,13 @@ func (o *Options) ApplySettings(ctx context.Context, certsIndex *cryptutil.Certi
 	o.EnvoyBindConfigFreebind = null.BoolFromPtr(settings.EnvoyBindConfigFreebind)
 	setSlice(&o.ProgrammaticRedirectDomainWhitelist, settings.ProgrammaticRedirectDomainWhitelist)
 	setAuditKey(&o.AuditKey, settings.AuditKey)
+	if len(settings.AuditSinks) > 0 {
+		o.AuditSinks = make([]AuditSink, 0, len(settings.AuditSinks))
+		for _, sink := range settings.AuditSinks {
+			o.AuditSinks = append(o.AuditSinks, NewAuditSinkFromProto(sink))
+		}
+	}
+	o.Impersonation.applySettingsProto(settings.Impersonation)
 	setCodecType(&o.CodecType, settings.CodecType)
 	setOptional(&o.PassIdentityHeaders, settings.PassIdentityHeaders)
 	o.BrandingOptions = settings
@@ -1593,6 +1781,14 @@ func (o *Options) ToProto() *config.Config {
 	copySrcToOptionalDest(&settings.IdpProviderUrl, &o.ProviderURL)
 	settings.Scopes = o.Scopes
 	settings.RequestParams = o.RequestParams
+	settings.IdpPushedAuthorizationRequests = o.PushedAuthorizationRequests
+	copySrcToOptionalDest(&settings.IdpSamlKeyPair, valueOrFromFileRaw(o.SAMLKeyPair, o.SAMLKeyPairFile))
+	settings.IdpSamlAttributes = o.SAMLAttributes
+	for i := range o.IdentityProviders {
+		settings.IdentityProviders = append(settings.IdentityProviders, o.IdentityProviders[i].ToProto())
+	}
+	settings.Ldap = o.LDAP.ToProto()
+	copySrcToOptionalDest(&settings.ScimBearerToken, valueOrFromFileRaw(o.SCIMBearerToken, o.SCIMBearerTokenFile))
 	settings.AuthorizeServiceUrls = o.AuthorizeURLStrings
 	copySrcToOptionalDest(&settings.AuthorizeInternalServiceUrl, &o.AuthorizeInternalURLString)
 	copySrcToOptionalDest(&settings.OverrideCertificateName, &o.OverrideCertificateName)
@@ -1606,12 +1802,14 @@ func (o *Options) ToProto() *config.Config {
 	copySrcToOptionalDest(&settings.MetricsBasicAuth, &o.MetricsBasicAuth)
 	settings.MetricsCertificate = toCertificateOrFromFile(o.MetricsCertificate, o.MetricsCertificateKey, o.MetricsCertificateFile, o.MetricsCertificateKeyFile)
 	copySrcToOptionalDest(&settings.MetricsClientCa, valueOrFromFileBase64(o.MetricsClientCA, o.MetricsClientCAFile))
+	settings.MetricsOtlp = o.MetricsOTLP.ToProto()
 	copySrcToOptionalDest(&settings.TracingProvider, &o.TracingProvider)
 	copySrcToOptionalDest(&settings.TracingSampleRate, &o.TracingSampleRate)
 	copySrcToOptionalDest(&settings.TracingDatadogAddress, &o.TracingDatadogAddress)
 	copySrcToOptionalDest(&settings.TracingJaegerCollectorEndpoint, &o.TracingJaegerCollectorEndpoint)
 	copySrcToOptionalDest(&settings.TracingJaegerAgentEndpoint, &o.TracingJaegerAgentEndpoint)
 	copySrcToOptionalDest(&settings.TracingZipkinEndpoint, &o.ZipkinEndpoint)
+	settings.TracingOtlp = o.TracingOTLP.ToProto()
 	copySrcToOptionalDest(&settings.GrpcAddress, &o.GRPCAddr)
 	settings.GrpcInsecure = o.GRPCInsecure
 	copyOptionalDuration(&settings.GrpcClientTimeout, o.GRPCClientTimeout)
@@ -1621,6 +1819,7 @@ func (o *Options) ToProto() *config.Config {
 	copySrcToOptionalDest(&settings.DatabrokerStorageConnectionString, valueOrFromFileRaw(o.DataBrokerStorageConnectionString, o.DataBrokerStorageConnectionStringFile))
 	settings.DownstreamMtls = o.DownstreamMTLS.ToProto()
 	copySrcToOptionalDest(&settings.GoogleCloudServerlessAuthenticationServiceAccount, &o.GoogleCloudServerlessAuthenticationServiceAccount)
+	copySrcToOptionalDest(&settings.GeoipDatabaseFile, &o.GeoIPDatabaseFile)
 	copySrcToOptionalDest(&settings.UseProxyProtocol, &o.UseProxyProtocol)
 	copySrcToOptionalDest(&settings.Autocert, &o.AutocertOptions.Enable)
 	copySrcToOptionalDest(&settings.AutocertCa, &o.AutocertOptions.CA)
@@ -1640,6 +1839,10 @@ func (o *Options) ToProto() *config.Config {
 	settings.EnvoyBindConfigFreebind = o.EnvoyBindConfigFreebind.Ptr()
 	settings.ProgrammaticRedirectDomainWhitelist = o.ProgrammaticRedirectDomainWhitelist
 	settings.AuditKey = o.AuditKey.ToProto()
+	for i := range o.AuditSinks {
+		settings.AuditSinks = append(settings.AuditSinks, o.AuditSinks[i].ToProto())
+	}
+	settings.Impersonation = o.Impersonation.ToProto()
 	if o.CodecType != "" {
 		codecType := o.CodecType.ToEnvoy()
 		settings.CodecType = &codecType
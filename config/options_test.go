@@ -72,6 +72,8 @@ func Test_Validate(t *testing.T) {
 	invalidStorageType.DataBrokerStorageType = "foo"
 	missingStorageDSN := testOptions()
 	missingStorageDSN.DataBrokerStorageType = "postgres"
+	missingStoragePath := testOptions()
+	missingStoragePath.DataBrokerStorageType = "file"
 	badSignoutRedirectURL := testOptions()
 	badSignoutRedirectURL.SignOutRedirectURLString = "--"
 	badCookieSettings := testOptions()
@@ -89,6 +91,7 @@ func Test_Validate(t *testing.T) {
 		{"policy file specified", badPolicyFile, true},
 		{"invalid databroker storage type", invalidStorageType, true},
 		{"missing databroker storage dsn", missingStorageDSN, true},
+		{"missing databroker storage path", missingStoragePath, true},
 		{"invalid signout redirect url", badSignoutRedirectURL, true},
 	}
 	for _, tt := range tests {
@@ -355,6 +358,27 @@ func Test_parsePolicyFile(t *testing.T) {
 	}
 }
 
+func Test_parsePolicyAllErrors(t *testing.T) {
+	t.Parallel()
+
+	o := NewDefaultOptions()
+	o.viper.SetConfigType("yaml")
+	require.NoError(t, o.viper.ReadConfig(strings.NewReader(`
+routes:
+  - from: https://a.example.com
+    to: https://httpbin.org
+  - from: https://b.example.com/path
+    to: https://httpbin.org
+  - from: "%"
+    to: https://httpbin.org
+`)))
+
+	err := o.parsePolicy()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "route 1 (https://b.example.com/path): config: policy source url")
+	assert.Contains(t, err.Error(), "route 2 (%): config: policy bad source url")
+}
+
 func Test_decodeSANMatcher(t *testing.T) {
 	// Verify that config file parsing will decode the SANMatcher type.
 	const yaml = `
@@ -1515,6 +1539,7 @@ func TestOptions_FromToProto(t *testing.T) {
 				"tls_client_cert_file",
 				"tls_client_key_file",
 				"tls_downstream_client_ca_file",
+				"impersonation.policy",
 			},
 		})
 
@@ -1522,6 +1547,7 @@ func TestOptions_FromToProto(t *testing.T) {
 		require.NoError(t, err)
 		unsetFalseOptionalBoolFields(settings)
 		fixZeroValuedEnums(settings)
+		unsetEmptyMessageFields(settings)
 		generateCertificates(t, settings)
 
 		return settings
@@ -1562,6 +1588,19 @@ func unsetFalseOptionalBoolFields(msg proto.Message) {
 	})
 }
 
+// unset any message fields with no fields set, as settings messages with no
+// values are converted to nil
+func unsetEmptyMessageFields(msg proto.Message) {
+	msg.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Cardinality() == protoreflect.Optional && fd.Kind() == protoreflect.MessageKind && !fd.IsMap() {
+			if proto.Size(v.Message().Interface()) == 0 {
+				msg.ProtoReflect().Clear(fd)
+			}
+		}
+		return true
+	})
+}
+
 func fixZeroValuedEnums(msg *configpb.Settings) {
 	if msg.DownstreamMtls != nil && msg.DownstreamMtls.Enforcement != nil {
 		// there is no "unknown" equivalent, so if the value is randomly set to
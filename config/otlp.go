@@ -0,0 +1,176 @@
+package config
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/pomerium/pomerium/internal/telemetry/otlp"
+	"github.com/pomerium/pomerium/pkg/grpc/config"
+)
+
+// DefaultOTLPMetricsInterval is the default interval at which metrics are
+// pushed to an OTLP collector.
+const DefaultOTLPMetricsInterval = time.Minute
+
+// OTLPSettings configures exporting telemetry to an OpenTelemetry collector
+// using the OpenTelemetry Protocol (OTLP).
+type OTLPSettings struct {
+	// Endpoint is the URL of the collector, using either the http:// or
+	// https:// scheme. If the port is omitted, 4317 is used for grpc and
+	// 4318 for http/protobuf. For http/protobuf, if the path is omitted,
+	// the default signal path (e.g. /v1/traces) is used.
+	Endpoint string `mapstructure:"endpoint" yaml:"endpoint,omitempty"`
+
+	// Protocol is either grpc (the default) or http/protobuf.
+	Protocol string `mapstructure:"protocol" yaml:"protocol,omitempty"`
+
+	// Headers are sent with every export request.
+	Headers map[string]string `mapstructure:"headers" yaml:"headers,omitempty"`
+
+	// CA is the base64-encoded certificate authority used to verify the
+	// collector's certificate.
+	CA string `mapstructure:"ca" yaml:"ca,omitempty"`
+
+	// CAFile is the path to a file containing the certificate authority used
+	// to verify the collector's certificate.
+	CAFile string `mapstructure:"ca_file" yaml:"ca_file,omitempty"`
+
+	// TLSSkipVerify disables verification of the collector's certificate.
+	TLSSkipVerify bool `mapstructure:"tls_skip_verify" yaml:"tls_skip_verify,omitempty"`
+
+	// ResourceAttributes are added to the exported resource, such as
+	// deployment.environment.
+	ResourceAttributes map[string]string `mapstructure:"resource_attributes" yaml:"resource_attributes,omitempty"`
+
+	// Interval is how often metrics are pushed. It is ignored for tracing.
+	Interval time.Duration `mapstructure:"interval" yaml:"interval,omitempty"`
+}
+
+// Enabled returns true if a collector endpoint is configured.
+func (s *OTLPSettings) Enabled() bool {
+	return s != nil && s.Endpoint != ""
+}
+
+// GetCA returns the certificate authority (or nil if unset).
+func (s *OTLPSettings) GetCA() ([]byte, error) {
+	if s.CA != "" {
+		ca, err := base64.StdEncoding.DecodeString(s.CA)
+		if err != nil {
+			return nil, fmt.Errorf("CA: %w", err)
+		}
+		return ca, nil
+	}
+	if s.CAFile != "" {
+		ca, err := os.ReadFile(s.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("CA file: %w", err)
+		}
+		return ca, nil
+	}
+	return nil, nil
+}
+
+// GetInterval returns how often metrics are pushed.
+func (s *OTLPSettings) GetInterval() time.Duration {
+	if s.Interval <= 0 {
+		return DefaultOTLPMetricsInterval
+	}
+	return s.Interval
+}
+
+// NewOptions returns the options used to create OTLP exporters.
+func (s *OTLPSettings) NewOptions() (*otlp.Options, error) {
+	endpoint, err := otlp.ParseEndpoint(s.Endpoint, s.Protocol)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint: %w", err)
+	}
+	ca, err := s.GetCA()
+	if err != nil {
+		return nil, err
+	}
+	return &otlp.Options{
+		Endpoint:           endpoint,
+		Protocol:           s.Protocol,
+		Headers:            s.Headers,
+		CA:                 ca,
+		TLSSkipVerify:      s.TLSSkipVerify,
+		ResourceAttributes: s.ResourceAttributes,
+	}, nil
+}
+
+func (s *OTLPSettings) validate() error {
+	if !s.Enabled() {
+		return nil
+	}
+
+	switch s.Protocol {
+	case "", otlp.ProtocolGRPC, otlp.ProtocolHTTP:
+	default:
+		return fmt.Errorf("unsupported protocol: %q", s.Protocol)
+	}
+
+	if s.CA != "" && s.CAFile != "" {
+		return errors.New("cannot set both ca and ca_file")
+	}
+
+	if s.Interval < 0 {
+		return errors.New("interval must not be negative")
+	}
+
+	options, err := s.NewOptions()
+	if err != nil {
+		return err
+	}
+	if _, err := options.TLSConfig(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *OTLPSettings) applySettingsProto(p *config.OtlpSettings) {
+	if p == nil {
+		return
+	}
+	set(&s.Endpoint, p.Endpoint)
+	set(&s.Protocol, p.Protocol)
+	if len(p.Headers) > 0 {
+		s.Headers = p.Headers
+	}
+	set(&s.CA, p.Ca)
+	s.TLSSkipVerify = p.GetTlsSkipVerify()
+	if len(p.ResourceAttributes) > 0 {
+		s.ResourceAttributes = p.ResourceAttributes
+	}
+	if p.Interval != nil {
+		s.Interval = p.Interval.AsDuration()
+	}
+}
+
+// ToProto converts the OTLP settings to a protobuf message.
+func (s *OTLPSettings) ToProto() *config.OtlpSettings {
+	if s == nil {
+		return nil
+	}
+	p := &config.OtlpSettings{
+		Headers:            s.Headers,
+		TlsSkipVerify:      s.TLSSkipVerify,
+		ResourceAttributes: s.ResourceAttributes,
+	}
+	copySrcToOptionalDest(&p.Endpoint, &s.Endpoint)
+	copySrcToOptionalDest(&p.Protocol, &s.Protocol)
+	copySrcToOptionalDest(&p.Ca, valueOrFromFileBase64(s.CA, s.CAFile))
+	if s.Interval != 0 {
+		p.Interval = durationpb.New(s.Interval)
+	}
+	if proto.Size(p) == 0 {
+		return nil
+	}
+	return p
+}
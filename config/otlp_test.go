@@ -0,0 +1,68 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOTLPSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("validate", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tc := range []struct {
+			name     string
+			settings OTLPSettings
+			expect   string
+		}{
+			{"disabled", OTLPSettings{}, ""},
+			{"grpc", OTLPSettings{Endpoint: "https://collector.example.com"}, ""},
+			{"http", OTLPSettings{Endpoint: "http://collector.example.com:4318", Protocol: "http/protobuf"}, ""},
+			{"invalid protocol", OTLPSettings{Endpoint: "https://collector.example.com", Protocol: "http/json"}, `unsupported protocol: "http/json"`},
+			{"invalid scheme", OTLPSettings{Endpoint: "collector.example.com:4317"}, `invalid endpoint: unsupported url scheme: "collector.example.com"`},
+			{"both ca and ca_file", OTLPSettings{Endpoint: "https://collector.example.com", CA: "Zm9v", CAFile: "ca.pem"}, "cannot set both ca and ca_file"},
+			{"invalid ca", OTLPSettings{Endpoint: "https://collector.example.com", CA: "Zm9v"}, "otlp: no certificates found in CA"},
+			{"negative interval", OTLPSettings{Endpoint: "https://collector.example.com", Interval: -time.Second}, "interval must not be negative"},
+		} {
+			err := tc.settings.validate()
+			if tc.expect == "" {
+				assert.NoError(t, err, tc.name)
+			} else {
+				assert.EqualError(t, err, tc.expect, tc.name)
+			}
+		}
+	})
+	t.Run("proto", func(t *testing.T) {
+		t.Parallel()
+
+		settings := OTLPSettings{
+			Endpoint:           "https://collector.example.com",
+			Protocol:           "http/protobuf",
+			Headers:            map[string]string{"X-API-Key": "secret"},
+			TLSSkipVerify:      true,
+			ResourceAttributes: map[string]string{"deployment.environment": "production"},
+			Interval:           30 * time.Second,
+		}
+		var actual OTLPSettings
+		actual.applySettingsProto(settings.ToProto())
+		assert.Equal(t, settings, actual)
+
+		assert.Nil(t, new(OTLPSettings).ToProto())
+	})
+	t.Run("tracing options", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewTracingOptions(&Options{TracingProvider: "otlp"})
+		assert.EqualError(t, err, "config: tracing_otlp endpoint is required")
+
+		opts, err := NewTracingOptions(&Options{
+			TracingProvider: "otlp",
+			TracingOTLP:     OTLPSettings{Endpoint: "http://collector.example.com"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "http://collector.example.com:4317", opts.OTLP.Endpoint.String())
+	})
+}
@@ -183,6 +183,21 @@ type Policy struct {
 	// ShowErrorDetails indicates whether or not additional error details should be displayed.
 	ShowErrorDetails bool `mapstructure:"show_error_details" yaml:"show_error_details" json:"show_error_details"`
 
+	// RateLimit limits the rate of requests to the route.
+	RateLimit *RateLimit `mapstructure:"rate_limit" yaml:"rate_limit,omitempty" json:"rate_limit,omitempty"`
+
+	// HealthChecks are active health checks of the upstream endpoints.
+	HealthChecks []HealthCheck `mapstructure:"health_checks" yaml:"health_checks,omitempty" json:"health_checks,omitempty"`
+	// OutlierDetection ejects upstream endpoints which fail proxied requests.
+	OutlierDetection *OutlierDetection `mapstructure:"outlier_detection" yaml:"outlier_detection,omitempty" json:"outlier_detection,omitempty"`
+	// LoadBalancingPolicy makes requests sticky to an upstream endpoint.
+	LoadBalancingPolicy *LoadBalancingPolicy `mapstructure:"load_balancing_policy" yaml:"load_balancing_policy,omitempty" json:"load_balancing_policy,omitempty"`
+	// RetryPolicy retries failed requests to the upstream endpoints.
+	RetryPolicy *RetryPolicy `mapstructure:"retry_policy" yaml:"retry_policy,omitempty" json:"retry_policy,omitempty"`
+	// CircuitBreakers limit the concurrent connections and requests to the
+	// upstream endpoints.
+	CircuitBreakers *CircuitBreakers `mapstructure:"circuit_breakers" yaml:"circuit_breakers,omitempty" json:"circuit_breakers,omitempty"`
+
 	Policy *PPLPolicy `mapstructure:"policy" yaml:"policy,omitempty" json:"policy,omitempty"`
 }
 
@@ -279,6 +294,7 @@ func NewPolicyFromProto(pb *configpb.Route) (*Policy, error) {
 		AllowPublicUnauthenticatedAccess: pb.GetAllowPublicUnauthenticatedAccess(),
 		AllowSPDY:                        pb.GetAllowSpdy(),
 		AllowWebsockets:                  pb.GetAllowWebsockets(),
+		CircuitBreakers:                  NewCircuitBreakersFromProto(pb.GetCircuitBreakers()),
 		CORSAllowPreflight:               pb.GetCorsAllowPreflight(),
 		EnableGoogleCloudServerlessAuthentication: pb.GetEnableGoogleCloudServerlessAuthentication(),
 		From:                              pb.GetFrom(),
@@ -292,15 +308,19 @@ func NewPolicyFromProto(pb *configpb.Route) (*Policy, error) {
 		IDPClientSecret:                   pb.GetIdpClientSecret(),
 		KubernetesServiceAccountToken:     pb.GetKubernetesServiceAccountToken(),
 		KubernetesServiceAccountTokenFile: pb.GetKubernetesServiceAccountTokenFile(),
+		LoadBalancingPolicy:               NewLoadBalancingPolicyFromProto(pb.GetLoadBalancingPolicy()),
 		PassIdentityHeaders:               pb.PassIdentityHeaders,
 		Path:                              pb.GetPath(),
 		Prefix:                            pb.GetPrefix(),
 		PrefixRewrite:                     pb.GetPrefixRewrite(),
 		PreserveHostHeader:                pb.GetPreserveHostHeader(),
+		OutlierDetection:                  NewOutlierDetectionFromProto(pb.GetOutlierDetection()),
+		RateLimit:                         NewRateLimitFromProto(pb.GetRateLimit()),
 		Regex:                             pb.GetRegex(),
 		RegexPriorityOrder:                pb.RegexPriorityOrder,
 		RegexRewritePattern:               pb.GetRegexRewritePattern(),
 		RegexRewriteSubstitution:          pb.GetRegexRewriteSubstitution(),
+		RetryPolicy:                       NewRetryPolicyFromProto(pb.GetRetryPolicy()),
 		RemoveRequestHeaders:              pb.GetRemoveRequestHeaders(),
 		SetRequestHeaders:                 pb.GetSetRequestHeaders(),
 		SetResponseHeaders:                pb.GetSetResponseHeaders(),
@@ -368,6 +388,10 @@ func NewPolicyFromProto(pb *configpb.Route) (*Policy, error) {
 		p.JWTIssuerFormat = "uri"
 	}
 
+	for _, hc := range pb.GetHealthChecks() {
+		p.HealthChecks = append(p.HealthChecks, NewHealthCheckFromProto(hc))
+	}
+
 	for _, rwh := range pb.RewriteResponseHeaders {
 		p.RewriteResponseHeaders = append(p.RewriteResponseHeaders, RewriteHeader{
 			Header: rwh.GetHeader(),
@@ -426,6 +450,7 @@ func (p *Policy) ToProto() (*configpb.Route, error) {
 		AllowPublicUnauthenticatedAccess: p.AllowPublicUnauthenticatedAccess,
 		AllowSpdy:                        p.AllowSPDY,
 		AllowWebsockets:                  p.AllowWebsockets,
+		CircuitBreakers:                  p.CircuitBreakers.ToProto(),
 		CorsAllowPreflight:               p.CORSAllowPreflight,
 		EnableGoogleCloudServerlessAuthentication: p.EnableGoogleCloudServerlessAuthentication,
 		EnvoyOpts:                         p.EnvoyOpts,
@@ -434,18 +459,22 @@ func (p *Policy) ToProto() (*configpb.Route, error) {
 		IdleTimeout:                       idleTimeout,
 		KubernetesServiceAccountToken:     p.KubernetesServiceAccountToken,
 		KubernetesServiceAccountTokenFile: p.KubernetesServiceAccountTokenFile,
+		LoadBalancingPolicy:               p.LoadBalancingPolicy.ToProto(),
 		Name:                              fmt.Sprint(p.RouteID()),
+		OutlierDetection:                  p.OutlierDetection.ToProto(),
 		PassIdentityHeaders:               p.PassIdentityHeaders,
 		Path:                              p.Path,
 		Policies:                          sps,
 		Prefix:                            p.Prefix,
 		PrefixRewrite:                     p.PrefixRewrite,
 		PreserveHostHeader:                p.PreserveHostHeader,
+		RateLimit:                         p.RateLimit.ToProto(),
 		Regex:                             p.Regex,
 		RegexPriorityOrder:                p.RegexPriorityOrder,
 		RegexRewritePattern:               p.RegexRewritePattern,
 		RegexRewriteSubstitution:          p.RegexRewriteSubstitution,
 		RemoveRequestHeaders:              p.RemoveRequestHeaders,
+		RetryPolicy:                       p.RetryPolicy.ToProto(),
 		SetRequestHeaders:                 p.SetRequestHeaders,
 		SetResponseHeaders:                p.SetResponseHeaders,
 		ShowErrorDetails:                  p.ShowErrorDetails,
@@ -515,6 +544,10 @@ func (p *Policy) ToProto() (*configpb.Route, error) {
 		pb.JwtIssuerFormat = configpb.IssuerFormat_IssuerURI
 	}
 
+	for i := range p.HealthChecks {
+		pb.HealthChecks = append(pb.HealthChecks, p.HealthChecks[i].ToProto())
+	}
+
 	for _, rwh := range p.RewriteResponseHeaders {
 		pb.RewriteResponseHeaders = append(pb.RewriteResponseHeaders, &configpb.RouteRewriteHeader{
 			Header: rwh.Header,
@@ -567,6 +600,46 @@ func (p *Policy) Validate() error {
 		return fmt.Errorf("config: %w", err)
 	}
 
+	if p.RateLimit != nil {
+		if err := p.RateLimit.Validate(); err != nil {
+			return fmt.Errorf("config: invalid rate_limit: %w", err)
+		}
+	}
+
+	for i := range p.HealthChecks {
+		if err := p.HealthChecks[i].Validate(); err != nil {
+			return fmt.Errorf("config: invalid health_checks[%d]: %w", i, err)
+		}
+	}
+
+	if p.OutlierDetection != nil {
+		if err := p.OutlierDetection.Validate(); err != nil {
+			return fmt.Errorf("config: invalid outlier_detection: %w", err)
+		}
+	}
+
+	if p.LoadBalancingPolicy != nil {
+		if err := p.LoadBalancingPolicy.Validate(); err != nil {
+			return fmt.Errorf("config: invalid load_balancing_policy: %w", err)
+		}
+	}
+
+	if p.RetryPolicy != nil {
+		if err := p.RetryPolicy.Validate(); err != nil {
+			return fmt.Errorf("config: invalid retry_policy: %w", err)
+		}
+		// each attempt must fit in the route timeout
+		if p.UpstreamTimeout != nil && *p.UpstreamTimeout != 0 && p.RetryPolicy.PerTryTimeout > *p.UpstreamTimeout {
+			return fmt.Errorf("config: invalid retry_policy: per_try_timeout must not exceed the route timeout")
+		}
+	}
+
+	if p.CircuitBreakers != nil {
+		if err := p.CircuitBreakers.Validate(); err != nil {
+			return fmt.Errorf("config: invalid circuit_breakers: %w", err)
+		}
+	}
+
 	// Only allow public access if no other whitelists are in place
 	if p.AllowPublicUnauthenticatedAccess && (p.AllowAnyAuthenticatedUser || p.AllowedDomains != nil || p.AllowedUsers != nil) {
 		return fmt.Errorf("config: policy route marked as public but contains whitelists")
@@ -0,0 +1,139 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/net/http/httpguts"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	configpb "github.com/pomerium/pomerium/pkg/grpc/config"
+)
+
+// A RateLimitKey determines how requests are grouped when rate limiting.
+type RateLimitKey string
+
+// RateLimitKey values.
+const (
+	// RateLimitKeyRoute limits all the requests to a route together.
+	RateLimitKeyRoute RateLimitKey = "route"
+	// RateLimitKeySourceIP limits requests by the client's IP address.
+	RateLimitKeySourceIP RateLimitKey = "source_ip"
+	// RateLimitKeyUserID limits requests by the user's ID.
+	RateLimitKeyUserID RateLimitKey = "user_id"
+	// RateLimitKeySessionID limits requests by the user's session ID.
+	RateLimitKeySessionID RateLimitKey = "session_id"
+	// RateLimitKeyHeader limits requests by the value of a request header.
+	RateLimitKeyHeader RateLimitKey = "header"
+)
+
+// DefaultRateLimitInterval is the interval used when none is set.
+const DefaultRateLimitInterval = time.Second
+
+// A RateLimit limits the rate of requests to a route. Requests which exceed
+// the limit receive a 429 Too Many Requests response.
+//
+// Requests which are missing the key (for example unauthenticated requests
+// when limiting by user ID, or requests without the header) are limited
+// together.
+type RateLimit struct {
+	// Requests is the number of requests allowed per interval.
+	Requests uint32 `mapstructure:"requests" yaml:"requests" json:"requests"`
+	// Interval is the interval over which requests are counted. Defaults to
+	// one second.
+	Interval time.Duration `mapstructure:"interval" yaml:"interval,omitempty" json:"interval,omitempty"`
+	// Burst is the maximum number of requests allowed at once. Defaults to
+	// Requests.
+	Burst uint32 `mapstructure:"burst" yaml:"burst,omitempty" json:"burst,omitempty"`
+	// Key determines how requests are grouped. Defaults to source_ip.
+	Key RateLimitKey `mapstructure:"key" yaml:"key,omitempty" json:"key,omitempty"`
+	// HeaderName is the name of the header used when Key is header.
+	HeaderName string `mapstructure:"header_name" yaml:"header_name,omitempty" json:"header_name,omitempty"`
+}
+
+// GetInterval returns the interval over which requests are counted.
+func (rl *RateLimit) GetInterval() time.Duration {
+	if rl.Interval <= 0 {
+		return DefaultRateLimitInterval
+	}
+	return rl.Interval
+}
+
+// GetBurst returns the maximum number of requests allowed at once.
+func (rl *RateLimit) GetBurst() uint32 {
+	if rl.Burst == 0 {
+		return rl.Requests
+	}
+	return rl.Burst
+}
+
+// GetKey returns how requests are grouped.
+func (rl *RateLimit) GetKey() RateLimitKey {
+	if rl.Key == "" {
+		return RateLimitKeySourceIP
+	}
+	return rl.Key
+}
+
+// Validate checks the validity of the rate limit.
+func (rl *RateLimit) Validate() error {
+	if rl.Requests == 0 {
+		return errors.New("requests is required")
+	}
+	if rl.Interval < 0 {
+		return errors.New("interval must be positive")
+	}
+
+	switch rl.GetKey() {
+	case RateLimitKeyRoute, RateLimitKeySourceIP, RateLimitKeyUserID, RateLimitKeySessionID:
+		if rl.HeaderName != "" {
+			return fmt.Errorf("header_name is only supported with the %s key", RateLimitKeyHeader)
+		}
+	case RateLimitKeyHeader:
+		if rl.HeaderName == "" {
+			return errors.New("header_name is required")
+		}
+		if !httpguts.ValidHeaderFieldName(rl.HeaderName) {
+			return fmt.Errorf("invalid header_name: %q", rl.HeaderName)
+		}
+	default:
+		return fmt.Errorf("unsupported key: %q", rl.Key)
+	}
+
+	return nil
+}
+
+// ToProto converts the rate limit to a protobuf type.
+func (rl *RateLimit) ToProto() *configpb.RouteRateLimit {
+	if rl == nil {
+		return nil
+	}
+	pb := &configpb.RouteRateLimit{
+		Requests:   rl.Requests,
+		Burst:      rl.Burst,
+		Key:        string(rl.Key),
+		HeaderName: rl.HeaderName,
+	}
+	if rl.Interval != 0 {
+		pb.Interval = durationpb.New(rl.Interval)
+	}
+	return pb
+}
+
+// NewRateLimitFromProto creates a new RateLimit from a protobuf type.
+func NewRateLimitFromProto(pb *configpb.RouteRateLimit) *RateLimit {
+	if pb == nil {
+		return nil
+	}
+	rl := &RateLimit{
+		Requests:   pb.GetRequests(),
+		Burst:      pb.GetBurst(),
+		Key:        RateLimitKey(pb.GetKey()),
+		HeaderName: pb.GetHeaderName(),
+	}
+	if pb.Interval != nil {
+		rl.Interval = pb.GetInterval().AsDuration()
+	}
+	return rl
+}
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit_Validate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		rateLimit RateLimit
+		expect    string
+	}{
+		{"valid", RateLimit{Requests: 10}, ""},
+		{"valid header", RateLimit{Requests: 10, Key: RateLimitKeyHeader, HeaderName: "X-Api-Key"}, ""},
+		{"missing requests", RateLimit{Key: RateLimitKeyUserID}, "requests is required"},
+		{"negative interval", RateLimit{Requests: 10, Interval: -time.Second}, "interval must be positive"},
+		{"missing header name", RateLimit{Requests: 10, Key: RateLimitKeyHeader}, "header_name is required"},
+		{"invalid header name", RateLimit{Requests: 10, Key: RateLimitKeyHeader, HeaderName: "X Api Key"}, `invalid header_name: "X Api Key"`},
+		{"unexpected header name", RateLimit{Requests: 10, HeaderName: "X-Api-Key"}, "header_name is only supported with the header key"},
+		{"unsupported key", RateLimit{Requests: 10, Key: "cookie"}, `unsupported key: "cookie"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rateLimit.Validate()
+			if tc.expect == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expect)
+			}
+		})
+	}
+}
+
+func TestRateLimit_Defaults(t *testing.T) {
+	t.Parallel()
+
+	rl := &RateLimit{Requests: 10}
+	assert.Equal(t, time.Second, rl.GetInterval())
+	assert.Equal(t, uint32(10), rl.GetBurst())
+	assert.Equal(t, RateLimitKeySourceIP, rl.GetKey())
+}
+
+func TestRateLimit_Proto(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, (*RateLimit)(nil).ToProto())
+	assert.Nil(t, NewRateLimitFromProto(nil))
+
+	rl := &RateLimit{
+		Requests:   10,
+		Interval:   time.Minute,
+		Burst:      20,
+		Key:        RateLimitKeyHeader,
+		HeaderName: "X-Api-Key",
+	}
+	assert.Equal(t, rl, NewRateLimitFromProto(rl.ToProto()))
+}
@@ -0,0 +1,302 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/telemetry/metrics"
+	"github.com/pomerium/pomerium/pkg/netutil"
+)
+
+const (
+	// DefaultRemoteSourcePollInterval is the default interval a RemoteSource
+	// polls for configuration changes.
+	DefaultRemoteSourcePollInterval = 30 * time.Second
+
+	// maxRemoteConfigSize limits the size of the configuration and signature
+	// read from the remote server.
+	maxRemoteConfigSize = 16 << 20
+)
+
+// RemoteSourceOptions are the options for a RemoteSource.
+type RemoteSourceOptions struct {
+	// URL is the https URL the YAML configuration is retrieved from.
+	URL string
+	// SignatureURL is the https URL the detached ed25519 signature of the
+	// configuration is retrieved from. Defaults to URL with ".sig" appended.
+	SignatureURL string
+	// PublicKey is the ed25519 public key the configuration is verified with.
+	PublicKey ed25519.PublicKey
+	// ClientCertificate is an optional client certificate used to
+	// authenticate to the remote server.
+	ClientCertificate *tls.Certificate
+	// RootCAs are used to verify the remote server. Defaults to the system
+	// roots.
+	RootCAs *x509.CertPool
+	// PollInterval is how often the configuration is checked for changes.
+	// Defaults to DefaultRemoteSourcePollInterval.
+	PollInterval time.Duration
+}
+
+// A RemoteSource retrieves config options from a remote https server. The
+// configuration is polled for changes and is only applied if its signature is
+// valid. If retrieving or validating a new configuration fails, the last good
+// configuration is kept.
+type RemoteSource struct {
+	options RemoteSourceOptions
+	client  *http.Client
+
+	mu     sync.RWMutex
+	config *Config
+	etag   string
+	hash   uint64
+
+	ChangeDispatcher
+}
+
+// NewRemoteSource creates a new RemoteSource. The initial configuration must be
+// retrieved and verified successfully.
+func NewRemoteSource(
+	ctx context.Context,
+	options RemoteSourceOptions,
+	envoyVersion string,
+) (*RemoteSource, error) {
+	ctx = log.WithContext(ctx, func(c zerolog.Context) zerolog.Context {
+		return c.Str("config_remote_source", options.URL)
+	})
+
+	if err := validateRemoteSourceURL(options.URL); err != nil {
+		return nil, fmt.Errorf("config: invalid remote config url: %w", err)
+	}
+	if options.SignatureURL == "" {
+		options.SignatureURL = options.URL + ".sig"
+	}
+	if err := validateRemoteSourceURL(options.SignatureURL); err != nil {
+		return nil, fmt.Errorf("config: invalid remote config signature url: %w", err)
+	}
+	if len(options.PublicKey) != ed25519.PublicKeySize {
+		return nil, errors.New("config: remote config public key is required")
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = DefaultRemoteSourcePollInterval
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:    options.RootCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+	if options.ClientCertificate != nil {
+		transport.TLSClientConfig.Certificates = []tls.Certificate{*options.ClientCertificate}
+	}
+
+	src := &RemoteSource{
+		options: options,
+		client:  &http.Client{Transport: transport},
+	}
+
+	res, err := src.fetch(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{
+		Options:      res.options,
+		EnvoyVersion: envoyVersion,
+	}
+
+	ports, err := netutil.AllocatePorts(6)
+	if err != nil {
+		return nil, fmt.Errorf("allocating ports: %w", err)
+	}
+
+	cfg.AllocatePorts(*(*[6]string)(ports))
+
+	metrics.SetConfigInfo(ctx, cfg.Options.Services, "remote", cfg.Checksum(), true)
+
+	src.config = cfg
+	src.etag = res.etag
+	src.hash = res.hash
+
+	go src.run(ctx)
+
+	return src, nil
+}
+
+// GetConfig gets the config.
+func (src *RemoteSource) GetConfig() *Config {
+	src.mu.RLock()
+	defer src.mu.RUnlock()
+
+	return src.config
+}
+
+func (src *RemoteSource) run(ctx context.Context) {
+	ticker := time.NewTicker(src.options.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		src.check(ctx)
+	}
+}
+
+func (src *RemoteSource) check(ctx context.Context) {
+	ctx = log.WithContext(ctx, func(c zerolog.Context) zerolog.Context {
+		return c.Str("config_change_id", uuid.New().String())
+	})
+
+	src.mu.RLock()
+	etag, hash := src.etag, src.hash
+	src.mu.RUnlock()
+
+	res, err := src.fetch(ctx, etag)
+	if err != nil {
+		// keep the last known good config
+		log.Ctx(ctx).Error().Err(err).Msg("config/remotesource: error updating config")
+		cfg := src.GetConfig()
+		metrics.SetConfigInfo(ctx, cfg.Options.Services, "remote", cfg.Checksum(), false)
+		return
+	} else if res == nil || res.hash == hash {
+		log.Ctx(ctx).Debug().Msg("config/remotesource: no change detected")
+		src.mu.Lock()
+		if res != nil {
+			src.etag = res.etag
+		}
+		src.mu.Unlock()
+		return
+	}
+
+	log.Ctx(ctx).Info().Msg("config/remotesource: change detected, reconfiguring...")
+	src.mu.Lock()
+	cfg := src.config.Clone()
+	cfg.Options = res.options
+	src.config = cfg
+	src.etag = res.etag
+	src.hash = res.hash
+	src.mu.Unlock()
+
+	metrics.SetConfigInfo(ctx, cfg.Options.Services, "remote", cfg.Checksum(), true)
+	log.Ctx(ctx).Info().Msg("config: loaded configuration")
+
+	src.Trigger(ctx, cfg)
+}
+
+type remoteSourceResult struct {
+	options *Options
+	etag    string
+	hash    uint64
+}
+
+// fetch retrieves, verifies and parses the configuration. If the
+// configuration has not changed since etag, nil is returned.
+func (src *RemoteSource) fetch(ctx context.Context, etag string) (*remoteSourceResult, error) {
+	data, newETag, err := src.get(ctx, src.options.URL, etag)
+	if err != nil {
+		return nil, fmt.Errorf("config: error retrieving remote config: %w", err)
+	} else if data == nil {
+		return nil, nil
+	}
+
+	signature, _, err := src.get(ctx, src.options.SignatureURL, "")
+	if err != nil {
+		return nil, fmt.Errorf("config: error retrieving remote config signature: %w", err)
+	}
+	if err := verifyRemoteConfigSignature(src.options.PublicKey, data, signature); err != nil {
+		return nil, err
+	}
+
+	options, err := newOptionsFromYAML(src.options.URL, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &remoteSourceResult{
+		options: options,
+		etag:    newETag,
+		hash:    xxhash.Sum64(data),
+	}, nil
+}
+
+// get retrieves the body of rawURL. If etag is set and the content has not
+// changed, nil is returned.
+func (src *RemoteSource) get(ctx context.Context, rawURL, etag string) (body []byte, newETag string, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	res, err := src.client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusNotModified && etag != "":
+		return nil, etag, nil
+	case res.StatusCode != http.StatusOK:
+		return nil, "", fmt.Errorf("unexpected status code from %s: %d", rawURL, res.StatusCode)
+	}
+
+	body, err = io.ReadAll(io.LimitReader(res.Body, maxRemoteConfigSize+1))
+	if err != nil {
+		return nil, "", err
+	} else if len(body) > maxRemoteConfigSize {
+		return nil, "", fmt.Errorf("response from %s exceeds %d bytes", rawURL, maxRemoteConfigSize)
+	}
+
+	return body, res.Header.Get("ETag"), nil
+}
+
+// verifyRemoteConfigSignature verifies the detached ed25519 signature of the
+// configuration. The signature may be raw or base64 encoded.
+func verifyRemoteConfigSignature(publicKey ed25519.PublicKey, data, signature []byte) error {
+	if len(signature) != ed25519.SignatureSize {
+		decoded, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(signature)))
+		if err != nil {
+			return fmt.Errorf("config: invalid remote config signature encoding: %w", err)
+		}
+		signature = decoded
+	}
+
+	if !ed25519.Verify(publicKey, data, signature) {
+		return errors.New("config: invalid remote config signature")
+	}
+	return nil
+}
+
+func validateRemoteSourceURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("%s must use https", rawURL)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package config
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+type testRemoteConfigServer struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	data        []byte
+	signature   []byte
+	etag        string
+	notModified int
+}
+
+func newTestRemoteConfigServer(t *testing.T, privateKey ed25519.PrivateKey, data string) *testRemoteConfigServer {
+	t.Helper()
+
+	srv := new(testRemoteConfigServer)
+	srv.set(privateKey, data)
+	srv.Server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+
+		switch r.URL.Path {
+		case "/config.yaml":
+			if r.Header.Get("If-None-Match") == srv.etag {
+				srv.notModified++
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", srv.etag)
+			_, _ = w.Write(srv.data)
+		case "/config.yaml.sig":
+			_, _ = w.Write([]byte(base64.StdEncoding.EncodeToString(srv.signature)))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func (srv *testRemoteConfigServer) set(privateKey ed25519.PrivateKey, data string) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	srv.data = []byte(data)
+	srv.signature = ed25519.Sign(privateKey, srv.data)
+	srv.etag = fmt.Sprintf(`"%x"`, cryptutil.Hash("etag", srv.data))
+}
+
+func TestRemoteSource(t *testing.T) {
+	t.Parallel()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, otherPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	clientCert, err := cryptutil.GenerateCertificate(cryptutil.NewKey(), "client.example.com")
+	require.NoError(t, err)
+
+	srv := newTestRemoteConfigServer(t, privateKey, "insecure_server: true\naddress: :8080\n")
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(srv.Certificate())
+
+	newSource := func(t *testing.T, clientCert *tls.Certificate) (*RemoteSource, error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		return NewRemoteSource(ctx, RemoteSourceOptions{
+			URL:               srv.URL + "/config.yaml",
+			PublicKey:         publicKey,
+			ClientCertificate: clientCert,
+			RootCAs:           rootCAs,
+			PollInterval:      10 * time.Millisecond,
+		}, "")
+	}
+
+	t.Run("client certificate required", func(t *testing.T) {
+		_, err := newSource(t, nil)
+		assert.Error(t, err)
+	})
+
+	src, err := newSource(t, clientCert)
+	require.NoError(t, err)
+	assert.Equal(t, ":8080", src.GetConfig().Options.Addr)
+
+	ch := make(chan *Config, 10)
+	src.OnConfigChange(context.Background(), func(_ context.Context, cfg *Config) {
+		ch <- cfg
+	})
+
+	// unchanged configuration is not retrieved again
+	assert.Eventually(t, func() bool {
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+		return srv.notModified > 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Empty(t, ch)
+
+	// configuration with an invalid signature is not applied
+	srv.set(otherPrivateKey, "insecure_server: true\naddress: :8081\n")
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, ch)
+	assert.Equal(t, ":8080", src.GetConfig().Options.Addr)
+
+	// invalid configuration is not applied
+	srv.set(privateKey, "insecure_server: true\naddress: :8081\nservices: invalid\n")
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, ch)
+	assert.Equal(t, ":8080", src.GetConfig().Options.Addr)
+
+	srv.set(privateKey, "insecure_server: true\naddress: :8081\n")
+	select {
+	case cfg := <-ch:
+		assert.Equal(t, ":8081", cfg.Options.Addr)
+		assert.Equal(t, ":8081", src.GetConfig().Options.Addr)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected OnConfigChange to be fired after the configuration changed")
+	}
+
+	t.Run("invalid initial signature", func(t *testing.T) {
+		srv.set(otherPrivateKey, "insecure_server: true\n")
+		_, err := newSource(t, clientCert)
+		assert.ErrorContains(t, err, "invalid remote config signature")
+	})
+}
+
+func TestNewRemoteSourceValidation(t *testing.T) {
+	t.Parallel()
+
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name    string
+		options RemoteSourceOptions
+		err     string
+	}{
+		{"http", RemoteSourceOptions{URL: "http://example.com/config.yaml", PublicKey: publicKey}, "must use https"},
+		{"http signature", RemoteSourceOptions{
+			URL: "https://example.com/config.yaml", SignatureURL: "http://example.com/sig", PublicKey: publicKey,
+		}, "must use https"},
+		{"missing public key", RemoteSourceOptions{URL: "https://example.com/config.yaml"}, "public key is required"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewRemoteSource(context.Background(), tc.options, "")
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestVerifyRemoteConfigSignature(t *testing.T) {
+	t.Parallel()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	data := []byte("address: :8080\n")
+	signature := ed25519.Sign(privateKey, data)
+
+	assert.NoError(t, verifyRemoteConfigSignature(publicKey, data, signature))
+	assert.NoError(t, verifyRemoteConfigSignature(publicKey, data,
+		[]byte(base64.StdEncoding.EncodeToString(signature)+"\n")))
+	assert.Error(t, verifyRemoteConfigSignature(publicKey, []byte("address: :8081\n"), signature))
+	assert.Error(t, verifyRemoteConfigSignature(publicKey, data, []byte("not a signature")))
+}
@@ -0,0 +1,209 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	configpb "github.com/pomerium/pomerium/pkg/grpc/config"
+)
+
+// RetryOnConditions are the supported conditions a request is retried on.
+// See https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/router_filter#x-envoy-retry-on
+var RetryOnConditions = []string{
+	// http
+	"5xx",
+	"gateway-error",
+	"reset",
+	"reset-before-request",
+	"connect-failure",
+	"envoy-ratelimited",
+	"retriable-4xx",
+	"refused-stream",
+	"retriable-status-codes",
+	"http3-post-connect-failure",
+	// grpc
+	"cancelled",
+	"deadline-exceeded",
+	"internal",
+	"resource-exhausted",
+	"unavailable",
+}
+
+// retryOnRetriableStatusCodes is the condition retriable status codes require.
+const retryOnRetriableStatusCodes = "retriable-status-codes"
+
+// A RetryPolicy retries failed requests to a route's upstream endpoints.
+type RetryPolicy struct {
+	// RetryOn are the conditions a request is retried on. Defaults to
+	// connect-failure, refused-stream and gateway-error.
+	RetryOn []string `mapstructure:"retry_on" yaml:"retry_on,omitempty" json:"retry_on,omitempty"`
+	// NumRetries is the maximum number of retries. Defaults to one.
+	NumRetries uint32 `mapstructure:"num_retries" yaml:"num_retries,omitempty" json:"num_retries,omitempty"`
+	// PerTryTimeout is the timeout of each attempt, which must fit within the
+	// route timeout. Defaults to the route timeout.
+	PerTryTimeout time.Duration `mapstructure:"per_try_timeout" yaml:"per_try_timeout,omitempty" json:"per_try_timeout,omitempty"`
+	// BackoffBaseInterval is the base interval of the exponential backoff
+	// between retries. Defaults to 25ms.
+	BackoffBaseInterval time.Duration `mapstructure:"backoff_base_interval" yaml:"backoff_base_interval,omitempty" json:"backoff_base_interval,omitempty"`
+	// BackoffMaxInterval is the maximum interval between retries. Defaults to
+	// ten times the base interval.
+	BackoffMaxInterval time.Duration `mapstructure:"backoff_max_interval" yaml:"backoff_max_interval,omitempty" json:"backoff_max_interval,omitempty"`
+	// RetriableStatusCodes are upstream response statuses which are retried.
+	RetriableStatusCodes []uint32 `mapstructure:"retriable_status_codes" yaml:"retriable_status_codes,omitempty" json:"retriable_status_codes,omitempty"`
+	// HedgeOnPerTryTimeout sends another request when an attempt times out,
+	// without cancelling the first one, and uses whichever responds first.
+	HedgeOnPerTryTimeout bool `mapstructure:"hedge_on_per_try_timeout" yaml:"hedge_on_per_try_timeout,omitempty" json:"hedge_on_per_try_timeout,omitempty"`
+}
+
+// GetRetryOn returns the conditions a request is retried on.
+func (rp *RetryPolicy) GetRetryOn() []string {
+	retryOn := rp.RetryOn
+	if len(retryOn) == 0 {
+		retryOn = []string{"connect-failure", "refused-stream", "gateway-error"}
+	}
+	if len(rp.RetriableStatusCodes) > 0 && !slices.Contains(retryOn, retryOnRetriableStatusCodes) {
+		retryOn = append(slices.Clip(retryOn), retryOnRetriableStatusCodes)
+	}
+	return retryOn
+}
+
+// GetNumRetries returns the maximum number of retries.
+func (rp *RetryPolicy) GetNumRetries() uint32 {
+	if rp.NumRetries == 0 {
+		return 1
+	}
+	return rp.NumRetries
+}
+
+// Validate checks the validity of the retry policy.
+func (rp *RetryPolicy) Validate() error {
+	for _, condition := range rp.RetryOn {
+		if !slices.Contains(RetryOnConditions, condition) {
+			return fmt.Errorf("unsupported retry_on condition: %q", condition)
+		}
+	}
+	for _, code := range rp.RetriableStatusCodes {
+		if code < 100 || code > 599 {
+			return fmt.Errorf("invalid retriable status code: %d", code)
+		}
+	}
+	if rp.PerTryTimeout < 0 {
+		return errors.New("per_try_timeout must be positive")
+	}
+	if rp.BackoffBaseInterval < 0 {
+		return errors.New("backoff_base_interval must be positive")
+	}
+	if rp.BackoffMaxInterval < 0 {
+		return errors.New("backoff_max_interval must be positive")
+	}
+	if rp.BackoffMaxInterval != 0 && rp.BackoffBaseInterval == 0 {
+		return errors.New("backoff_max_interval requires backoff_base_interval")
+	}
+	if rp.BackoffMaxInterval != 0 && rp.BackoffMaxInterval < rp.BackoffBaseInterval {
+		return errors.New("backoff_max_interval must be greater than or equal to backoff_base_interval")
+	}
+	if rp.HedgeOnPerTryTimeout && rp.PerTryTimeout == 0 {
+		return errors.New("hedge_on_per_try_timeout requires per_try_timeout")
+	}
+	return nil
+}
+
+// ToProto converts the retry policy to a protobuf type.
+func (rp *RetryPolicy) ToProto() *configpb.RouteRetryPolicy {
+	if rp == nil {
+		return nil
+	}
+	pb := &configpb.RouteRetryPolicy{
+		RetryOn:              rp.RetryOn,
+		NumRetries:           rp.NumRetries,
+		RetriableStatusCodes: rp.RetriableStatusCodes,
+		HedgeOnPerTryTimeout: rp.HedgeOnPerTryTimeout,
+	}
+	if rp.PerTryTimeout != 0 {
+		pb.PerTryTimeout = durationpb.New(rp.PerTryTimeout)
+	}
+	if rp.BackoffBaseInterval != 0 {
+		pb.BackoffBaseInterval = durationpb.New(rp.BackoffBaseInterval)
+	}
+	if rp.BackoffMaxInterval != 0 {
+		pb.BackoffMaxInterval = durationpb.New(rp.BackoffMaxInterval)
+	}
+	return pb
+}
+
+// NewRetryPolicyFromProto creates a new RetryPolicy from a protobuf type.
+func NewRetryPolicyFromProto(pb *configpb.RouteRetryPolicy) *RetryPolicy {
+	if pb == nil {
+		return nil
+	}
+	rp := &RetryPolicy{
+		RetryOn:              pb.GetRetryOn(),
+		NumRetries:           pb.GetNumRetries(),
+		RetriableStatusCodes: pb.GetRetriableStatusCodes(),
+		HedgeOnPerTryTimeout: pb.GetHedgeOnPerTryTimeout(),
+	}
+	if pb.PerTryTimeout != nil {
+		rp.PerTryTimeout = pb.GetPerTryTimeout().AsDuration()
+	}
+	if pb.BackoffBaseInterval != nil {
+		rp.BackoffBaseInterval = pb.GetBackoffBaseInterval().AsDuration()
+	}
+	if pb.BackoffMaxInterval != nil {
+		rp.BackoffMaxInterval = pb.GetBackoffMaxInterval().AsDuration()
+	}
+	return rp
+}
+
+// CircuitBreakers limit the concurrent connections and requests to a route's
+// upstream endpoints. Requests over the limits fail immediately instead of
+// piling up on an overloaded upstream. Unset limits use Envoy's defaults.
+type CircuitBreakers struct {
+	// MaxConnections is the maximum number of connections to the upstream.
+	MaxConnections uint32 `mapstructure:"max_connections" yaml:"max_connections,omitempty" json:"max_connections,omitempty"`
+	// MaxPendingRequests is the maximum number of requests waiting for a
+	// connection.
+	MaxPendingRequests uint32 `mapstructure:"max_pending_requests" yaml:"max_pending_requests,omitempty" json:"max_pending_requests,omitempty"`
+	// MaxRequests is the maximum number of concurrent requests.
+	MaxRequests uint32 `mapstructure:"max_requests" yaml:"max_requests,omitempty" json:"max_requests,omitempty"`
+	// MaxRetries is the maximum number of concurrent retries.
+	MaxRetries uint32 `mapstructure:"max_retries" yaml:"max_retries,omitempty" json:"max_retries,omitempty"`
+}
+
+// Validate checks the validity of the circuit breakers.
+func (cb *CircuitBreakers) Validate() error {
+	if cb.MaxConnections == 0 && cb.MaxPendingRequests == 0 && cb.MaxRequests == 0 && cb.MaxRetries == 0 {
+		return errors.New("at least one limit is required")
+	}
+	return nil
+}
+
+// ToProto converts the circuit breakers to a protobuf type.
+func (cb *CircuitBreakers) ToProto() *configpb.RouteCircuitBreakers {
+	if cb == nil {
+		return nil
+	}
+	return &configpb.RouteCircuitBreakers{
+		MaxConnections:     cb.MaxConnections,
+		MaxPendingRequests: cb.MaxPendingRequests,
+		MaxRequests:        cb.MaxRequests,
+		MaxRetries:         cb.MaxRetries,
+	}
+}
+
+// NewCircuitBreakersFromProto creates new CircuitBreakers from a protobuf
+// type.
+func NewCircuitBreakersFromProto(pb *configpb.RouteCircuitBreakers) *CircuitBreakers {
+	if pb == nil {
+		return nil
+	}
+	return &CircuitBreakers{
+		MaxConnections:     pb.GetMaxConnections(),
+		MaxPendingRequests: pb.GetMaxPendingRequests(),
+		MaxRequests:        pb.GetMaxRequests(),
+		MaxRetries:         pb.GetMaxRetries(),
+	}
+}
@@ -0,0 +1,156 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		rp     RetryPolicy
+		expect string
+	}{
+		{"empty", RetryPolicy{}, ""},
+		{"valid", RetryPolicy{
+			RetryOn:              []string{"5xx", "reset", "unavailable"},
+			NumRetries:           3,
+			PerTryTimeout:        time.Second,
+			BackoffBaseInterval:  100 * time.Millisecond,
+			BackoffMaxInterval:   time.Second,
+			RetriableStatusCodes: []uint32{409},
+			HedgeOnPerTryTimeout: true,
+		}, ""},
+		{"unsupported condition", RetryPolicy{RetryOn: []string{"always"}}, `unsupported retry_on condition: "always"`},
+		{"invalid status code", RetryPolicy{RetriableStatusCodes: []uint32{600}}, "invalid retriable status code: 600"},
+		{"negative per try timeout", RetryPolicy{PerTryTimeout: -time.Second}, "per_try_timeout must be positive"},
+		{"negative base interval", RetryPolicy{BackoffBaseInterval: -time.Second}, "backoff_base_interval must be positive"},
+		{"negative max interval", RetryPolicy{BackoffMaxInterval: -time.Second}, "backoff_max_interval must be positive"},
+		{"max interval without base interval", RetryPolicy{BackoffMaxInterval: time.Second}, "backoff_max_interval requires backoff_base_interval"},
+		{"max interval less than base interval", RetryPolicy{BackoffBaseInterval: time.Second, BackoffMaxInterval: time.Millisecond},
+			"backoff_max_interval must be greater than or equal to backoff_base_interval"},
+		{"hedge without per try timeout", RetryPolicy{HedgeOnPerTryTimeout: true}, "hedge_on_per_try_timeout requires per_try_timeout"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rp.Validate()
+			if tc.expect == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expect)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Defaults(t *testing.T) {
+	t.Parallel()
+
+	rp := &RetryPolicy{}
+	assert.Equal(t, []string{"connect-failure", "refused-stream", "gateway-error"}, rp.GetRetryOn())
+	assert.Equal(t, uint32(1), rp.GetNumRetries())
+
+	rp = &RetryPolicy{RetryOn: []string{"5xx"}, RetriableStatusCodes: []uint32{409}}
+	assert.Equal(t, []string{"5xx", "retriable-status-codes"}, rp.GetRetryOn())
+	assert.Equal(t, []string{"5xx"}, rp.RetryOn)
+}
+
+func TestRetryPolicy_Proto(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, (*RetryPolicy)(nil).ToProto())
+	assert.Nil(t, NewRetryPolicyFromProto(nil))
+
+	rp := &RetryPolicy{
+		RetryOn:              []string{"5xx"},
+		NumRetries:           3,
+		PerTryTimeout:        time.Second,
+		BackoffBaseInterval:  100 * time.Millisecond,
+		BackoffMaxInterval:   time.Second,
+		RetriableStatusCodes: []uint32{409},
+		HedgeOnPerTryTimeout: true,
+	}
+	assert.Equal(t, rp, NewRetryPolicyFromProto(rp.ToProto()))
+}
+
+func TestCircuitBreakers(t *testing.T) {
+	t.Parallel()
+
+	assert.EqualError(t, (&CircuitBreakers{}).Validate(), "at least one limit is required")
+
+	assert.Nil(t, (*CircuitBreakers)(nil).ToProto())
+	assert.Nil(t, NewCircuitBreakersFromProto(nil))
+
+	cb := &CircuitBreakers{MaxConnections: 1, MaxPendingRequests: 2, MaxRequests: 3, MaxRetries: 4}
+	assert.NoError(t, cb.Validate())
+	assert.Equal(t, cb, NewCircuitBreakersFromProto(cb.ToProto()))
+}
+
+func TestPolicy_ValidateRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	timeout := 5 * time.Second
+	p := &Policy{
+		From:            "https://from.example.com",
+		To:              mustParseWeightedURLs(t, "https://to.example.com"),
+		UpstreamTimeout: &timeout,
+		RetryPolicy:     &RetryPolicy{PerTryTimeout: time.Second},
+		CircuitBreakers: &CircuitBreakers{MaxRequests: 100},
+	}
+	assert.NoError(t, p.Validate())
+
+	p.RetryPolicy.PerTryTimeout = 10 * time.Second
+	assert.EqualError(t, p.Validate(), "config: invalid retry_policy: per_try_timeout must not exceed the route timeout")
+
+	p.RetryPolicy = &RetryPolicy{RetryOn: []string{"always"}}
+	assert.ErrorContains(t, p.Validate(), "config: invalid retry_policy")
+
+	p.RetryPolicy = nil
+	p.CircuitBreakers = &CircuitBreakers{}
+	assert.ErrorContains(t, p.Validate(), "config: invalid circuit_breakers")
+}
+
+func TestPolicy_RetryPolicyFromYAML(t *testing.T) {
+	t.Parallel()
+
+	o := NewDefaultOptions()
+	o.viper.SetConfigType("yaml")
+	require.NoError(t, o.viper.ReadConfig(strings.NewReader(`
+routes:
+  - from: https://from1.example.com
+    to: https://to.example.com
+    retry_policy:
+      retry_on: [5xx, reset]
+      num_retries: 3
+      per_try_timeout: 2s
+      retriable_status_codes: [409]
+    circuit_breakers:
+      max_connections: 100
+      max_pending_requests: 10
+  - from: https://from2.example.com
+    to: https://to.example.com
+    circuit_breakers:
+      thresholds:
+        - max_connections: 5
+`)))
+	require.NoError(t, o.parsePolicy())
+	require.Len(t, o.Routes, 2)
+
+	assert.Equal(t, &RetryPolicy{
+		RetryOn:              []string{"5xx", "reset"},
+		NumRetries:           3,
+		PerTryTimeout:        2 * time.Second,
+		RetriableStatusCodes: []uint32{409},
+	}, o.Routes[0].RetryPolicy)
+	assert.Equal(t, &CircuitBreakers{MaxConnections: 100, MaxPendingRequests: 10}, o.Routes[0].CircuitBreakers)
+	assert.Nil(t, o.Routes[0].EnvoyOpts.GetCircuitBreakers())
+
+	// envoy's circuit breakers are passed through to the cluster
+	assert.Nil(t, o.Routes[1].CircuitBreakers)
+	assert.Equal(t, uint32(5), o.Routes[1].EnvoyOpts.GetCircuitBreakers().GetThresholds()[0].GetMaxConnections().GetValue())
+}
@@ -99,7 +99,7 @@ func (store *SessionStore) LoadSessionStateAndCheckIDP(r *http.Request) (*sessio
 			return nil, err
 		}
 
-		if idp.GetId() != state.IdentityProviderID {
+		if !store.options.IsIdentityProviderAllowed(idp.GetId(), state.IdentityProviderID) {
 			return nil, fmt.Errorf("unexpected session state identity provider id: %s != %s",
 				idp.GetId(), state.IdentityProviderID)
 		}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"sync"
@@ -44,6 +45,15 @@ func NewTracingOptions(o *Options) (*TracingOptions, error) {
 			return nil, fmt.Errorf("config: invalid zipkin endpoint url: %w", err)
 		}
 		tracingOpts.ZipkinEndpoint = zipkinEndpoint
+	case trace.OTLPTracingProviderName:
+		if !o.TracingOTLP.Enabled() {
+			return nil, errors.New("config: tracing_otlp endpoint is required")
+		}
+		otlpOptions, err := o.TracingOTLP.NewOptions()
+		if err != nil {
+			return nil, fmt.Errorf("config: invalid tracing_otlp settings: %w", err)
+		}
+		tracingOpts.OTLP = otlpOptions
 	case "":
 		return &TracingOptions{}, nil
 	default:
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/url"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -23,25 +24,34 @@ import (
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	"github.com/pomerium/pomerium/pkg/envoy/files"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	identitypb "github.com/pomerium/pomerium/pkg/grpc/identity"
 	"github.com/pomerium/pomerium/pkg/grpc/registry"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
 	"github.com/pomerium/pomerium/pkg/grpcutil"
 	"github.com/pomerium/pomerium/pkg/identity"
 	"github.com/pomerium/pomerium/pkg/identity/legacymanager"
 	"github.com/pomerium/pomerium/pkg/identity/manager"
+	"github.com/pomerium/pomerium/pkg/identity/oauth"
 )
 
 // DataBroker represents the databroker service. The databroker service is a simple interface
 // for storing keyed blobs (bytes) of unstructured data.
 type DataBroker struct {
-	dataBrokerServer *dataBrokerServer
-	manager          *manager.Manager
-	legacyManager    *legacymanager.Manager
-	eventsMgr        *events.Manager
+	dataBrokerServer          *dataBrokerServer
+	sessionAdminServer        *sessionAdminServer
+	serviceAccountAdminServer *serviceAccountAdminServer
+	passkeyAdminServer        *passkeyAdminServer
+	localUserAdminServer      *localUserAdminServer
+	manager                   *manager.Manager
+	legacyManager             *legacymanager.Manager
+	eventsMgr                 *events.Manager
 
 	localListener       net.Listener
 	localGRPCServer     *grpc.Server
 	localGRPCConnection *grpc.ClientConn
 	sharedKey           *atomicutil.Value[[]byte]
+	currentOptions      *atomicutil.Value[*config.Options]
 }
 
 // New creates a new databroker service.
@@ -102,8 +112,29 @@ func New(ctx context.Context, cfg *config.Config, eventsMgr *events.Manager) (*D
 		localGRPCServer:     localGRPCServer,
 		localGRPCConnection: localGRPCConnection,
 		sharedKey:           sharedKeyValue,
+		currentOptions:      atomicutil.NewValue(cfg.Options),
 		eventsMgr:           eventsMgr,
 	}
+	c.sessionAdminServer = &sessionAdminServer{
+		client:           databroker.NewDataBrokerServiceClient(localGRPCConnection),
+		sharedKey:        sharedKeyValue,
+		getAuthenticator: c.getSessionAuthenticator,
+	}
+	c.serviceAccountAdminServer = &serviceAccountAdminServer{
+		client:    databroker.NewDataBrokerServiceClient(localGRPCConnection),
+		sharedKey: sharedKeyValue,
+	}
+	c.passkeyAdminServer = &passkeyAdminServer{
+		client:    databroker.NewDataBrokerServiceClient(localGRPCConnection),
+		sharedKey: sharedKeyValue,
+		getAuthenticateURL: func() (*url.URL, error) {
+			return c.currentOptions.Load().GetAuthenticateURL()
+		},
+	}
+	c.localUserAdminServer = &localUserAdminServer{
+		client:    databroker.NewDataBrokerServiceClient(localGRPCConnection),
+		sharedKey: sharedKeyValue,
+	}
 	c.Register(c.localGRPCServer)
 
 	err = c.update(ctx, cfg)
@@ -128,6 +159,10 @@ func (c *DataBroker) OnConfigChange(ctx context.Context, cfg *config.Config) {
 func (c *DataBroker) Register(grpcServer *grpc.Server) {
 	databroker.RegisterDataBrokerServiceServer(grpcServer, c.dataBrokerServer)
 	registry.RegisterRegistryServer(grpcServer, c.dataBrokerServer)
+	session.RegisterSessionAdminServer(grpcServer, c.sessionAdminServer)
+	user.RegisterServiceAccountAdminServer(grpcServer, c.serviceAccountAdminServer)
+	user.RegisterPasskeyAdminServer(grpcServer, c.passkeyAdminServer)
+	user.RegisterLocalUserAdminServer(grpcServer, c.localUserAdminServer)
 }
 
 // Run runs the databroker components.
@@ -152,6 +187,7 @@ func (c *DataBroker) update(ctx context.Context, cfg *config.Config) error {
 		return fmt.Errorf("databroker: invalid shared key: %w", err)
 	}
 	c.sharedKey.Store(sharedKey)
+	c.currentOptions.Store(cfg.Options)
 
 	oauthOptions, err := cfg.Options.GetOauthOptions()
 	if err != nil {
@@ -172,13 +208,24 @@ func (c *DataBroker) update(ctx context.Context, cfg *config.Config) error {
 	}
 
 	if cfg.Options.SupportsUserRefresh() {
-		authenticator, err := identity.NewAuthenticator(oauthOptions)
-		if err != nil {
-			log.Ctx(ctx).Error().Err(err).Msg("databroker: failed to create authenticator")
-		} else {
-			options = append(options, manager.WithAuthenticator(authenticator))
-			legacyOptions = append(legacyOptions, legacymanager.WithAuthenticator(authenticator))
+		if cfg.Options.Provider != "" {
+			authenticator, err := identity.NewAuthenticator(oauthOptions)
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("databroker: failed to create authenticator")
+			} else {
+				options = append(options, manager.WithAuthenticator(authenticator))
+				legacyOptions = append(legacyOptions, legacymanager.WithAuthenticator(authenticator))
+			}
 		}
+
+		authenticators := map[string]manager.Authenticator{}
+		legacyAuthenticators := map[string]legacymanager.Authenticator{}
+		for idpID, authenticator := range c.getIdentityProviderAuthenticators(ctx, cfg.Options, oauthOptions) {
+			authenticators[idpID] = authenticator
+			legacyAuthenticators[idpID] = authenticator
+		}
+		options = append(options, manager.WithIdentityProviderAuthenticators(authenticators))
+		legacyOptions = append(legacyOptions, legacymanager.WithIdentityProviderAuthenticators(legacyAuthenticators))
 	} else {
 		log.Ctx(ctx).Info().Msg("databroker: disabling refresh of user sessions")
 	}
@@ -198,6 +245,44 @@ func (c *DataBroker) update(ctx context.Context, cfg *config.Config) error {
 	return nil
 }
 
+// getIdentityProviderAuthenticators returns authenticators for the named
+// identity providers, keyed by identity provider id.
+func (c *DataBroker) getIdentityProviderAuthenticators(
+	ctx context.Context,
+	options *config.Options,
+	oauthOptions oauth.Options,
+) map[string]identity.Authenticator {
+	idps, err := options.GetIdentityProviders()
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("databroker: invalid identity providers")
+		return nil
+	}
+
+	authenticators := make(map[string]identity.Authenticator, len(idps))
+	for _, idp := range idps {
+		authenticator, err := newIdentityProviderAuthenticator(oauthOptions, idp)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("idp", idp.GetName()).Msg("databroker: failed to create authenticator")
+			continue
+		}
+		authenticators[idp.GetId()] = authenticator
+	}
+	return authenticators
+}
+
+// newIdentityProviderAuthenticator returns an authenticator for the identity
+// provider, using oauthOptions for the settings the provider doesn't define.
+func newIdentityProviderAuthenticator(oauthOptions oauth.Options, idp *identitypb.Provider) (identity.Authenticator, error) {
+	o := oauthOptions
+	o.ProviderName = idp.GetType()
+	o.ProviderURL = idp.GetUrl()
+	o.ClientID = idp.GetClientId()
+	o.ClientSecret = idp.GetClientSecret()
+	o.Scopes = idp.GetScopes()
+	o.AuthCodeOptions = idp.GetRequestParams()
+	return identity.NewAuthenticator(o)
+}
+
 // validate checks that proper configuration settings are set to create
 // a databroker instance
 func validate(o *config.Options) error {
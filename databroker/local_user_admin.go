@@ -0,0 +1,146 @@
+package databroker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/atomicutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+	"github.com/pomerium/pomerium/pkg/identity/local"
+)
+
+// A localUserAdminServer implements the local user admin service. Requests
+// must be signed with the shared secret. Passwords are hashed by the caller,
+// so they are never sent to the databroker.
+type localUserAdminServer struct {
+	user.UnimplementedLocalUserAdminServer
+
+	client    databrokerpb.DataBrokerServiceClient
+	sharedKey *atomicutil.Value[[]byte]
+}
+
+// AddLocalUser creates a user with a password.
+func (srv *localUserAdminServer) AddLocalUser(ctx context.Context, req *user.AddLocalUserRequest) (*user.AddLocalUserResponse, error) {
+	if err := grpcutil.RequireSignedJWT(ctx, srv.sharedKey.Load()); err != nil {
+		return nil, err
+	}
+
+	userID := req.GetUserId()
+	if userID == "" {
+		userID = req.GetEmail()
+	}
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id or email is required")
+	}
+	if err := validateLocalUserCredentials(req.GetPasswordHash(), req.TotpSecret); err != nil {
+		return nil, err
+	}
+
+	_, err := user.GetLocalUser(ctx, srv.client, userID)
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "local user %s already exists", userID)
+	} else if status.Code(err) != codes.NotFound {
+		return nil, fmt.Errorf("databroker: error retrieving local user: %w", err)
+	}
+
+	u, err := user.Get(ctx, srv.client, userID)
+	if status.Code(err) == codes.NotFound {
+		u = &user.User{Id: userID}
+	} else if err != nil {
+		return nil, fmt.Errorf("databroker: error retrieving user: %w", err)
+	}
+	if req.GetEmail() != "" {
+		u.Email = req.GetEmail()
+	}
+	if req.GetName() != "" {
+		u.Name = req.GetName()
+	}
+	if len(req.GetGroups()) > 0 {
+		groups := make([]*structpb.Value, len(req.GetGroups()))
+		for i, group := range req.GetGroups() {
+			groups[i] = structpb.NewStringValue(group)
+		}
+		if u.Claims == nil {
+			u.Claims = make(map[string]*structpb.ListValue)
+		}
+		u.Claims["groups"] = &structpb.ListValue{Values: groups}
+	}
+	_, err = databrokerpb.Put(ctx, srv.client, u)
+	if err != nil {
+		return nil, fmt.Errorf("databroker: error saving user: %w", err)
+	}
+
+	_, err = user.PutLocalUser(ctx, srv.client, &user.LocalUser{
+		Id:                userID,
+		PasswordHash:      req.GetPasswordHash(),
+		TotpSecret:        req.TotpSecret,
+		PasswordChangedAt: timestamppb.New(time.Now()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("databroker: error saving local user: %w", err)
+	}
+
+	return &user.AddLocalUserResponse{User: u}, nil
+}
+
+// SetLocalUserPassword replaces the password of a user and unlocks them.
+func (srv *localUserAdminServer) SetLocalUserPassword(ctx context.Context, req *user.SetLocalUserPasswordRequest) (*user.SetLocalUserPasswordResponse, error) {
+	if err := grpcutil.RequireSignedJWT(ctx, srv.sharedKey.Load()); err != nil {
+		return nil, err
+	}
+
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.TotpSecret != nil && req.GetDisableTotp() {
+		return nil, status.Error(codes.InvalidArgument, "totp_secret and disable_totp are mutually exclusive")
+	}
+	if err := validateLocalUserCredentials(req.GetPasswordHash(), req.TotpSecret); err != nil {
+		return nil, err
+	}
+
+	lu, err := user.GetLocalUser(ctx, srv.client, req.GetUserId())
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Errorf(codes.NotFound, "local user %s not found", req.GetUserId())
+	} else if err != nil {
+		return nil, fmt.Errorf("databroker: error retrieving local user: %w", err)
+	}
+
+	lu.PasswordHash = req.GetPasswordHash()
+	lu.PasswordChangedAt = timestamppb.New(time.Now())
+	lu.FailedAttempts = 0
+	lu.LockedUntil = nil
+	if req.TotpSecret != nil {
+		lu.TotpSecret = req.TotpSecret
+		lu.TotpLastStep = 0
+	} else if req.GetDisableTotp() {
+		lu.TotpSecret = nil
+		lu.TotpLastStep = 0
+	}
+	_, err = user.PutLocalUser(ctx, srv.client, lu)
+	if err != nil {
+		return nil, fmt.Errorf("databroker: error saving local user: %w", err)
+	}
+
+	return new(user.SetLocalUserPasswordResponse), nil
+}
+
+func validateLocalUserCredentials(passwordHash string, totpSecret *string) error {
+	if err := local.ValidatePasswordHash(passwordHash); err != nil {
+		return status.Error(codes.InvalidArgument, "password_hash must be an argon2id or bcrypt hash")
+	}
+	if totpSecret != nil {
+		if err := local.ValidateTOTPSecret(*totpSecret); err != nil {
+			return status.Error(codes.InvalidArgument, "totp_secret must be base32 encoded")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package databroker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/atomicutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+func TestLocalUserAdmin(t *testing.T) {
+	t.Parallel()
+
+	sharedKey := cryptutil.NewKey()
+	client := newTestDataBrokerClient(t)
+	srv := &localUserAdminServer{
+		client:    client,
+		sharedKey: atomicutil.NewValue(sharedKey),
+	}
+	ctx := signedIncomingContext(t, sharedKey)
+
+	hash := func(t *testing.T, password string) string {
+		t.Helper()
+		bs, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+		require.NoError(t, err)
+		return string(bs)
+	}
+
+	t.Run("add", func(t *testing.T) {
+		res, err := srv.AddLocalUser(ctx, &user.AddLocalUserRequest{
+			Email:        "alice@example.com",
+			Name:         "Alice",
+			Groups:       []string{"admins"},
+			PasswordHash: hash(t, "hunter2"),
+			TotpSecret:   proto.String("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.com", res.GetUser().GetId())
+		assert.Equal(t, []any{"admins"}, res.GetUser().GetClaim("groups"))
+
+		lu, err := user.GetLocalUser(ctx, client, "alice@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", lu.GetTotpSecret())
+		assert.True(t, lu.GetPasswordChangedAt().IsValid())
+
+		_, err = srv.AddLocalUser(ctx, &user.AddLocalUserRequest{
+			Email:        "alice@example.com",
+			PasswordHash: hash(t, "hunter2"),
+		})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+	t.Run("passwd", func(t *testing.T) {
+		_, err := srv.AddLocalUser(ctx, &user.AddLocalUserRequest{
+			UserId:       "bob",
+			PasswordHash: hash(t, "hunter2"),
+			TotpSecret:   proto.String("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"),
+		})
+		require.NoError(t, err)
+
+		lu, err := user.GetLocalUser(ctx, client, "bob")
+		require.NoError(t, err)
+		lu.FailedAttempts = 3
+		lu.LockedUntil = timestamppb.Now()
+		_, err = user.PutLocalUser(ctx, client, lu)
+		require.NoError(t, err)
+
+		newHash := hash(t, "hunter3")
+		_, err = srv.SetLocalUserPassword(ctx, &user.SetLocalUserPasswordRequest{
+			UserId:       "bob",
+			PasswordHash: newHash,
+			DisableTotp:  true,
+		})
+		require.NoError(t, err)
+
+		lu, err = user.GetLocalUser(ctx, client, "bob")
+		require.NoError(t, err)
+		assert.Equal(t, newHash, lu.GetPasswordHash())
+		assert.Zero(t, lu.GetFailedAttempts())
+		assert.Nil(t, lu.GetLockedUntil())
+		assert.Nil(t, lu.TotpSecret)
+
+		_, err = srv.SetLocalUserPassword(ctx, &user.SetLocalUserPasswordRequest{
+			UserId:       "carol",
+			PasswordHash: newHash,
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("invalid", func(t *testing.T) {
+		for _, req := range []*user.AddLocalUserRequest{
+			{PasswordHash: hash(t, "hunter2")},
+			{UserId: "dave", PasswordHash: "hunter2"},
+			{UserId: "dave", PasswordHash: hash(t, "hunter2"), TotpSecret: proto.String("not base32!")},
+		} {
+			_, err := srv.AddLocalUser(ctx, req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package databroker
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/atomicutil"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/device"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+	"github.com/pomerium/pomerium/pkg/identity/passkey"
+	"github.com/pomerium/pomerium/pkg/webauthnutil"
+)
+
+const defaultPasskeyEnrollmentTTL = 24 * time.Hour
+
+// A passkeyAdminServer implements the passkey admin service. Requests must be
+// signed with the shared secret, which is also used to sign the enrollment
+// tokens.
+type passkeyAdminServer struct {
+	user.UnimplementedPasskeyAdminServer
+
+	client             databrokerpb.DataBrokerServiceClient
+	sharedKey          *atomicutil.Value[[]byte]
+	getAuthenticateURL func() (*url.URL, error)
+}
+
+// CreatePasskeyEnrollment creates or updates a user and returns a one-time
+// link the user can use to enroll a passkey.
+func (srv *passkeyAdminServer) CreatePasskeyEnrollment(ctx context.Context, req *user.CreatePasskeyEnrollmentRequest) (*user.CreatePasskeyEnrollmentResponse, error) {
+	if err := grpcutil.RequireSignedJWT(ctx, srv.sharedKey.Load()); err != nil {
+		return nil, err
+	}
+
+	userID := req.GetUserId()
+	if userID == "" {
+		userID = req.GetEmail()
+	}
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id or email is required")
+	}
+	ttl := defaultPasskeyEnrollmentTTL
+	if req.GetExpiresIn() != nil {
+		ttl = req.GetExpiresIn().AsDuration()
+		if ttl <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "expires_in must be positive")
+		}
+	}
+
+	authenticateURL, err := srv.getAuthenticateURL()
+	if err != nil {
+		return nil, fmt.Errorf("databroker: invalid authenticate url: %w", err)
+	}
+
+	u, err := user.Get(ctx, srv.client, userID)
+	if status.Code(err) == codes.NotFound {
+		u = &user.User{Id: userID}
+	} else if err != nil {
+		return nil, fmt.Errorf("databroker: error retrieving user: %w", err)
+	}
+	if req.GetEmail() != "" {
+		u.Email = req.GetEmail()
+	}
+	if req.GetName() != "" {
+		u.Name = req.GetName()
+	}
+	_, err = databrokerpb.Put(ctx, srv.client, u)
+	if err != nil {
+		return nil, fmt.Errorf("databroker: error saving user: %w", err)
+	}
+
+	enrollment := &device.Enrollment{
+		Id:     uuid.NewString(),
+		TypeId: webauthnutil.PasskeyDeviceType,
+		UserId: u.GetId(),
+	}
+	err = device.PutEnrollment(ctx, srv.client, enrollment)
+	if err != nil {
+		return nil, fmt.Errorf("databroker: error saving device enrollment: %w", err)
+	}
+
+	expiresAt := time.Now().Add(ttl)
+	token, err := webauthnutil.NewEnrollmentToken(srv.sharedKey.Load(), ttl, enrollment.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("databroker: error creating enrollment token: %w", err)
+	}
+
+	enrollmentURL := authenticateURL.ResolveReference(&url.URL{
+		Path:     passkey.EnrollPath,
+		RawQuery: url.Values{urlutil.QueryEnrollmentToken: {token}}.Encode(),
+	})
+
+	return &user.CreatePasskeyEnrollmentResponse{
+		User:          u,
+		EnrollmentId:  enrollment.GetId(),
+		EnrollmentUrl: enrollmentURL.String(),
+		ExpiresAt:     timestamppb.New(expiresAt),
+	}, nil
+}
@@ -0,0 +1,90 @@
+package databroker
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/pomerium/pomerium/internal/atomicutil"
+	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/device"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/webauthnutil"
+)
+
+func TestPasskeyAdmin(t *testing.T) {
+	t.Parallel()
+
+	sharedKey := cryptutil.NewKey()
+	client := newTestDataBrokerClient(t)
+	srv := &passkeyAdminServer{
+		client:    client,
+		sharedKey: atomicutil.NewValue(sharedKey),
+		getAuthenticateURL: func() (*url.URL, error) {
+			return url.Parse("https://authenticate.example.com")
+		},
+	}
+	ctx := signedIncomingContext(t, sharedKey)
+
+	_, err := srv.CreatePasskeyEnrollment(context.Background(), &user.CreatePasskeyEnrollmentRequest{
+		Email: "alice@example.com",
+	})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	res, err := srv.CreatePasskeyEnrollment(ctx, &user.CreatePasskeyEnrollmentRequest{
+		Email:     "alice@example.com",
+		Name:      "Alice",
+		ExpiresIn: durationpb.New(time.Hour),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "alice@example.com", res.GetUser().GetId())
+	assert.Equal(t, "Alice", res.GetUser().GetName())
+	assert.WithinDuration(t, time.Now().Add(time.Hour), res.GetExpiresAt().AsTime(), time.Minute)
+
+	stored, err := user.Get(ctx, client, "alice@example.com")
+	require.NoError(t, err)
+	testutil.AssertProtoEqual(t, res.GetUser(), stored)
+
+	enrollment, err := device.GetEnrollment(ctx, client, res.GetEnrollmentId())
+	require.NoError(t, err)
+	assert.Equal(t, webauthnutil.PasskeyDeviceType, enrollment.GetTypeId())
+	assert.Equal(t, "alice@example.com", enrollment.GetUserId())
+	assert.False(t, enrollment.GetEnrolledAt().IsValid())
+
+	enrollmentURL, err := url.Parse(res.GetEnrollmentUrl())
+	require.NoError(t, err)
+	assert.Equal(t, "authenticate.example.com", enrollmentURL.Host)
+	assert.Equal(t, "/.pomerium/passkey/enroll", enrollmentURL.Path)
+	enrollmentID, err := webauthnutil.ParseAndVerifyEnrollmentToken(sharedKey,
+		enrollmentURL.Query().Get(urlutil.QueryEnrollmentToken))
+	require.NoError(t, err)
+	assert.Equal(t, res.GetEnrollmentId(), enrollmentID)
+
+	t.Run("existing user", func(t *testing.T) {
+		res, err := srv.CreatePasskeyEnrollment(ctx, &user.CreatePasskeyEnrollmentRequest{
+			UserId: "alice@example.com",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Alice", res.GetUser().GetName())
+		assert.Equal(t, "alice@example.com", res.GetUser().GetEmail())
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := srv.CreatePasskeyEnrollment(ctx, &user.CreatePasskeyEnrollmentRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = srv.CreatePasskeyEnrollment(ctx, &user.CreatePasskeyEnrollmentRequest{
+			Email:     "alice@example.com",
+			ExpiresIn: durationpb.New(-time.Hour),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,228 @@
+package databroker
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/atomicutil"
+	"github.com/pomerium/pomerium/internal/encoding/jws"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/sessions"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+// A serviceAccountAdminServer implements the service account admin service.
+// Requests must be signed with the shared secret, which is also used to sign
+// the service account JWTs.
+type serviceAccountAdminServer struct {
+	user.UnimplementedServiceAccountAdminServer
+
+	client    databrokerpb.DataBrokerServiceClient
+	sharedKey *atomicutil.Value[[]byte]
+}
+
+// CreateServiceAccount creates a service account and returns its JWT.
+func (srv *serviceAccountAdminServer) CreateServiceAccount(ctx context.Context, req *user.CreateServiceAccountRequest) (*user.ServiceAccountWithToken, error) {
+	if err := grpcutil.RequireSignedJWT(ctx, srv.sharedKey.Load()); err != nil {
+		return nil, err
+	}
+
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.GetExpiresIn() != nil && req.GetExpiresIn().AsDuration() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "expires_in must be positive")
+	}
+
+	now := time.Now()
+	sa := &user.ServiceAccount{
+		Id:          uuid.NewString(),
+		NamespaceId: req.NamespaceId,
+		Description: req.Description,
+		UserId:      req.GetUserId(),
+		IssuedAt:    timestamppb.New(now),
+	}
+	if req.GetExpiresIn() != nil {
+		sa.ExpiresAt = timestamppb.New(now.Add(req.GetExpiresIn().AsDuration()))
+	}
+	return srv.putServiceAccount(ctx, sa)
+}
+
+// ListServiceAccounts returns the service accounts matching the request,
+// least recently accessed first.
+func (srv *serviceAccountAdminServer) ListServiceAccounts(ctx context.Context, req *user.ListServiceAccountsRequest) (*user.ListServiceAccountsResponse, error) {
+	if err := grpcutil.RequireSignedJWT(ctx, srv.sharedKey.Load()); err != nil {
+		return nil, err
+	}
+
+	records, _, _, err := databrokerpb.InitialSync(ctx, srv.client, &databrokerpb.SyncLatestRequest{
+		Type: grpcutil.GetTypeURL(new(user.ServiceAccount)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("databroker: error listing service accounts: %w", err)
+	}
+
+	res := new(user.ListServiceAccountsResponse)
+	for _, record := range records {
+		var sa user.ServiceAccount
+		if err := record.GetData().UnmarshalTo(&sa); err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("service-account-id", record.GetId()).Msg("databroker: invalid service account")
+			continue
+		}
+
+		if req.GetUserId() != "" && sa.GetUserId() != req.GetUserId() {
+			continue
+		}
+		if req.GetNamespaceId() != "" && sa.GetNamespaceId() != req.GetNamespaceId() {
+			continue
+		}
+		if req.GetNotAccessedSince() != nil && sa.GetAccessedAt() != nil &&
+			!sa.GetAccessedAt().AsTime().Before(req.GetNotAccessedSince().AsTime()) {
+			continue
+		}
+		res.ServiceAccounts = append(res.ServiceAccounts, &sa)
+	}
+	slices.SortFunc(res.ServiceAccounts, func(a, b *user.ServiceAccount) int {
+		return cmp.Or(
+			a.GetAccessedAt().AsTime().Compare(b.GetAccessedAt().AsTime()),
+			strings.Compare(a.GetId(), b.GetId()),
+		)
+	})
+	return res, nil
+}
+
+// RotateServiceAccount replaces a service account with a new one with the
+// same settings. If the service account expires, the new one is valid for as
+// long as the old one was when it was issued.
+func (srv *serviceAccountAdminServer) RotateServiceAccount(ctx context.Context, req *user.RotateServiceAccountRequest) (*user.ServiceAccountWithToken, error) {
+	if err := grpcutil.RequireSignedJWT(ctx, srv.sharedKey.Load()); err != nil {
+		return nil, err
+	}
+
+	old, err := srv.getServiceAccount(ctx, req.GetServiceAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	sa := &user.ServiceAccount{
+		Id:          uuid.NewString(),
+		NamespaceId: old.NamespaceId,
+		Description: old.Description,
+		UserId:      old.GetUserId(),
+		IssuedAt:    timestamppb.New(now),
+	}
+	if old.GetExpiresAt() != nil {
+		validFor := old.GetExpiresAt().AsTime().Sub(old.GetIssuedAt().AsTime())
+		sa.ExpiresAt = timestamppb.New(now.Add(validFor))
+	}
+
+	res, err := srv.putServiceAccount(ctx, sa)
+	if err != nil {
+		return nil, err
+	}
+	if err := srv.deleteServiceAccount(ctx, old); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// RevokeServiceAccounts deletes service accounts.
+func (srv *serviceAccountAdminServer) RevokeServiceAccounts(ctx context.Context, req *user.RevokeServiceAccountsRequest) (*user.RevokeServiceAccountsResponse, error) {
+	if err := grpcutil.RequireSignedJWT(ctx, srv.sharedKey.Load()); err != nil {
+		return nil, err
+	}
+
+	if len(req.GetServiceAccountIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "service_account_ids is required")
+	}
+
+	res := new(user.RevokeServiceAccountsResponse)
+	for _, id := range req.GetServiceAccountIds() {
+		sa, err := srv.getServiceAccount(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := srv.deleteServiceAccount(ctx, sa); err != nil {
+			return nil, err
+		}
+		res.ServiceAccountIds = append(res.ServiceAccountIds, sa.GetId())
+	}
+	return res, nil
+}
+
+func (srv *serviceAccountAdminServer) getServiceAccount(ctx context.Context, serviceAccountID string) (*user.ServiceAccount, error) {
+	if serviceAccountID == "" {
+		return nil, status.Error(codes.InvalidArgument, "service_account_id is required")
+	}
+
+	sa, err := user.GetServiceAccount(ctx, srv.client, serviceAccountID)
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Errorf(codes.NotFound, "service account %s not found", serviceAccountID)
+	} else if err != nil {
+		return nil, err
+	}
+	return sa, nil
+}
+
+func (srv *serviceAccountAdminServer) putServiceAccount(ctx context.Context, sa *user.ServiceAccount) (*user.ServiceAccountWithToken, error) {
+	rawJWT, err := signServiceAccountJWT(srv.sharedKey.Load(), sa)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = user.PutServiceAccount(ctx, srv.client, sa)
+	if err != nil {
+		return nil, fmt.Errorf("databroker: failed to save service account %s: %w", sa.GetId(), err)
+	}
+
+	return &user.ServiceAccountWithToken{
+		ServiceAccount: sa,
+		Jwt:            rawJWT,
+	}, nil
+}
+
+func (srv *serviceAccountAdminServer) deleteServiceAccount(ctx context.Context, sa *user.ServiceAccount) error {
+	record := databrokerpb.NewRecord(proto.Clone(sa).(*user.ServiceAccount))
+	record.DeletedAt = timestamppb.Now()
+	_, err := srv.client.Put(ctx, &databrokerpb.PutRequest{
+		Records: []*databrokerpb.Record{record},
+	})
+	if err != nil {
+		return fmt.Errorf("databroker: failed to delete service account %s: %w", sa.GetId(), err)
+	}
+	return nil
+}
+
+// signServiceAccountJWT returns the JWT used to authenticate as the service
+// account. It is the same kind of JWT as the session JWTs issued by the
+// authenticate service, with the service account id in place of the session id.
+func signServiceAccountJWT(sharedKey []byte, sa *user.ServiceAccount) (string, error) {
+	encoder, err := jws.NewHS256Signer(sharedKey)
+	if err != nil {
+		return "", fmt.Errorf("databroker: invalid shared key: %w", err)
+	}
+
+	rawJWT, err := encoder.Marshal(&sessions.State{
+		Subject:  sa.GetUserId(),
+		IssuedAt: jwt.NewNumericDate(sa.GetIssuedAt().AsTime()),
+		ID:       sa.GetId(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("databroker: failed to sign service account jwt: %w", err)
+	}
+	return string(rawJWT), nil
+}
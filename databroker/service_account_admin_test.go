@@ -0,0 +1,197 @@
+package databroker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/atomicutil"
+	"github.com/pomerium/pomerium/internal/encoding/jws"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+func TestServiceAccountAdmin(t *testing.T) {
+	t.Parallel()
+
+	sharedKey := cryptutil.NewKey()
+	client := newTestDataBrokerClient(t)
+	srv := &serviceAccountAdminServer{
+		client:    client,
+		sharedKey: atomicutil.NewValue(sharedKey),
+	}
+	ctx := signedIncomingContext(t, sharedKey)
+
+	parseJWT := func(t *testing.T, rawJWT string) *sessions.State {
+		t.Helper()
+		encoder, err := jws.NewHS256Signer(sharedKey)
+		require.NoError(t, err)
+		var state sessions.State
+		require.NoError(t, encoder.Unmarshal([]byte(rawJWT), &state))
+		return &state
+	}
+
+	t.Run("create", func(t *testing.T) {
+		res, err := srv.CreateServiceAccount(ctx, &user.CreateServiceAccountRequest{
+			UserId:      "u1",
+			NamespaceId: proto.String("n1"),
+			Description: proto.String("ci"),
+			ExpiresIn:   durationpb.New(time.Hour),
+		})
+		require.NoError(t, err)
+
+		sa := res.GetServiceAccount()
+		assert.NotEmpty(t, sa.GetId())
+		assert.Equal(t, "u1", sa.GetUserId())
+		assert.Equal(t, "n1", sa.GetNamespaceId())
+		assert.Equal(t, "ci", sa.GetDescription())
+		assert.Equal(t, time.Hour, sa.GetExpiresAt().AsTime().Sub(sa.GetIssuedAt().AsTime()))
+
+		state := parseJWT(t, res.GetJwt())
+		assert.Equal(t, sa.GetId(), state.ID)
+		assert.Equal(t, "u1", state.Subject)
+
+		stored, err := user.GetServiceAccount(ctx, client, sa.GetId())
+		require.NoError(t, err)
+		testutil.AssertProtoEqual(t, sa, stored)
+
+		_, err = srv.CreateServiceAccount(ctx, &user.CreateServiceAccountRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = srv.CreateServiceAccount(ctx, &user.CreateServiceAccountRequest{
+			UserId:    "u1",
+			ExpiresIn: durationpb.New(-time.Hour),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("list", func(t *testing.T) {
+		client := newTestDataBrokerClient(t)
+		srv := &serviceAccountAdminServer{client: client, sharedKey: atomicutil.NewValue(sharedKey)}
+
+		now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		_, err := databroker.Put(ctx, client,
+			&user.ServiceAccount{Id: "sa1", UserId: "u1", AccessedAt: timestamppb.New(now)},
+			&user.ServiceAccount{Id: "sa2", UserId: "u1", NamespaceId: proto.String("n1")},
+			&user.ServiceAccount{Id: "sa3", UserId: "u2", AccessedAt: timestamppb.New(now.Add(-48 * time.Hour))},
+		)
+		require.NoError(t, err)
+
+		ids := func(res *user.ListServiceAccountsResponse) []string {
+			var ids []string
+			for _, sa := range res.GetServiceAccounts() {
+				ids = append(ids, sa.GetId())
+			}
+			return ids
+		}
+
+		res, err := srv.ListServiceAccounts(ctx, &user.ListServiceAccountsRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"sa2", "sa3", "sa1"}, ids(res), "should be least recently accessed first")
+
+		res, err = srv.ListServiceAccounts(ctx, &user.ListServiceAccountsRequest{UserId: "u1"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"sa2", "sa1"}, ids(res))
+
+		res, err = srv.ListServiceAccounts(ctx, &user.ListServiceAccountsRequest{NamespaceId: "n1"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"sa2"}, ids(res))
+
+		res, err = srv.ListServiceAccounts(ctx, &user.ListServiceAccountsRequest{
+			NotAccessedSince: timestamppb.New(now.Add(-24 * time.Hour)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"sa2", "sa3"}, ids(res))
+	})
+	t.Run("rotate", func(t *testing.T) {
+		created, err := srv.CreateServiceAccount(ctx, &user.CreateServiceAccountRequest{
+			UserId:      "u1",
+			Description: proto.String("deploy"),
+			ExpiresIn:   durationpb.New(2 * time.Hour),
+		})
+		require.NoError(t, err)
+
+		res, err := srv.RotateServiceAccount(ctx, &user.RotateServiceAccountRequest{
+			ServiceAccountId: created.GetServiceAccount().GetId(),
+		})
+		require.NoError(t, err)
+
+		sa := res.GetServiceAccount()
+		assert.NotEqual(t, created.GetServiceAccount().GetId(), sa.GetId())
+		assert.Equal(t, "u1", sa.GetUserId())
+		assert.Equal(t, "deploy", sa.GetDescription())
+		assert.Equal(t, 2*time.Hour, sa.GetExpiresAt().AsTime().Sub(sa.GetIssuedAt().AsTime()))
+		assert.Equal(t, sa.GetId(), parseJWT(t, res.GetJwt()).ID)
+
+		_, err = user.GetServiceAccount(ctx, client, created.GetServiceAccount().GetId())
+		assert.Equal(t, codes.NotFound, status.Code(err), "should delete the old service account")
+
+		_, err = srv.RotateServiceAccount(ctx, &user.RotateServiceAccountRequest{
+			ServiceAccountId: created.GetServiceAccount().GetId(),
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("revoke", func(t *testing.T) {
+		created, err := srv.CreateServiceAccount(ctx, &user.CreateServiceAccountRequest{UserId: "u1"})
+		require.NoError(t, err)
+		assert.Nil(t, created.GetServiceAccount().GetExpiresAt())
+
+		res, err := srv.RevokeServiceAccounts(ctx, &user.RevokeServiceAccountsRequest{
+			ServiceAccountIds: []string{created.GetServiceAccount().GetId()},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{created.GetServiceAccount().GetId()}, res.GetServiceAccountIds())
+
+		_, err = user.GetServiceAccount(ctx, client, created.GetServiceAccount().GetId())
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = srv.RevokeServiceAccounts(ctx, &user.RevokeServiceAccountsRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestServiceAccountAdmin_RequireSignedJWT(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := &serviceAccountAdminServer{
+		client:    newTestDataBrokerClient(t),
+		sharedKey: atomicutil.NewValue(cryptutil.NewKey()),
+	}
+	_, err := srv.CreateServiceAccount(ctx, &user.CreateServiceAccountRequest{UserId: "u1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = srv.ListServiceAccounts(ctx, &user.ListServiceAccountsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = srv.RotateServiceAccount(ctx, &user.RotateServiceAccountRequest{ServiceAccountId: "sa1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = srv.RevokeServiceAccounts(ctx, &user.RevokeServiceAccountsRequest{ServiceAccountIds: []string{"sa1"}})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// signedIncomingContext returns a context as it would be seen by a gRPC
+// server for a request signed with the shared key.
+func signedIncomingContext(t *testing.T, sharedKey []byte) context.Context {
+	t.Helper()
+
+	sig, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: sharedKey},
+		(&jose.SignerOptions{}).WithType("JWT"))
+	require.NoError(t, err)
+	rawJWT, err := jwt.Signed(sig).Claims(jwt.Claims{
+		Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}).CompactSerialize()
+	require.NoError(t, err)
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcutil.JWTMetadataKey, rawJWT))
+}
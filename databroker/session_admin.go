@@ -0,0 +1,330 @@
+package databroker
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/atomicutil"
+	"github.com/pomerium/pomerium/internal/log"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+	"github.com/pomerium/pomerium/pkg/identity"
+	"github.com/pomerium/pomerium/pkg/identity/manager"
+)
+
+var errRefreshTokenRevocationNotSupported = errors.New("databroker: the identity provider does not support revoking refresh tokens")
+
+// A sessionAdminServer implements the session admin service. Requests must be
+// signed with the shared secret.
+type sessionAdminServer struct {
+	session.UnimplementedSessionAdminServer
+
+	client    databrokerpb.DataBrokerServiceClient
+	sharedKey *atomicutil.Value[[]byte]
+
+	// getAuthenticator returns the authenticator used to revoke the OAuth
+	// tokens of sessions for the given identity provider.
+	getAuthenticator func(ctx context.Context, idpID string) (identity.Authenticator, error)
+}
+
+// ListSessions returns the sessions for a user, or all sessions if no user is
+// given.
+func (srv *sessionAdminServer) ListSessions(ctx context.Context, req *session.ListSessionsRequest) (*session.ListSessionsResponse, error) {
+	if err := grpcutil.RequireSignedJWT(ctx, srv.sharedKey.Load()); err != nil {
+		return nil, err
+	}
+
+	sessions, err := srv.listSessions(ctx, req.GetUserId(), req.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(session.ListSessionsResponse)
+	for _, s := range sessions {
+		res.Sessions = append(res.Sessions, s.info())
+	}
+	return res, nil
+}
+
+// GetSession returns a single session.
+func (srv *sessionAdminServer) GetSession(ctx context.Context, req *session.GetSessionRequest) (*session.SessionInfo, error) {
+	if err := grpcutil.RequireSignedJWT(ctx, srv.sharedKey.Load()); err != nil {
+		return nil, err
+	}
+
+	if req.GetSessionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+
+	s, err := srv.getSession(ctx, req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+	return s.info(), nil
+}
+
+// RevokeSessions revokes the OAuth tokens of the matching sessions and
+// deletes them.
+func (srv *sessionAdminServer) RevokeSessions(ctx context.Context, req *session.RevokeSessionsRequest) (*session.RevokeSessionsResponse, error) {
+	if err := grpcutil.RequireSignedJWT(ctx, srv.sharedKey.Load()); err != nil {
+		return nil, err
+	}
+
+	if len(req.GetSessionIds()) == 0 && req.GetUserId() == "" && req.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "one of session_ids, user_id or email is required")
+	}
+
+	var sessions []*adminSession
+	for _, id := range req.GetSessionIds() {
+		s, err := srv.getSession(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	if req.GetUserId() != "" || req.GetEmail() != "" {
+		s, err := srv.listSessions(ctx, req.GetUserId(), req.GetEmail())
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s...)
+	}
+
+	res := new(session.RevokeSessionsResponse)
+	seen := make(map[string]struct{}, len(sessions))
+	for _, s := range sessions {
+		if _, ok := seen[s.record.GetId()]; ok {
+			continue
+		}
+		seen[s.record.GetId()] = struct{}{}
+
+		result, err := srv.revokeSession(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
+
+func (srv *sessionAdminServer) revokeSession(ctx context.Context, s *adminSession) (*session.RevokeSessionsResponse_Result, error) {
+	result := &session.RevokeSessionsResponse_Result{SessionId: s.record.GetId()}
+	if s.session.GetOauthToken() != nil {
+		if err := srv.revokeTokens(ctx, s.session, result); err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("session-id", s.record.GetId()).Msg("databroker: failed to revoke oauth tokens")
+			result.Error = err.Error()
+		}
+	}
+
+	// the identity manager expects to be able to read the session and user
+	// ids from deleted session records, so session.Delete cannot be used
+	record := s.record
+	record.DeletedAt = timestamppb.Now()
+	_, err := srv.client.Put(ctx, &databrokerpb.PutRequest{
+		Records: []*databrokerpb.Record{record},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("databroker: failed to delete session %s: %w", record.GetId(), err)
+	}
+
+	return result, nil
+}
+
+// revokeTokens revokes the session's OAuth tokens, recording which were
+// revoked in the result. The refresh token is revoked first, since it could be
+// used to get new access tokens.
+func (srv *sessionAdminServer) revokeTokens(ctx context.Context, s *session.Session, result *session.RevokeSessionsResponse_Result) error {
+	authenticator, err := srv.getAuthenticator(ctx, s.GetIdpId())
+	if err != nil {
+		return err
+	}
+
+	tokenRevoker, ok := authenticator.(interface {
+		RevokeToken(ctx context.Context, token, tokenTypeHint string) error
+	})
+	if !ok {
+		if err := authenticator.Revoke(ctx, manager.FromOAuthToken(s.GetOauthToken())); err != nil {
+			return err
+		}
+		result.TokenRevoked = true
+		if s.GetOauthToken().GetRefreshToken() != "" {
+			return errRefreshTokenRevocationNotSupported
+		}
+		return nil
+	}
+
+	if refreshToken := s.GetOauthToken().GetRefreshToken(); refreshToken != "" {
+		if err := tokenRevoker.RevokeToken(ctx, refreshToken, "refresh_token"); err != nil {
+			return err
+		}
+		result.RefreshTokenRevoked = true
+	}
+	if accessToken := s.GetOauthToken().GetAccessToken(); accessToken != "" {
+		if err := tokenRevoker.RevokeToken(ctx, accessToken, "access_token"); err != nil {
+			return err
+		}
+		result.TokenRevoked = true
+	}
+	return nil
+}
+
+func (srv *sessionAdminServer) getSession(ctx context.Context, sessionID string) (*adminSession, error) {
+	res, err := srv.client.Get(ctx, &databrokerpb.GetRequest{
+		Type: grpcutil.GetTypeURL(new(session.Session)),
+		Id:   sessionID,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Errorf(codes.NotFound, "session %s not found", sessionID)
+	} else if err != nil {
+		return nil, err
+	}
+
+	s, err := newAdminSession(res.GetRecord())
+	if err != nil {
+		return nil, err
+	}
+
+	if s.session.GetUserId() != "" {
+		u, err := user.Get(ctx, srv.client, s.session.GetUserId())
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		s.email = cmp.Or(u.GetEmail(), s.email)
+	}
+	return s, nil
+}
+
+// listSessions returns the sessions matching the user id and email, most
+// recently issued first.
+func (srv *sessionAdminServer) listSessions(ctx context.Context, userID, email string) ([]*adminSession, error) {
+	emails, err := srv.listUserEmails(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	records, _, _, err := databrokerpb.InitialSync(ctx, srv.client, &databrokerpb.SyncLatestRequest{
+		Type: grpcutil.GetTypeURL(new(session.Session)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("databroker: error listing sessions: %w", err)
+	}
+
+	var sessions []*adminSession
+	for _, record := range records {
+		s, err := newAdminSession(record)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("session-id", record.GetId()).Msg("databroker: invalid session")
+			continue
+		}
+		s.email = cmp.Or(emails[s.session.GetUserId()], s.email)
+
+		if userID != "" && s.session.GetUserId() != userID {
+			continue
+		}
+		if email != "" && !strings.EqualFold(s.email, email) {
+			continue
+		}
+		sessions = append(sessions, s)
+	}
+	slices.SortFunc(sessions, func(a, b *adminSession) int {
+		return cmp.Or(
+			b.session.GetIssuedAt().AsTime().Compare(a.session.GetIssuedAt().AsTime()),
+			strings.Compare(a.record.GetId(), b.record.GetId()),
+		)
+	})
+	return sessions, nil
+}
+
+// listUserEmails returns the email of every user, keyed by user id.
+func (srv *sessionAdminServer) listUserEmails(ctx context.Context) (map[string]string, error) {
+	records, _, _, err := databrokerpb.InitialSync(ctx, srv.client, &databrokerpb.SyncLatestRequest{
+		Type: grpcutil.GetTypeURL(new(user.User)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("databroker: error listing users: %w", err)
+	}
+
+	emails := make(map[string]string, len(records))
+	for _, record := range records {
+		var u user.User
+		if err := record.GetData().UnmarshalTo(&u); err != nil {
+			continue
+		}
+		emails[record.GetId()] = u.GetEmail()
+	}
+	return emails, nil
+}
+
+// getSessionAuthenticator returns an authenticator for the given identity
+// provider using the current options.
+func (c *DataBroker) getSessionAuthenticator(_ context.Context, idpID string) (identity.Authenticator, error) {
+	options := c.currentOptions.Load()
+	if options == nil {
+		return nil, errors.New("databroker: no configuration")
+	}
+
+	oauthOptions, err := options.GetOauthOptions()
+	if err != nil {
+		return nil, fmt.Errorf("databroker: invalid oauth options: %w", err)
+	}
+	idp, err := options.GetIdentityProviderForID(idpID)
+	if err != nil {
+		return nil, fmt.Errorf("databroker: invalid identity provider: %w", err)
+	}
+	return newIdentityProviderAuthenticator(oauthOptions, idp)
+}
+
+// An adminSession is a session record along with its decoded session.
+type adminSession struct {
+	record  *databrokerpb.Record
+	session *session.Session
+	email   string
+}
+
+func newAdminSession(record *databrokerpb.Record) (*adminSession, error) {
+	var s session.Session
+	if err := record.GetData().UnmarshalTo(&s); err != nil {
+		return nil, fmt.Errorf("databroker: error unmarshaling session: %w", err)
+	}
+	return &adminSession{
+		record:  record,
+		session: &s,
+		email:   getSessionEmail(&s),
+	}, nil
+}
+
+func (s *adminSession) info() *session.SessionInfo {
+	return &session.SessionInfo{
+		Id:                   s.record.GetId(),
+		UserId:               s.session.GetUserId(),
+		Email:                s.email,
+		IdpId:                s.session.GetIdpId(),
+		IssuedAt:             s.session.GetIssuedAt(),
+		AccessedAt:           s.session.GetAccessedAt(),
+		ExpiresAt:            s.session.GetExpiresAt(),
+		DeviceCredentials:    s.session.GetDeviceCredentials(),
+		HasRefreshToken:      s.session.GetOauthToken().GetRefreshToken() != "",
+		ImpersonateSessionId: s.session.ImpersonateSessionId,
+	}
+}
+
+// getSessionEmail returns the email claim of the session, which is used when
+// there is no user record.
+func getSessionEmail(s *session.Session) string {
+	for _, v := range s.GetClaims()["email"].GetValues() {
+		if email := v.GetStringValue(); email != "" {
+			return email
+		}
+	}
+	return ""
+}
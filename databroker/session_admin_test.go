@@ -0,0 +1,285 @@
+package databroker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/atomicutil"
+	internal_databroker "github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/identity"
+	"github.com/pomerium/pomerium/pkg/identity/oauth"
+	"github.com/pomerium/pomerium/pkg/identity/oidc"
+)
+
+type revokeRecorder struct {
+	identity.MockProvider
+	mu     sync.Mutex
+	tokens []string
+}
+
+func (r *revokeRecorder) Revoke(_ context.Context, token *oauth2.Token) error {
+	r.mu.Lock()
+	r.tokens = append(r.tokens, token.AccessToken)
+	r.mu.Unlock()
+	return r.RevokeError
+}
+
+func newTestSessionAdminServer(t *testing.T) (*sessionAdminServer, databroker.DataBrokerServiceClient, *revokeRecorder) {
+	t.Helper()
+
+	client := newTestDataBrokerClient(t)
+	recorder := new(revokeRecorder)
+	return &sessionAdminServer{
+		client:    client,
+		sharedKey: atomicutil.NewValue([]byte{}),
+		getAuthenticator: func(_ context.Context, idpID string) (identity.Authenticator, error) {
+			if idpID == "missing" {
+				return nil, errors.New("unknown identity provider")
+			}
+			return recorder, nil
+		},
+	}, client, recorder
+}
+
+func newTestDataBrokerClient(t *testing.T) databroker.DataBrokerServiceClient {
+	t.Helper()
+
+	lis := bufconn.Listen(bufSize)
+	s := grpc.NewServer()
+	databroker.RegisterDataBrokerServiceServer(s, &dataBrokerServer{
+		server:    internal_databroker.New(context.Background()),
+		sharedKey: atomicutil.NewValue([]byte{}),
+	})
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return databroker.NewDataBrokerServiceClient(conn)
+}
+
+func TestSessionAdmin(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv, client, recorder := newTestSessionAdminServer(t)
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	_, err := databroker.Put(ctx, client,
+		&user.User{Id: "u1", Email: "Jane@Example.com"},
+		&session.Session{
+			Id:         "s1",
+			UserId:     "u1",
+			IdpId:      "idp1",
+			IssuedAt:   timestamppb.New(now),
+			AccessedAt: timestamppb.New(now.Add(time.Minute)),
+			ExpiresAt:  timestamppb.New(now.Add(time.Hour)),
+			OauthToken: &session.OAuthToken{AccessToken: "at1", RefreshToken: "rt1"},
+			DeviceCredentials: []*session.Session_DeviceCredential{{
+				TypeId:     "any",
+				Credential: &session.Session_DeviceCredential_Id{Id: "d1"},
+			}},
+		},
+		&session.Session{
+			Id:         "s2",
+			UserId:     "u1",
+			IdpId:      "missing",
+			IssuedAt:   timestamppb.New(now.Add(time.Hour)),
+			OauthToken: &session.OAuthToken{AccessToken: "at2"},
+		},
+		&session.Session{
+			Id:       "s3",
+			UserId:   "u2",
+			IssuedAt: timestamppb.New(now),
+			Claims: map[string]*structpb.ListValue{
+				"email": {Values: []*structpb.Value{structpb.NewStringValue("john@example.com")}},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	sessionIDs := func(sessions []*session.SessionInfo) []string {
+		var ids []string
+		for _, s := range sessions {
+			ids = append(ids, s.GetId())
+		}
+		return ids
+	}
+
+	t.Run("list", func(t *testing.T) {
+		res, err := srv.ListSessions(ctx, &session.ListSessionsRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"s2", "s1", "s3"}, sessionIDs(res.GetSessions()))
+
+		res, err = srv.ListSessions(ctx, &session.ListSessionsRequest{UserId: "u1"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"s2", "s1"}, sessionIDs(res.GetSessions()))
+
+		res, err = srv.ListSessions(ctx, &session.ListSessionsRequest{Email: "jane@example.com"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"s2", "s1"}, sessionIDs(res.GetSessions()))
+
+		res, err = srv.ListSessions(ctx, &session.ListSessionsRequest{Email: "john@example.com"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"s3"}, sessionIDs(res.GetSessions()))
+	})
+	t.Run("get", func(t *testing.T) {
+		res, err := srv.GetSession(ctx, &session.GetSessionRequest{SessionId: "s1"})
+		require.NoError(t, err)
+		assert.Equal(t, "u1", res.GetUserId())
+		assert.Equal(t, "Jane@Example.com", res.GetEmail())
+		assert.Equal(t, "idp1", res.GetIdpId())
+		assert.Equal(t, now, res.GetIssuedAt().AsTime())
+		assert.Equal(t, now.Add(time.Minute), res.GetAccessedAt().AsTime())
+		assert.Equal(t, now.Add(time.Hour), res.GetExpiresAt().AsTime())
+		assert.Equal(t, "d1", res.GetDeviceCredentials()[0].GetId())
+		assert.True(t, res.GetHasRefreshToken())
+
+		_, err = srv.GetSession(ctx, &session.GetSessionRequest{SessionId: "s4"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("revoke", func(t *testing.T) {
+		_, err := srv.RevokeSessions(ctx, &session.RevokeSessionsRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		res, err := srv.RevokeSessions(ctx, &session.RevokeSessionsRequest{
+			SessionIds: []string{"s1"},
+			Email:      "jane@example.com",
+		})
+		require.NoError(t, err)
+		require.Len(t, res.GetResults(), 2)
+		assert.Equal(t, "s1", res.GetResults()[0].GetSessionId())
+		assert.True(t, res.GetResults()[0].GetTokenRevoked())
+		assert.False(t, res.GetResults()[0].GetRefreshTokenRevoked(),
+			"should not report the refresh token as revoked when the authenticator can only revoke access tokens")
+		assert.Equal(t, errRefreshTokenRevocationNotSupported.Error(), res.GetResults()[0].GetError())
+		assert.Equal(t, "s2", res.GetResults()[1].GetSessionId())
+		assert.False(t, res.GetResults()[1].GetTokenRevoked())
+		assert.Equal(t, "unknown identity provider", res.GetResults()[1].GetError())
+		assert.Equal(t, []string{"at1"}, recorder.tokens)
+
+		list, err := srv.ListSessions(ctx, &session.ListSessionsRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"s3"}, sessionIDs(list.GetSessions()))
+	})
+}
+
+func TestSessionAdmin_RevokeTokens(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type revocation struct{ token, tokenTypeHint string }
+	var mu sync.Mutex
+	var revocations []revocation
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{
+				"issuer":                 srv.URL,
+				"authorization_endpoint": srv.URL + "/authorize",
+				"token_endpoint":         srv.URL + "/token",
+				"jwks_uri":               srv.URL + "/jwks",
+				"revocation_endpoint":    srv.URL + "/revoke",
+			})
+		case "/revoke":
+			assert.Equal(t, "CLIENT_SECRET", r.FormValue("client_secret"))
+			mu.Lock()
+			revocations = append(revocations, revocation{r.FormValue("token"), r.FormValue("token_type_hint")})
+			mu.Unlock()
+			if r.FormValue("token") == "broken" {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	authenticator, err := oidc.New(ctx, &oauth.Options{
+		ProviderURL:  srv.URL,
+		RedirectURL:  &url.URL{Scheme: "https", Host: "authenticate.example.com", Path: "/oauth2/callback"},
+		ClientID:     "CLIENT_ID",
+		ClientSecret: "CLIENT_SECRET",
+	})
+	require.NoError(t, err)
+
+	client := newTestDataBrokerClient(t)
+	admin := &sessionAdminServer{
+		client:    client,
+		sharedKey: atomicutil.NewValue([]byte{}),
+		getAuthenticator: func(context.Context, string) (identity.Authenticator, error) {
+			return authenticator, nil
+		},
+	}
+	_, err = databroker.Put(ctx, client,
+		&session.Session{Id: "s1", UserId: "u1", OauthToken: &session.OAuthToken{AccessToken: "at1", RefreshToken: "rt1"}},
+		&session.Session{Id: "s2", UserId: "u2", OauthToken: &session.OAuthToken{AccessToken: "at2"}},
+		&session.Session{Id: "s3", UserId: "u3", OauthToken: &session.OAuthToken{AccessToken: "at3", RefreshToken: "broken"}},
+	)
+	require.NoError(t, err)
+
+	res, err := admin.RevokeSessions(ctx, &session.RevokeSessionsRequest{SessionIds: []string{"s1", "s2", "s3"}})
+	require.NoError(t, err)
+	require.Len(t, res.GetResults(), 3)
+
+	assert.True(t, res.GetResults()[0].GetRefreshTokenRevoked())
+	assert.True(t, res.GetResults()[0].GetTokenRevoked())
+	assert.Empty(t, res.GetResults()[0].GetError())
+
+	assert.False(t, res.GetResults()[1].GetRefreshTokenRevoked())
+	assert.True(t, res.GetResults()[1].GetTokenRevoked())
+	assert.Empty(t, res.GetResults()[1].GetError())
+
+	assert.False(t, res.GetResults()[2].GetRefreshTokenRevoked())
+	assert.False(t, res.GetResults()[2].GetTokenRevoked())
+	assert.Contains(t, res.GetResults()[2].GetError(), "error revoking refresh_token")
+
+	assert.Equal(t, []revocation{
+		{"rt1", "refresh_token"},
+		{"at1", "access_token"},
+		{"at2", "access_token"},
+		{"broken", "refresh_token"},
+	}, revocations)
+}
+
+func TestSessionAdmin_RequireSignedJWT(t *testing.T) {
+	t.Parallel()
+
+	srv, _, _ := newTestSessionAdminServer(t)
+	srv.sharedKey.Store([]byte("2p/Wi2Q6bYDfzmoSEbKqYKtg+DUoLWTEHHs7vOhvL7w="))
+
+	_, err := srv.ListSessions(context.Background(), &session.ListSessionsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = srv.GetSession(context.Background(), &session.GetSessionRequest{SessionId: "s1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = srv.RevokeSessions(context.Background(), &session.RevokeSessionsRequest{SessionIds: []string{"s1"}})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
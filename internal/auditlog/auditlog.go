@@ -0,0 +1,143 @@
+// Package auditlog writes sealed audit records to dedicated sinks and reads
+// them back for investigations.
+package auditlog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/grpc/crypt"
+)
+
+// queueSize is the number of entries buffered by asynchronous sinks.
+const queueSize = 1024
+
+var errQueueFull = errors.New("auditlog: queue is full, dropping record")
+
+// A Sink writes audit log entries. Each entry is a single JSON object.
+type Sink interface {
+	Write(ctx context.Context, entry []byte) error
+	// Close closes the sink. Buffered entries are flushed until the context
+	// is done.
+	Close(ctx context.Context) error
+}
+
+// NewEntry returns the JSON encoding of a sealed audit record. The format is
+// the same as audit records written to the pomerium log.
+func NewEntry(t time.Time, requestID string, sealed *crypt.SealedMessage) []byte {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+	logger.Info().
+		Time(zerolog.TimestampFieldName, t).
+		Str("request-id", requestID).
+		EmbedObject(sealed).
+		Msg("audit log")
+	return bytes.TrimSuffix(buf.Bytes(), []byte{'\n'})
+}
+
+// NewSink creates a new Sink from the audit sink options.
+func NewSink(cfg *config.Config, options *config.AuditSink) (Sink, error) {
+	switch {
+	case options.File != nil:
+		return newFileSink(options.File), nil
+	case options.Syslog != nil:
+		return newSyslogSink(options.Syslog)
+	case options.Webhook != nil:
+		return newWebhookSink(cfg, options.Webhook)
+	}
+	return nil, errors.New("auditlog: no sink configured")
+}
+
+// A Logger writes audit log entries to all of the configured sinks.
+type Logger struct {
+	mu      sync.RWMutex
+	options []config.AuditSink
+	sinks   []Sink
+}
+
+// NewLogger creates a new Logger with no sinks.
+func NewLogger() *Logger {
+	return new(Logger)
+}
+
+// OnConfigChange updates the sinks. The existing sinks are kept if the audit
+// sink options are unchanged. Replaced sinks are closed using the context.
+func (l *Logger) OnConfigChange(ctx context.Context, cfg *config.Config) error {
+	options := cfg.Options.AuditSinks
+
+	l.mu.RLock()
+	unchanged := reflect.DeepEqual(l.options, options)
+	l.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	sinks := make([]Sink, 0, len(options))
+	for i := range options {
+		sink, err := NewSink(cfg, &options[i])
+		if err != nil {
+			for _, s := range sinks {
+				_ = s.Close(ctx)
+			}
+			return fmt.Errorf("auditlog: invalid sink %d: %w", i, err)
+		}
+		sinks = append(sinks, sink)
+	}
+
+	l.mu.Lock()
+	previous := l.sinks
+	l.options, l.sinks = options, sinks
+	l.mu.Unlock()
+
+	return closeSinks(ctx, previous)
+}
+
+// Enabled returns true if any sinks are configured.
+func (l *Logger) Enabled() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.sinks) > 0
+}
+
+// Write writes an entry to every sink.
+func (l *Logger) Write(ctx context.Context, entry []byte) error {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	var errs []error
+	for _, s := range l.sinks {
+		if err := s.Write(ctx, entry); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Close closes all of the sinks, flushing any buffered entries until the
+// context is done.
+func (l *Logger) Close(ctx context.Context) error {
+	l.mu.Lock()
+	sinks := l.sinks
+	l.options, l.sinks = nil, nil
+	l.mu.Unlock()
+
+	return closeSinks(ctx, sinks)
+}
+
+func closeSinks(ctx context.Context, sinks []Sink) error {
+	var errs []error
+	for _, s := range sinks {
+		if err := s.Close(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
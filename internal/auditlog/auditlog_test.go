@@ -0,0 +1,95 @@
+package auditlog
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/audit"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+func TestEntries(t *testing.T) {
+	t.Parallel()
+
+	kek, err := cryptutil.GenerateKeyEncryptionKey()
+	require.NoError(t, err)
+	enc := protoutil.NewEncryptor(kek.Public())
+	dec := protoutil.NewDecryptor(cryptutil.KeyEncryptionKeySourceFunc(func(_ string) (*cryptutil.PrivateKeyEncryptionKey, error) {
+		return kek, nil
+	}))
+
+	sealed, err := enc.Encrypt(&audit.Record{
+		Request: &envoy_service_auth_v3.CheckRequest{
+			Attributes: &envoy_service_auth_v3.AttributeContext{
+				Request: &envoy_service_auth_v3.AttributeContext_Request{
+					Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{Path: "/admin"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	buf.WriteString(`{"level":"info","message":"authorize check"}` + "\n")
+	buf.Write(NewEntry(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), "REQUEST-ID", sealed))
+	buf.WriteString("\nnot json\n")
+
+	var entries []*DecryptedEntry
+	err = ReadEntries(&buf, dec, func(entry *DecryptedEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "should skip other log messages")
+	assert.Equal(t, "REQUEST-ID", entries[0].RequestID)
+	assert.Equal(t, "2024-01-02T03:04:05Z", entries[0].Time)
+	assert.Equal(t, "/admin", entries[0].Record.GetRequest().GetAttributes().GetRequest().GetHttp().GetPath())
+}
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	newConfig := func(names ...string) *config.Config {
+		opts := config.NewDefaultOptions()
+		for _, name := range names {
+			opts.AuditSinks = append(opts.AuditSinks, config.AuditSink{
+				File: &config.AuditFileSink{Path: filepath.Join(dir, name)},
+			})
+		}
+		return &config.Config{Options: opts}
+	}
+
+	l := NewLogger()
+	assert.False(t, l.Enabled())
+
+	require.NoError(t, l.OnConfigChange(context.Background(), newConfig("a.log", "b.log")))
+	assert.True(t, l.Enabled())
+	require.NoError(t, l.Write(context.Background(), []byte(`{"n":1}`)))
+
+	sinks := l.sinks
+	require.NoError(t, l.OnConfigChange(context.Background(), newConfig("a.log", "b.log")))
+	assert.Equal(t, sinks, l.sinks, "should keep sinks when unchanged")
+
+	require.NoError(t, l.OnConfigChange(context.Background(), newConfig("a.log")))
+	require.NoError(t, l.Write(context.Background(), []byte(`{"n":2}`)))
+	require.NoError(t, l.Close(context.Background()))
+	assert.False(t, l.Enabled())
+
+	bs, err := os.ReadFile(filepath.Join(dir, "a.log"))
+	require.NoError(t, err)
+	assert.Equal(t, "{\"n\":1}\n{\"n\":2}\n", string(bs))
+	bs, err = os.ReadFile(filepath.Join(dir, "b.log"))
+	require.NoError(t, err)
+	assert.Equal(t, "{\"n\":1}\n", string(bs))
+}
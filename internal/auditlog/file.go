@@ -0,0 +1,150 @@
+package auditlog
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+// A fileSink appends entries to a file, rotating it when it reaches its
+// maximum size. The file is opened on the first write.
+type fileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+	now        func() time.Time
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+func newFileSink(options *config.AuditFileSink) *fileSink {
+	return &fileSink{
+		path:       options.Path,
+		maxSize:    options.GetMaxSize(),
+		maxBackups: options.MaxBackups,
+		now:        time.Now,
+	}
+}
+
+func (s *fileSink) Write(_ context.Context, entry []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	line := append(entry[:len(entry):len(entry)], '\n')
+
+	if s.file == nil {
+		if err := s.openLocked(); err != nil {
+			return err
+		}
+	}
+	if s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		if err := s.rotateLocked(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("auditlog: error writing to file: %w", err)
+	}
+	return nil
+}
+
+func (s *fileSink) Close(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+func (s *fileSink) openLocked() error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("auditlog: error creating directory: %w", err)
+	}
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("auditlog: error opening file: %w", err)
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("auditlog: error opening file: %w", err)
+	}
+	s.file, s.size = f, fi.Size()
+	return nil
+}
+
+func (s *fileSink) rotateLocked() error {
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("auditlog: error closing file: %w", err)
+	}
+	s.file = nil
+
+	prefix, ext := s.backupPrefixAndExt()
+	backup := prefix + s.now().UTC().Format(backupTimeFormat) + ext
+	if err := os.Rename(s.path, backup); err != nil {
+		return fmt.Errorf("auditlog: error rotating file: %w", err)
+	}
+	if err := s.openLocked(); err != nil {
+		return err
+	}
+	return s.removeOldBackups()
+}
+
+// removeOldBackups removes the oldest backups so that at most maxBackups are
+// kept.
+func (s *fileSink) removeOldBackups() error {
+	if s.maxBackups <= 0 {
+		return nil
+	}
+
+	prefix, ext := s.backupPrefixAndExt()
+	entries, err := os.ReadDir(filepath.Dir(s.path))
+	if err != nil {
+		return fmt.Errorf("auditlog: error listing backups: %w", err)
+	}
+
+	var backups []string
+	for _, e := range entries {
+		name := filepath.Join(filepath.Dir(s.path), e.Name())
+		if !e.IsDir() && strings.HasPrefix(name, prefix) && strings.HasSuffix(name, ext) {
+			backups = append(backups, name)
+		}
+	}
+	if len(backups) <= s.maxBackups {
+		return nil
+	}
+
+	// backup names sort by time
+	slices.Sort(backups)
+	for _, name := range backups[:len(backups)-s.maxBackups] {
+		if err := os.Remove(name); err != nil {
+			return fmt.Errorf("auditlog: error removing backup: %w", err)
+		}
+	}
+	return nil
+}
+
+// backupPrefixAndExt returns the prefix and extension of backup file names,
+// so that audit.log is rotated to audit-<time>.log.
+func (s *fileSink) backupPrefixAndExt() (prefix, ext string) {
+	ext = filepath.Ext(s.path)
+	return strings.TrimSuffix(s.path, ext) + "-", ext
+}
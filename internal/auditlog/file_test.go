@@ -0,0 +1,54 @@
+package auditlog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestFileSink(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	s := newFileSink(&config.AuditFileSink{
+		Path:       filepath.Join(dir, "audit", "audit.log"),
+		MaxBackups: 2,
+	})
+	s.maxSize = 10
+	s.now = func() time.Time { return now }
+	t.Cleanup(func() { _ = s.Close(context.Background()) })
+
+	for _, entry := range []string{"0000", "1111", "2222", "3333", "4444", "5555", "6666", "7777"} {
+		require.NoError(t, s.Write(context.Background(), []byte(entry)))
+		now = now.Add(time.Second)
+	}
+
+	read := func(name string) string {
+		bs, err := os.ReadFile(filepath.Join(dir, "audit", name))
+		require.NoError(t, err)
+		return string(bs)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(dir, "audit"))
+	require.NoError(t, err)
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	assert.Equal(t, []string{
+		"audit-2024-01-02T03-04-09.000.log",
+		"audit-2024-01-02T03-04-11.000.log",
+		"audit.log",
+	}, names, "should only keep max_backups rotated files")
+	assert.Equal(t, "2222\n3333\n", read("audit-2024-01-02T03-04-09.000.log"))
+	assert.Equal(t, "4444\n5555\n", read("audit-2024-01-02T03-04-11.000.log"))
+	assert.Equal(t, "6666\n7777\n", read("audit.log"))
+}
@@ -0,0 +1,72 @@
+package auditlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/pomerium/pomerium/pkg/grpc/audit"
+	"github.com/pomerium/pomerium/pkg/grpc/crypt"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+var sealedMessageTypeURL = protoutil.GetTypeURL(new(crypt.SealedMessage))
+
+// A DecryptedEntry is an audit log entry with its record decrypted.
+type DecryptedEntry struct {
+	Time      string
+	RequestID string
+	Record    *audit.Record
+}
+
+// ReadEntries reads newline-delimited JSON audit log entries from r, decrypts
+// them and calls fn for each one. Lines which are not audit log entries, such
+// as other log messages, are skipped.
+func ReadEntries(r io.Reader, dec *protoutil.Decryptor, fn func(*DecryptedEntry) error) error {
+	br := bufio.NewReader(r)
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			if err := readEntry(line, dec, fn); err != nil {
+				return fmt.Errorf("auditlog: line %d: %w", lineNumber, err)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+func readEntry(line []byte, dec *protoutil.Decryptor, fn func(*DecryptedEntry) error) error {
+	var header struct {
+		Type      string `json:"@type"`
+		Time      string `json:"time"`
+		RequestID string `json:"request-id"`
+	}
+	if json.Unmarshal(line, &header) != nil || header.Type != sealedMessageTypeURL {
+		return nil
+	}
+
+	sealed := new(crypt.SealedMessage)
+	if err := sealed.UnmarshalFromRawZerolog(line); err != nil {
+		return err
+	}
+	msg, err := dec.Decrypt(sealed)
+	if err != nil {
+		return err
+	}
+	record, ok := msg.(*audit.Record)
+	if !ok {
+		return fmt.Errorf("unexpected message type: %s", sealed.GetMessageType())
+	}
+
+	return fn(&DecryptedEntry{
+		Time:      header.Time,
+		RequestID: header.RequestID,
+		Record:    record,
+	})
+}
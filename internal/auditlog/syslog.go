@@ -0,0 +1,190 @@
+package auditlog
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+const (
+	// syslogPriority is the authpriv facility with the informational
+	// severity.
+	syslogPriority = 10*8 + 6
+	syslogMsgID    = "audit"
+	syslogTimeout  = 10 * time.Second
+)
+
+// A syslogSink sends entries to a syslog server over TCP, optionally with
+// TLS. Messages use the RFC 5424 format with octet-counting framing.
+//
+// Entries are queued and sent in the background. If the server is
+// unavailable, sending is retried until the sink is closed. When the sink is
+// closed, the queued entries are sent until the context passed to Close is
+// done.
+type syslogSink struct {
+	address   string
+	tlsConfig *tls.Config
+	tag       string
+	hostname  string
+	now       func() time.Time
+
+	entries chan []byte
+	cancel  context.CancelFunc
+	closing chan context.Context
+	done    chan struct{}
+	conn    net.Conn
+}
+
+func newSyslogSink(options *config.AuditSyslogSink) (*syslogSink, error) {
+	tlsConfig, err := options.GetTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	hostname, _ := os.Hostname()
+	if hostname == "" {
+		hostname = "-"
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &syslogSink{
+		address:   options.Address,
+		tlsConfig: tlsConfig,
+		tag:       options.GetTag(),
+		hostname:  hostname,
+		now:       time.Now,
+		entries:   make(chan []byte, queueSize),
+		cancel:    cancel,
+		closing:   make(chan context.Context, 1),
+		done:      make(chan struct{}),
+	}
+	go s.run(ctx)
+	return s, nil
+}
+
+func (s *syslogSink) Write(_ context.Context, entry []byte) error {
+	select {
+	case s.entries <- entry:
+		return nil
+	default:
+		return errQueueFull
+	}
+}
+
+func (s *syslogSink) Close(ctx context.Context) error {
+	s.cancel()
+	s.closing <- ctx
+	<-s.done
+	return nil
+}
+
+func (s *syslogSink) run(ctx context.Context) {
+	defer close(s.done)
+	defer s.disconnect()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.drain(<-s.closing)
+			return
+		case entry := <-s.entries:
+			b := backoff.NewExponentialBackOff()
+			b.MaxElapsedTime = 0
+			err := backoff.RetryNotify(func() error {
+				return s.send(ctx, entry)
+			}, backoff.WithContext(b, ctx), func(err error, next time.Duration) {
+				log.Ctx(ctx).Error().Err(err).Str("address", s.address).Dur("next", next).
+					Msg("auditlog: error sending to syslog, retrying")
+			})
+			if err != nil {
+				// the sink was closed while retrying
+				s.drain(<-s.closing, entry)
+				return
+			}
+		}
+	}
+}
+
+// drain makes a single attempt to send the pending entries and any queued
+// entries, until the context is done.
+func (s *syslogSink) drain(ctx context.Context, pending ...[]byte) {
+	for {
+		var entry []byte
+		if len(pending) > 0 {
+			entry, pending = pending[0], pending[1:]
+		} else {
+			select {
+			case entry = <-s.entries:
+			default:
+				return
+			}
+		}
+		if err := s.send(ctx, entry); err != nil {
+			log.Error().Err(err).Str("address", s.address).
+				Int("dropped", len(pending)+len(s.entries)+1).
+				Msg("auditlog: error sending to syslog, dropping records")
+			return
+		}
+	}
+}
+
+func (s *syslogSink) send(ctx context.Context, entry []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s.conn == nil {
+		if err := s.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	msg := fmt.Sprintf("<%d>1 %s %s %s %d %s - %s",
+		syslogPriority,
+		s.now().UTC().Format(time.RFC3339Nano),
+		s.hostname,
+		s.tag,
+		os.Getpid(),
+		syslogMsgID,
+		entry)
+
+	conn := s.conn
+	_ = conn.SetWriteDeadline(time.Now().Add(syslogTimeout))
+	// interrupt the write once the context is done
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetWriteDeadline(time.Now()) })
+	defer stop()
+	if _, err := fmt.Fprintf(conn, "%d %s", len(msg), msg); err != nil {
+		s.disconnect()
+		return err
+	}
+	return nil
+}
+
+func (s *syslogSink) connect(ctx context.Context) error {
+	dialer := &net.Dialer{Timeout: syslogTimeout}
+	var conn net.Conn
+	var err error
+	if s.tlsConfig != nil {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: s.tlsConfig}).DialContext(ctx, "tcp", s.address)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", s.address)
+	}
+	if err != nil {
+		return err
+	}
+	s.conn = conn
+	return nil
+}
+
+func (s *syslogSink) disconnect() {
+	if s.conn != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+}
@@ -0,0 +1,100 @@
+package auditlog
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestSyslogSink(t *testing.T) {
+	t.Parallel()
+
+	li, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = li.Close() })
+
+	messages := make(chan string)
+	go func() {
+		conn, err := li.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		for {
+			rawLength, err := r.ReadString(' ')
+			if err != nil {
+				return
+			}
+			length, err := strconv.Atoi(strings.TrimSpace(rawLength))
+			if err != nil {
+				return
+			}
+			msg := make([]byte, length)
+			if _, err := io.ReadFull(r, msg); err != nil {
+				return
+			}
+			messages <- string(msg)
+		}
+	}()
+
+	s, err := newSyslogSink(&config.AuditSyslogSink{Address: li.Addr().String()})
+	require.NoError(t, err)
+	s.now = func() time.Time { return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC) }
+	t.Cleanup(func() { _ = s.Close(context.Background()) })
+
+	require.NoError(t, s.Write(context.Background(), []byte(`{"n":1}`)))
+	require.NoError(t, s.Write(context.Background(), []byte(`{"n":2}`)))
+
+	for _, entry := range []string{`{"n":1}`, `{"n":2}`} {
+		select {
+		case msg := <-messages:
+			assert.Equal(t, fmt.Sprintf("<86>1 2024-01-02T03:04:05Z %s pomerium %d audit - %s",
+				s.hostname, os.Getpid(), entry), msg)
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for syslog message")
+		}
+	}
+}
+
+func TestSyslogSinkCloseTimeout(t *testing.T) {
+	t.Parallel()
+
+	// the server accepts connections but never completes the TLS handshake
+	li, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = li.Close() })
+	go func() {
+		for {
+			conn, err := li.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { _ = conn.Close() })
+		}
+	}()
+
+	s, err := newSyslogSink(&config.AuditSyslogSink{Address: li.Addr().String(), TLS: true})
+	require.NoError(t, err)
+	require.NoError(t, s.Write(context.Background(), []byte(`{"n":1}`)))
+	require.NoError(t, s.Write(context.Background(), []byte(`{"n":2}`)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	require.NoError(t, s.Close(ctx))
+	assert.Less(t, time.Since(start), syslogTimeout/2, "should stop draining once the context is done")
+}
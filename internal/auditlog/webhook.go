@@ -0,0 +1,174 @@
+package auditlog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+const webhookTimeout = 30 * time.Second
+
+// A webhookSink posts batches of entries to an HTTP endpoint as
+// newline-delimited JSON.
+//
+// Entries are queued and sent in the background when the batch is full or
+// the flush interval elapses. Failed requests are retried with an exponential
+// backoff, and the batch is dropped once the retries are exhausted.
+type webhookSink struct {
+	url           string
+	headers       map[string]string
+	batchSize     int
+	flushInterval time.Duration
+	maxRetries    int
+	client        *http.Client
+	newBackOff    func() backoff.BackOff
+
+	entries chan []byte
+	cancel  context.CancelFunc
+	closing chan context.Context
+	done    chan struct{}
+}
+
+func newWebhookSink(cfg *config.Config, options *config.AuditWebhookSink) (*webhookSink, error) {
+	transport, err := config.GetTLSClientTransport(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &webhookSink{
+		url:           options.URL,
+		headers:       options.Headers,
+		batchSize:     options.GetBatchSize(),
+		flushInterval: options.GetFlushInterval(),
+		maxRetries:    options.GetMaxRetries(),
+		client:        &http.Client{Transport: transport, Timeout: webhookTimeout},
+		newBackOff: func() backoff.BackOff {
+			return backoff.NewExponentialBackOff()
+		},
+		entries: make(chan []byte, queueSize),
+		cancel:  cancel,
+		closing: make(chan context.Context, 1),
+		done:    make(chan struct{}),
+	}
+	go s.run(ctx)
+	return s, nil
+}
+
+func (s *webhookSink) Write(_ context.Context, entry []byte) error {
+	select {
+	case s.entries <- entry:
+		return nil
+	default:
+		return errQueueFull
+	}
+}
+
+func (s *webhookSink) Close(ctx context.Context) error {
+	s.cancel()
+	s.closing <- ctx
+	<-s.done
+	return nil
+}
+
+func (s *webhookSink) run(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	var batch [][]byte
+	for {
+		select {
+		case <-ctx.Done():
+			// send whatever is left, with a single attempt per batch, until
+			// the context passed to Close is done
+			ctx := <-s.closing
+			for {
+				select {
+				case entry := <-s.entries:
+					batch = append(batch, entry)
+					if len(batch) < s.batchSize {
+						continue
+					}
+				default:
+				}
+				if len(batch) == 0 {
+					return
+				}
+				if batch = s.flush(ctx, batch, 0); batch != nil {
+					log.Ctx(ctx).Error().Err(ctx.Err()).Str("url", s.url).
+						Int("dropped", len(batch)+len(s.entries)).
+						Msg("auditlog: error sending to webhook, dropping records")
+					return
+				}
+			}
+		case entry := <-s.entries:
+			batch = append(batch, entry)
+			if len(batch) >= s.batchSize {
+				batch = s.flush(ctx, batch, s.maxRetries)
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				batch = s.flush(ctx, batch, s.maxRetries)
+			}
+		}
+	}
+}
+
+// flush sends a batch, returning the batch if the sink was closed before it
+// could be sent.
+func (s *webhookSink) flush(ctx context.Context, batch [][]byte, maxRetries int) [][]byte {
+	body := append(bytes.Join(batch, []byte{'\n'}), '\n')
+	b := backoff.WithMaxRetries(backoff.WithContext(s.newBackOff(), ctx), uint64(maxRetries))
+	err := backoff.RetryNotify(func() error {
+		return s.send(ctx, body)
+	}, b, func(err error, next time.Duration) {
+		log.Ctx(ctx).Error().Err(err).Str("url", s.url).Dur("next", next).
+			Msg("auditlog: error sending to webhook, retrying")
+	})
+	if err != nil && ctx.Err() != nil {
+		return batch
+	} else if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("url", s.url).Int("dropped", len(batch)).
+			Msg("auditlog: error sending to webhook, dropping records")
+	}
+	return nil
+}
+
+func (s *webhookSink) send(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return backoff.Permanent(err)
+	}
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	_ = res.Body.Close()
+
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		return nil
+	case res.StatusCode == http.StatusRequestTimeout,
+		res.StatusCode == http.StatusTooManyRequests,
+		res.StatusCode >= 500:
+		return fmt.Errorf("unexpected status code: %d", res.StatusCode)
+	default:
+		return backoff.Permanent(fmt.Errorf("unexpected status code: %d", res.StatusCode))
+	}
+}
@@ -0,0 +1,66 @@
+package auditlog
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestWebhookSink(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	bodies := make(chan string, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer TOKEN", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		bodies <- string(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	s, err := newWebhookSink(&config.Config{Options: config.NewDefaultOptions()}, &config.AuditWebhookSink{
+		URL:           srv.URL,
+		Headers:       map[string]string{"Authorization": "Bearer TOKEN"},
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+	})
+	require.NoError(t, err)
+	s.newBackOff = func() backoff.BackOff { return &backoff.ZeroBackOff{} }
+
+	for _, entry := range []string{`{"n":1}`, `{"n":2}`, `{"n":3}`} {
+		require.NoError(t, s.Write(context.Background(), []byte(entry)))
+	}
+
+	select {
+	case body := <-bodies:
+		assert.Equal(t, "{\"n\":1}\n{\"n\":2}\n", body, "should retry and send a full batch")
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for webhook request")
+	}
+
+	require.NoError(t, s.Close(context.Background()))
+	select {
+	case body := <-bodies:
+		// the previous batch may be sent again if the sink was closed
+		// before its response was read
+		assert.True(t, strings.HasSuffix(body, "{\"n\":3}\n"), "should flush remaining records on close")
+	default:
+		t.Fatal("expected remaining records to be flushed")
+	}
+}
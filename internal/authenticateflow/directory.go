@@ -0,0 +1,158 @@
+package authenticateflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/datasource/pkg/directory"
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/directory/ldap"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+// populateDirectoryFromLDAP looks up the user in the configured LDAP
+// directory and stores their group memberships and attributes as directory
+// records in the databroker. Failures are logged but do not prevent the user
+// from signing in.
+//
+// If the user isn't found, or the lookup fails, the user's directory record
+// is deleted, so that policies don't match groups the user may no longer be
+// a member of.
+//
+// The highest version of the saved records is returned, so that it can be
+// included in the session's databroker record version.
+func populateDirectoryFromLDAP(
+	ctx context.Context,
+	client databroker.DataBrokerServiceClient,
+	settings *config.LDAPSettings,
+	u *user.User,
+	claims map[string]any,
+) (recordVersion uint64) {
+	if !settings.Enabled() {
+		return 0
+	}
+
+	recordVersion, err := putDirectoryRecords(ctx, client, settings, u, ldapEmail(settings, u, claims))
+	if err == nil {
+		return recordVersion
+	}
+
+	if errors.Is(err, ldap.ErrUserNotFound) {
+		log.Ctx(ctx).Info().
+			Str("user_id", u.GetId()).
+			Msg("authenticate: user not found in ldap directory")
+	} else {
+		log.Ctx(ctx).Error().Err(err).
+			Str("user_id", u.GetId()).
+			Msg("authenticate: error populating directory from ldap")
+	}
+
+	recordVersion, err = deleteDirectoryUserRecord(ctx, client, u.GetId())
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).
+			Str("user_id", u.GetId()).
+			Msg("authenticate: error deleting directory user")
+	}
+	return recordVersion
+}
+
+func putDirectoryRecords(
+	ctx context.Context,
+	client databroker.DataBrokerServiceClient,
+	settings *config.LDAPSettings,
+	u *user.User,
+	email string,
+) (recordVersion uint64, err error) {
+	du, err := ldap.New(settings).GetUser(ctx, u.GetId(), email)
+	if err != nil {
+		return 0, err
+	}
+
+	records := make([]*databroker.Record, 0, len(du.Groups)+1)
+	records = append(records, newDirectoryUserRecord(u.GetId(), du))
+	for _, g := range du.Groups {
+		records = append(records, newDirectoryRecord(directory.GroupRecordType, g.ID, map[string]any{
+			"id":    g.ID,
+			"name":  g.Name,
+			"email": g.Email,
+		}))
+	}
+
+	res, err := client.Put(ctx, &databroker.PutRequest{Records: records})
+	if err != nil {
+		return 0, fmt.Errorf("error saving directory records: %w", err)
+	}
+	for _, record := range res.GetRecords() {
+		recordVersion = max(recordVersion, record.GetVersion())
+	}
+	return recordVersion, nil
+}
+
+// ldapEmail returns the email used to look up the user in the LDAP directory.
+// Unless the settings allow unverified emails, it's empty if the identity
+// provider didn't assert the email is verified, since a user could otherwise
+// claim the email of a directory user and inherit their groups.
+func ldapEmail(settings *config.LDAPSettings, u *user.User, claims map[string]any) string {
+	if settings.AllowUnverifiedEmail {
+		return u.GetEmail()
+	}
+	// some identity providers return email_verified as a string
+	if verified, _ := strconv.ParseBool(fmt.Sprint(claims["email_verified"])); !verified {
+		return ""
+	}
+	return u.GetEmail()
+}
+
+func deleteDirectoryUserRecord(
+	ctx context.Context,
+	client databroker.DataBrokerServiceClient,
+	userID string,
+) (recordVersion uint64, err error) {
+	record := newDirectoryRecord(directory.UserRecordType, userID, map[string]any{"id": userID})
+	record.DeletedAt = timestamppb.Now()
+	res, err := client.Put(ctx, &databroker.PutRequest{Records: []*databroker.Record{record}})
+	if err != nil {
+		return 0, err
+	}
+	for _, record := range res.GetRecords() {
+		recordVersion = max(recordVersion, record.GetVersion())
+	}
+	return recordVersion, nil
+}
+
+func newDirectoryUserRecord(userID string, du *ldap.User) *databroker.Record {
+	groupIDs := make([]string, 0, len(du.Groups))
+	for _, g := range du.Groups {
+		groupIDs = append(groupIDs, g.ID)
+	}
+
+	m := make(map[string]any, len(du.Attributes)+4)
+	for name, values := range du.Attributes {
+		if len(values) == 1 {
+			m[name] = values[0]
+		} else {
+			m[name] = values
+		}
+	}
+	// the standard fields take precedence over any attributes with the same name
+	m["id"] = userID
+	m["group_ids"] = groupIDs
+	m["display_name"] = du.DisplayName
+	m["email"] = du.Email
+	return newDirectoryRecord(directory.UserRecordType, userID, m)
+}
+
+func newDirectoryRecord(recordType, recordID string, m map[string]any) *databroker.Record {
+	return &databroker.Record{
+		Type: recordType,
+		Id:   recordID,
+		Data: protoutil.NewAny(protoutil.ToStruct(m).GetStructValue()),
+	}
+}
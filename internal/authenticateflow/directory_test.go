@@ -0,0 +1,105 @@
+package authenticateflow
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+
+	"github.com/pomerium/datasource/pkg/directory"
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/directory/ldap"
+	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker/mock_databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+func TestPopulateDirectoryFromLDAP_Error(t *testing.T) {
+	t.Parallel()
+
+	// nothing is listening on the port once the listener is closed
+	li, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := li.Addr().String()
+	require.NoError(t, li.Close())
+
+	ctrl := gomock.NewController(t)
+	client := mock_databroker.NewMockDataBrokerServiceClient(ctrl)
+	client.EXPECT().Put(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r *databroker.PutRequest, _ ...grpc.CallOption) (*databroker.PutResponse, error) {
+			require.Len(t, r.GetRecords(), 1)
+			record := r.GetRecord()
+			assert.Equal(t, directory.UserRecordType, record.GetType())
+			assert.Equal(t, "USER_ID", record.GetId())
+			assert.NotNil(t, record.GetDeletedAt(), "should delete the user's directory record")
+			return &databroker.PutResponse{Records: []*databroker.Record{{Version: 1234}}}, nil
+		})
+
+	recordVersion := populateDirectoryFromLDAP(context.Background(), client, &config.LDAPSettings{
+		URL: "ldap://" + addr,
+	}, &user.User{Id: "USER_ID", Email: "jane@example.com"}, map[string]any{"email_verified": true})
+	assert.Equal(t, uint64(1234), recordVersion)
+}
+
+func TestLDAPEmail(t *testing.T) {
+	t.Parallel()
+
+	u := &user.User{Id: "USER_ID", Email: "jane@example.com"}
+	for _, tc := range []struct {
+		name     string
+		settings config.LDAPSettings
+		claims   map[string]any
+		expect   string
+	}{
+		{"verified", config.LDAPSettings{}, map[string]any{"email_verified": true}, "jane@example.com"},
+		{"verified string", config.LDAPSettings{}, map[string]any{"email_verified": "true"}, "jane@example.com"},
+		{"unverified", config.LDAPSettings{}, map[string]any{"email_verified": false}, ""},
+		{"missing", config.LDAPSettings{}, map[string]any{}, ""},
+		{"allow unverified", config.LDAPSettings{AllowUnverifiedEmail: true}, map[string]any{}, "jane@example.com"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expect, ldapEmail(&tc.settings, u, tc.claims))
+		})
+	}
+}
+
+func TestNewDirectoryUserRecord(t *testing.T) {
+	t.Parallel()
+
+	record := newDirectoryUserRecord("USER_ID", &ldap.User{
+		DN:          "uid=jane,ou=people,dc=example,dc=com",
+		DisplayName: "Jane Doe",
+		Email:       "jane@example.com",
+		Groups: []directory.Group{
+			{ID: "admins", Name: "admins"},
+			{ID: "users", Name: "users"},
+		},
+		Attributes: map[string][]string{
+			"department": {"engineering"},
+			"email":      {"other@example.com"},
+			"locations":  {"a", "b"},
+		},
+	})
+	testutil.AssertProtoJSONEqual(t, `{
+		"type": "pomerium.io/DirectoryUser",
+		"id": "USER_ID",
+		"data": {
+			"@type": "type.googleapis.com/google.protobuf.Struct",
+			"value": {
+				"department": "engineering",
+				"display_name": "Jane Doe",
+				"email": "jane@example.com",
+				"group_ids": ["admins", "users"],
+				"id": "USER_ID",
+				"locations": ["a", "b"]
+			}
+		}
+	}`, record)
+}
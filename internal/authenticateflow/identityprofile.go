@@ -152,6 +152,7 @@ func populateSessionFromProfile(s *session.Session, p *identitypb.Profile, ss *s
 	_ = json.Unmarshal(p.GetOauthToken(), oauthToken)
 
 	s.UserId = ss.UserID()
+	s.IdpId = p.GetProviderId()
 	issuedAt := timeNow()
 	s.IssuedAt = timestamppb.New(issuedAt)
 	s.AccessedAt = timestamppb.New(issuedAt)
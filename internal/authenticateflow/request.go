@@ -1,11 +1,13 @@
 package authenticateflow
 
 import (
+	"encoding/base64"
 	"net/http"
 
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/middleware"
 	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
 )
 
 type signatureVerifier struct {
@@ -21,7 +23,43 @@ func (v signatureVerifier) VerifySignature(r *http.Request) error {
 // VerifyAuthenticateSignature checks that the provided request has a valid
 // signature (for the authenticate service).
 func (v signatureVerifier) VerifyAuthenticateSignature(r *http.Request) error {
-	return middleware.ValidateRequestURL(GetExternalAuthenticateRequest(r, v.options), v.sharedKey)
+	return middleware.ValidateRequestURL(stripUnsignedQueryParams(GetExternalAuthenticateRequest(r, v.options)), v.sharedKey)
+}
+
+// GetPKCEVerifier returns the PKCE code verifier for the state passed to the
+// identity provider. The verifier is derived from the state, which is bound
+// to the user's CSRF cookie, so it does not need to be stored between the
+// sign in redirect and the callback.
+//
+// https://datatracker.ietf.org/doc/html/rfc7636#section-4.1
+func (v signatureVerifier) GetPKCEVerifier(state string) string {
+	return base64.RawURLEncoding.EncodeToString(
+		cryptutil.GenerateHMAC([]byte("pkce|"+state), v.sharedKey))
+}
+
+// unsignedQueryParams may be added to a signed authenticate URL by the user,
+// so they are excluded when verifying the signature.
+var unsignedQueryParams = []string{
+	urlutil.QueryLoginHint,
+	urlutil.QuerySelectedIdentityProviderID,
+}
+
+func stripUnsignedQueryParams(r *http.Request) *http.Request {
+	q := r.URL.Query()
+	found := false
+	for _, k := range unsignedQueryParams {
+		if q.Has(k) {
+			q.Del(k)
+			found = true
+		}
+	}
+	if !found {
+		return r
+	}
+
+	r = r.Clone(r.Context())
+	r.URL.RawQuery = q.Encode()
+	return r
 }
 
 // GetExternalAuthenticateRequest canonicalizes an authenticate request URL
@@ -37,6 +37,23 @@ func TestVerifyAuthenticateSignature(t *testing.T) {
 		err := v.VerifyAuthenticateSignature(r)
 		assert.Error(t, err)
 	})
+	t.Run("UnsignedParams", func(t *testing.T) {
+		u := urlutil.NewSignedURL(key, mustParseURL("https://example.com/?pomerium_idp_id=IDP")).Sign()
+		q := u.Query()
+		q.Set(urlutil.QuerySelectedIdentityProviderID, "SELECTED")
+		q.Set(urlutil.QueryLoginHint, "user@example.com")
+		u.RawQuery = q.Encode()
+		r := &http.Request{Host: "example.com", URL: u}
+		err := v.VerifyAuthenticateSignature(r)
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECTED", r.URL.Query().Get(urlutil.QuerySelectedIdentityProviderID),
+			"should not modify the original request")
+
+		q.Set(urlutil.QueryIdentityProviderID, "OTHER")
+		u.RawQuery = q.Encode()
+		err = v.VerifyAuthenticateSignature(r)
+		assert.Error(t, err)
+	})
 	t.Run("InternalDomain", func(t *testing.T) {
 		// A request with the internal authenticate service URL should first be
 		// canonicalized to use the external authenticate service URL before
@@ -56,3 +73,14 @@ func mustParseURL(rawurl string) *url.URL {
 	}
 	return u
 }
+
+func TestGetPKCEVerifier(t *testing.T) {
+	key := []byte("SHARED KEY--(must be 32 bytes)--")
+	v := signatureVerifier{&config.Options{}, key}
+
+	verifier := v.GetPKCEVerifier("STATE")
+	assert.Regexp(t, `^[A-Za-z0-9_-]{43}$`, verifier, "should be a valid code verifier")
+	assert.Equal(t, verifier, v.GetPKCEVerifier("STATE"))
+	assert.NotEqual(t, verifier, v.GetPKCEVerifier("OTHER STATE"))
+	assert.NotEqual(t, verifier, signatureVerifier{&config.Options{}, make([]byte, 32)}.GetPKCEVerifier("STATE"))
+}
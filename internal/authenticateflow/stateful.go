@@ -117,7 +117,7 @@ func (s *Stateful) SignIn(
 	idpID := r.FormValue(urlutil.QueryIdentityProviderID)
 
 	// start over if this is a different identity provider
-	if sessionState == nil || sessionState.IdentityProviderID != idpID {
+	if sessionState == nil || !s.options.IsIdentityProviderAllowed(idpID, sessionState.IdentityProviderID) {
 		sessionState = sessions.NewState(idpID)
 	}
 
@@ -186,6 +186,7 @@ func (s *Stateful) PersistSession(
 		ExpiresAt:  sessionExpiry,
 		OauthToken: manager.ToOAuthToken(accessToken),
 		Audience:   sessionState.Audience,
+		IdpId:      sessionState.IdentityProviderID,
 	}
 	sess.SetRawIDToken(claims.RawIDToken)
 	sess.AddClaims(claims.Flatten())
@@ -202,6 +203,9 @@ func (s *Stateful) PersistSession(
 	if err != nil {
 		return fmt.Errorf("authenticate: error saving user: %w", err)
 	}
+	// the session is saved after the directory records, so its version is
+	// higher
+	populateDirectoryFromLDAP(ctx, s.dataBrokerClient, &s.options.LDAP, u, claims.Claims)
 
 	res, err := session.Put(ctx, s.dataBrokerClient, sess)
 	if err != nil {
@@ -333,6 +337,12 @@ func (s *Stateful) AuthenticateSignInURL(
 	return redirectTo, nil
 }
 
+// GetSignInRequestParams returns the params of a sign in request, which are
+// sent unencrypted in the stateful flow.
+func (s *Stateful) GetSignInRequestParams(vs url.Values) url.Values {
+	return vs
+}
+
 // GetIdentityProviderIDForURLValues returns the identity provider ID
 // associated with the given URL values.
 func (s *Stateful) GetIdentityProviderIDForURLValues(vs url.Values) string {
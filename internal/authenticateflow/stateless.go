@@ -184,7 +184,7 @@ func (s *Stateless) SignIn(
 	idpID := requestParams.Get(urlutil.QueryIdentityProviderID)
 
 	// start over if this is a different identity provider
-	if sessionState == nil || sessionState.IdentityProviderID != idpID {
+	if sessionState == nil || !s.options.IsIdentityProviderAllowed(idpID, sessionState.IdentityProviderID) {
 		sessionState = sessions.NewState(idpID)
 	}
 
@@ -281,6 +281,15 @@ func (s *Stateless) GetIdentityProviderIDForURLValues(vs url.Values) string {
 	return idpID
 }
 
+// GetSignInRequestParams returns the params of a sign in request, which are
+// encrypted by AuthenticateSignInURL.
+func (s *Stateless) GetSignInRequestParams(vs url.Values) url.Values {
+	if _, requestParams, err := hpke.DecryptURLValues(s.hpkePrivateKey, vs); err == nil {
+		return requestParams
+	}
+	return vs
+}
+
 // LogAuthenticateEvent logs an authenticate service event.
 func (s *Stateless) LogAuthenticateEvent(r *http.Request) {
 	s.logAuthenticateEvent(r, nil)
@@ -405,7 +414,8 @@ func (s *Stateless) Callback(w http.ResponseWriter, r *http.Request) error {
 	if err != nil {
 		u = &user.User{Id: ss.UserID()}
 	}
-	populateUserFromClaims(u, profile.GetClaims().AsMap())
+	claims := profile.GetClaims().AsMap()
+	populateUserFromClaims(u, claims)
 
 	redirectURI, err := getRedirectURIFromValues(values)
 	if err != nil {
@@ -422,6 +432,7 @@ func (s *Stateless) Callback(w http.ResponseWriter, r *http.Request) error {
 	if err != nil {
 		return httputil.NewError(http.StatusInternalServerError, fmt.Errorf("proxy: error saving databroker records: %w", err))
 	}
+	ss.DatabrokerRecordVersion = populateDirectoryFromLDAP(r.Context(), s.dataBrokerClient, &s.options.LDAP, u, claims)
 	ss.DatabrokerServerVersion = res.GetServerVersion()
 	for _, record := range res.GetRecords() {
 		if record.GetVersion() > ss.DatabrokerRecordVersion {
@@ -0,0 +1,54 @@
+package authenticateflow
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/hpke"
+)
+
+type stubKeyFetcher struct {
+	key *hpke.PublicKey
+}
+
+func (f stubKeyFetcher) FetchPublicKey(context.Context) (*hpke.PublicKey, error) {
+	return f.key, nil
+}
+
+func TestStatelessGetSignInRequestParams(t *testing.T) {
+	t.Parallel()
+
+	hpkePrivateKey, err := hpke.GeneratePrivateKey()
+	require.NoError(t, err)
+	flow := &Stateless{
+		hpkePrivateKey:         hpkePrivateKey,
+		authenticateKeyFetcher: stubKeyFetcher{hpkePrivateKey.PublicKey()},
+		authenticateURL:        &url.URL{Scheme: "https", Host: "authenticate.example.com"},
+	}
+
+	// the step-up params added by the authorize service are encrypted
+	u, err := flow.AuthenticateSignInURL(context.Background(), url.Values{
+		urlutil.QueryPrompt:    {"login"},
+		urlutil.QueryMaxAge:    {"900"},
+		urlutil.QueryACRValues: {"phr"},
+	}, &url.URL{Scheme: "https", Host: "example.com"}, "IDP")
+	require.NoError(t, err)
+	parsed, err := url.Parse(u)
+	require.NoError(t, err)
+	assert.Empty(t, parsed.Query().Get(urlutil.QueryPrompt))
+
+	params := flow.GetSignInRequestParams(parsed.Query())
+	assert.Equal(t, "login", params.Get(urlutil.QueryPrompt))
+	assert.Equal(t, "900", params.Get(urlutil.QueryMaxAge))
+	assert.Equal(t, "phr", params.Get(urlutil.QueryACRValues))
+	assert.Equal(t, "IDP", params.Get(urlutil.QueryIdentityProviderID))
+
+	// unencrypted params are returned as is
+	q := url.Values{urlutil.QueryPrompt: {"login"}}
+	assert.Equal(t, q, flow.GetSignInRequestParams(q))
+}
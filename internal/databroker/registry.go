@@ -104,7 +104,7 @@ func (srv *Server) newRegistryLocked(ctx context.Context, backend storage.Backen
 	}
 
 	switch srv.cfg.storageType {
-	case config.StorageInMemoryName:
+	case config.StorageInMemoryName, config.StorageRedisName, config.StorageFileName:
 		log.Ctx(ctx).Info().Msg("using in-memory registry")
 		return inmemory.New(ctx, srv.cfg.registryTTL), nil
 	}
@@ -20,8 +20,10 @@ import (
 	"github.com/pomerium/pomerium/internal/telemetry/trace"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 	"github.com/pomerium/pomerium/pkg/storage"
+	"github.com/pomerium/pomerium/pkg/storage/file"
 	"github.com/pomerium/pomerium/pkg/storage/inmemory"
 	"github.com/pomerium/pomerium/pkg/storage/postgres"
+	"github.com/pomerium/pomerium/pkg/storage/redis"
 )
 
 // Server implements the databroker service using an in memory database.
@@ -465,6 +467,12 @@ func (srv *Server) newBackendLocked(ctx context.Context) (storage.Backend, error
 		// to the lifetime of the server itself. 'ctx' may be a short-lived request
 		// context, since the backend is lazy-initialized.
 		return postgres.New(srv.backendCtx, srv.cfg.storageConnectionString), nil
+	case config.StorageRedisName:
+		log.Ctx(ctx).Info().Msg("initializing new redis store")
+		return redis.New(srv.backendCtx, srv.cfg.storageConnectionString), nil
+	case config.StorageFileName:
+		log.Ctx(ctx).Info().Msg("initializing new file store")
+		return file.New(srv.cfg.storageConnectionString)
 	default:
 		return nil, fmt.Errorf("unsupported storage type: %s", srv.cfg.storageType)
 	}
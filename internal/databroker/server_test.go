@@ -7,11 +7,13 @@ import (
 	"io"
 	"net"
 	"os"
+	"path/filepath"
 	"runtime"
 	"sort"
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/sync/errgroup"
@@ -26,6 +28,7 @@ import (
 
 	"github.com/pomerium/pomerium/internal/testutil"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	registrypb "github.com/pomerium/pomerium/pkg/grpc/registry"
 	"github.com/pomerium/pomerium/pkg/grpc/session"
 	"github.com/pomerium/pomerium/pkg/protoutil"
 )
@@ -410,3 +413,63 @@ func TestServerPostgres(t *testing.T) {
 		assert.NoError(t, eg.Wait())
 	})
 }
+
+func TestServerRedis(t *testing.T) {
+	t.Parallel()
+
+	mr := miniredis.RunT(t)
+	srv := newServer(&serverConfig{
+		storageType:             "redis",
+		storageConnectionString: "redis://" + mr.Addr(),
+	})
+
+	s := new(session.Session)
+	s.Id = "1"
+	data := protoutil.NewAny(s)
+	_, err := srv.Put(context.Background(), &databroker.PutRequest{
+		Records: []*databroker.Record{{
+			Type: data.TypeUrl,
+			Id:   s.Id,
+			Data: data,
+		}},
+	})
+	require.NoError(t, err)
+
+	res, err := srv.Get(context.Background(), &databroker.GetRequest{
+		Type: data.TypeUrl,
+		Id:   s.Id,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, s.Id, res.GetRecord().GetId())
+
+	_, err = srv.List(context.Background(), &registrypb.ListRequest{})
+	assert.NoError(t, err, "should fall back to the in-memory registry")
+}
+
+func TestServerFile(t *testing.T) {
+	t.Parallel()
+
+	srv := newServer(&serverConfig{
+		storageType:             "file",
+		storageConnectionString: filepath.Join(t.TempDir(), "databroker.db"),
+	})
+
+	s := new(session.Session)
+	s.Id = "1"
+	data := protoutil.NewAny(s)
+	_, err := srv.Put(context.Background(), &databroker.PutRequest{
+		Records: []*databroker.Record{{
+			Type: data.TypeUrl,
+			Id:   s.Id,
+			Data: data,
+		}},
+	})
+	require.NoError(t, err)
+
+	res, err := srv.Get(context.Background(), &databroker.GetRequest{
+		Type: data.TypeUrl,
+		Id:   s.Id,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, s.Id, res.GetRecord().GetId())
+}
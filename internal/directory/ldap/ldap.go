@@ -0,0 +1,233 @@
+// Package ldap looks up users and their group memberships in an LDAP directory.
+package ldap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+
+	"github.com/pomerium/datasource/pkg/directory"
+	"github.com/pomerium/pomerium/config"
+)
+
+const defaultTimeout = 10 * time.Second
+
+var (
+	// ErrUserNotFound indicates the user was not found in the directory.
+	ErrUserNotFound = errors.New("ldap: user not found")
+	// ErrEmailRequired indicates the user search filter uses the user's
+	// email, but no verified email was given.
+	ErrEmailRequired = errors.New("ldap: the user search filter requires a verified email")
+)
+
+// A User is a user found in the directory.
+type User struct {
+	DN          string
+	DisplayName string
+	Email       string
+	Groups      []directory.Group
+	// Attributes are the additional user attributes requested in the settings.
+	Attributes map[string][]string
+}
+
+// A Directory looks up users in an LDAP directory.
+type Directory struct {
+	settings *config.LDAPSettings
+}
+
+// New creates a new Directory.
+func New(settings *config.LDAPSettings) *Directory {
+	return &Directory{settings: settings}
+}
+
+// GetUser returns the user matching the given subject and email. The email
+// must have been verified by the identity provider. If it's empty, and the
+// user search filter uses it, ErrEmailRequired is returned.
+func (d *Directory) GetUser(ctx context.Context, subject, email string) (*User, error) {
+	userSearchFilter := d.settings.GetUserSearchFilter()
+	if email == "" && (strings.Contains(userSearchFilter, "{email}") || strings.Contains(userSearchFilter, "{username}")) {
+		return nil, ErrEmailRequired
+	}
+
+	conn, err := d.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	groupNameAttribute := d.settings.GetGroupNameAttribute()
+
+	attributes := []string{"cn", "displayName", "mail", "memberOf"}
+	attributes = append(attributes, d.settings.UserAttributes...)
+	res, err := conn.Search(goldap.NewSearchRequest(
+		d.settings.UserSearchBase,
+		goldap.ScopeWholeSubtree, goldap.NeverDerefAliases,
+		2, int(defaultTimeout.Seconds()), false,
+		expandFilter(userSearchFilter, map[string]string{
+			"email":    email,
+			"username": username(email),
+			"subject":  subject,
+		}),
+		attributes,
+		nil,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("ldap: error searching for user: %w", err)
+	}
+	switch len(res.Entries) {
+	case 0:
+		return nil, ErrUserNotFound
+	case 1:
+	default:
+		return nil, fmt.Errorf("ldap: multiple users found for %s", email)
+	}
+
+	entry := res.Entries[0]
+	u := &User{
+		DN:          entry.DN,
+		DisplayName: entry.GetAttributeValue("displayName"),
+		Email:       entry.GetAttributeValue("mail"),
+		Attributes:  make(map[string][]string, len(d.settings.UserAttributes)),
+	}
+	if u.DisplayName == "" {
+		u.DisplayName = entry.GetAttributeValue("cn")
+	}
+	for _, attribute := range d.settings.UserAttributes {
+		if values := entry.GetAttributeValues(attribute); len(values) > 0 {
+			u.Attributes[attribute] = values
+		}
+	}
+
+	if d.settings.GroupSearchBase == "" {
+		for _, dn := range entry.GetAttributeValues("memberOf") {
+			if name := groupNameFromDN(dn, groupNameAttribute); name != "" {
+				u.Groups = append(u.Groups, directory.Group{ID: name, Name: name})
+			}
+		}
+	} else {
+		u.Groups, err = d.getGroups(conn, u.DN, groupNameAttribute)
+		if err != nil {
+			return nil, err
+		}
+	}
+	slices.SortFunc(u.Groups, func(a, b directory.Group) int { return strings.Compare(a.ID, b.ID) })
+	u.Groups = slices.CompactFunc(u.Groups, func(a, b directory.Group) bool { return a.ID == b.ID })
+
+	return u, nil
+}
+
+func (d *Directory) getGroups(conn *goldap.Conn, userDN, groupNameAttribute string) ([]directory.Group, error) {
+	res, err := conn.SearchWithPaging(goldap.NewSearchRequest(
+		d.settings.GroupSearchBase,
+		goldap.ScopeWholeSubtree, goldap.NeverDerefAliases,
+		0, int(defaultTimeout.Seconds()), false,
+		expandFilter(d.settings.GetGroupSearchFilter(), map[string]string{
+			"dn": userDN,
+		}),
+		[]string{groupNameAttribute, "mail"},
+		nil,
+	), 500)
+	if err != nil {
+		return nil, fmt.Errorf("ldap: error searching for groups: %w", err)
+	}
+
+	groups := make([]directory.Group, 0, len(res.Entries))
+	for _, entry := range res.Entries {
+		name := entry.GetAttributeValue(groupNameAttribute)
+		if name == "" {
+			continue
+		}
+		groups = append(groups, directory.Group{
+			ID:    name,
+			Name:  name,
+			Email: entry.GetAttributeValue("mail"),
+		})
+	}
+	return groups, nil
+}
+
+func (d *Directory) connect(ctx context.Context) (*goldap.Conn, error) {
+	tlsConfig, err := d.settings.GetTLSConfig()
+	if err != nil {
+		return nil, fmt.Errorf("ldap: invalid tls config: %w", err)
+	}
+
+	dialer := &net.Dialer{Timeout: defaultTimeout}
+	if deadline, ok := ctx.Deadline(); ok {
+		dialer.Deadline = deadline
+	}
+	conn, err := goldap.DialURL(d.settings.URL,
+		goldap.DialWithDialer(dialer),
+		goldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, fmt.Errorf("ldap: error connecting to %s: %w", redactURL(d.settings.URL), err)
+	}
+	conn.SetTimeout(defaultTimeout)
+
+	if d.settings.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("ldap: error starting tls: %w", err)
+		}
+	}
+
+	if d.settings.BindDN != "" {
+		password, err := d.settings.GetBindPassword()
+		if err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("ldap: invalid bind password: %w", err)
+		}
+		if err := conn.Bind(d.settings.BindDN, password); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("ldap: error binding as %s: %w", d.settings.BindDN, err)
+		}
+	}
+
+	return conn, nil
+}
+
+// expandFilter replaces the {name} placeholders in the filter with the
+// escaped values.
+func expandFilter(filter string, values map[string]string) string {
+	args := make([]string, 0, len(values)*2)
+	for name, value := range values {
+		args = append(args, "{"+name+"}", goldap.EscapeFilter(value))
+	}
+	return strings.NewReplacer(args...).Replace(filter)
+}
+
+// groupNameFromDN returns the value of the named attribute in the first
+// relative distinguished name of the DN, or the first value if the attribute
+// isn't present.
+func groupNameFromDN(rawDN, attribute string) string {
+	dn, err := goldap.ParseDN(rawDN)
+	if err != nil || len(dn.RDNs) == 0 || len(dn.RDNs[0].Attributes) == 0 {
+		return ""
+	}
+	for _, a := range dn.RDNs[0].Attributes {
+		if strings.EqualFold(a.Type, attribute) {
+			return a.Value
+		}
+	}
+	return dn.RDNs[0].Attributes[0].Value
+}
+
+func username(email string) string {
+	name, _, _ := strings.Cut(email, "@")
+	return name
+}
+
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Redacted()
+}
@@ -0,0 +1,276 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/datasource/pkg/directory"
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestDirectory(t *testing.T) {
+	t.Parallel()
+
+	ctx, clearTimeout := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(clearTimeout)
+
+	srv := newTestServer(t, "cn=admin,dc=example,dc=com", "PASSWORD", []*goldap.Entry{
+		goldap.NewEntry("uid=jane,ou=people,dc=example,dc=com", map[string][]string{
+			"cn":         {"Jane Doe"},
+			"mail":       {"jane@example.com"},
+			"uid":        {"jane"},
+			"department": {"engineering"},
+			"memberOf": {
+				"cn=admins,ou=groups,dc=example,dc=com",
+				"cn=users,ou=groups,dc=example,dc=com",
+			},
+		}),
+		goldap.NewEntry("cn=admins,ou=groups,dc=example,dc=com", map[string][]string{
+			"cn":     {"admins"},
+			"mail":   {"admins@example.com"},
+			"member": {"uid=jane,ou=people,dc=example,dc=com"},
+		}),
+		goldap.NewEntry("cn=everyone,ou=groups,dc=example,dc=com", map[string][]string{
+			"cn":     {"everyone"},
+			"member": {"uid=jane,ou=people,dc=example,dc=com"},
+		}),
+	})
+
+	settings := config.LDAPSettings{
+		URL:            "ldap://" + srv.addr,
+		BindDN:         "cn=admin,dc=example,dc=com",
+		BindPassword:   "PASSWORD",
+		UserSearchBase: "ou=people,dc=example,dc=com",
+		UserAttributes: []string{"department", "uid"},
+	}
+
+	t.Run("member of", func(t *testing.T) {
+		t.Parallel()
+
+		u, err := New(&settings).GetUser(ctx, "SUBJECT", "jane@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, &User{
+			DN:          "uid=jane,ou=people,dc=example,dc=com",
+			DisplayName: "Jane Doe",
+			Email:       "jane@example.com",
+			Groups: []directory.Group{
+				{ID: "admins", Name: "admins"},
+				{ID: "users", Name: "users"},
+			},
+			Attributes: map[string][]string{
+				"department": {"engineering"},
+				"uid":        {"jane"},
+			},
+		}, u)
+	})
+	t.Run("group search", func(t *testing.T) {
+		t.Parallel()
+
+		settings := settings
+		settings.GroupSearchBase = "ou=groups,dc=example,dc=com"
+		settings.UserSearchFilter = "(uid={username})"
+		u, err := New(&settings).GetUser(ctx, "SUBJECT", "jane@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, []directory.Group{
+			{ID: "admins", Name: "admins", Email: "admins@example.com"},
+			{ID: "everyone", Name: "everyone"},
+		}, u.Groups)
+	})
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := New(&settings).GetUser(ctx, "SUBJECT", "john@example.com")
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+	t.Run("unverified email", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := New(&settings).GetUser(ctx, "jane", "")
+		assert.ErrorIs(t, err, ErrEmailRequired)
+
+		settings := settings
+		settings.UserSearchFilter = "(uid={subject})"
+		u, err := New(&settings).GetUser(ctx, "jane", "")
+		require.NoError(t, err)
+		assert.Equal(t, "jane@example.com", u.Email)
+	})
+	t.Run("escapes filter values", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := New(&settings).GetUser(ctx, "SUBJECT", "*")
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+	t.Run("invalid credentials", func(t *testing.T) {
+		t.Parallel()
+
+		settings := settings
+		settings.BindPassword = "WRONG"
+		_, err := New(&settings).GetUser(ctx, "SUBJECT", "jane@example.com")
+		assert.True(t, goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials), "error: %v", err)
+	})
+}
+
+func TestGroupNameFromDN(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "admins", groupNameFromDN("CN=admins,OU=Groups,DC=example,DC=com", "cn"))
+	assert.Equal(t, "admins", groupNameFromDN("ou=admins,dc=example,dc=com", "cn"))
+	assert.Equal(t, "", groupNameFromDN("not a dn", "cn"))
+}
+
+// testServer is a minimal in-process LDAP server supporting simple binds and
+// searches with equality filters.
+type testServer struct {
+	addr     string
+	bindDN   string
+	password string
+	entries  []*goldap.Entry
+}
+
+func newTestServer(t *testing.T, bindDN, password string, entries []*goldap.Entry) *testServer {
+	t.Helper()
+
+	li, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := &testServer{
+		addr:     li.Addr().String(),
+		bindDN:   bindDN,
+		password: password,
+		entries:  entries,
+	}
+
+	var wg sync.WaitGroup
+	t.Cleanup(func() {
+		li.Close()
+		wg.Wait()
+	})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			conn, err := li.Accept()
+			if err != nil {
+				return
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer conn.Close()
+				srv.serve(t, conn)
+			}()
+		}
+	}()
+
+	return srv
+}
+
+func (srv *testServer) serve(t *testing.T, conn net.Conn) {
+	bound := false
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) {
+			return
+		} else if !assert.NoError(t, err) {
+			return
+		}
+
+		messageID := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+		switch op.Tag {
+		case goldap.ApplicationBindRequest:
+			name := op.Children[1].Value.(string)
+			password := op.Children[2].Data.String()
+			if name == srv.bindDN && password == srv.password {
+				bound = true
+				srv.writeResult(conn, messageID, goldap.ApplicationBindResponse, goldap.LDAPResultSuccess)
+			} else {
+				srv.writeResult(conn, messageID, goldap.ApplicationBindResponse, goldap.LDAPResultInvalidCredentials)
+			}
+		case goldap.ApplicationUnbindRequest:
+			return
+		case goldap.ApplicationSearchRequest:
+			if !bound {
+				srv.writeResult(conn, messageID, goldap.ApplicationSearchResultDone, goldap.LDAPResultInsufficientAccessRights)
+				continue
+			}
+			baseDN := op.Children[0].Value.(string)
+			filter := op.Children[6]
+			var attributes []string
+			for _, a := range op.Children[7].Children {
+				attributes = append(attributes, a.Value.(string))
+			}
+			for _, entry := range srv.entries {
+				if strings.HasSuffix(strings.ToLower(entry.DN), ","+strings.ToLower(baseDN)) && matchesFilter(entry, filter) {
+					srv.writeEntry(conn, messageID, entry, attributes)
+				}
+			}
+			srv.writeResult(conn, messageID, goldap.ApplicationSearchResultDone, goldap.LDAPResultSuccess)
+		default:
+			t.Errorf("unsupported ldap operation: %d", op.Tag)
+			return
+		}
+	}
+}
+
+func (srv *testServer) writeEntry(w io.Writer, messageID int64, entry *goldap.Entry, attributes []string) {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.DN, "Object Name"))
+	attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for _, name := range attributes {
+		values := entry.GetEqualFoldAttributeValues(name)
+		if len(values) == 0 {
+			continue
+		}
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		vals := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, value := range values {
+			vals.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+		}
+		attr.AppendChild(vals)
+		attrs.AppendChild(attr)
+	}
+	op.AppendChild(attrs)
+	srv.write(w, messageID, op)
+}
+
+func (srv *testServer) writeResult(w io.Writer, messageID int64, tag ber.Tag, resultCode uint16) {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(resultCode), "Result Code"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	srv.write(w, messageID, op)
+}
+
+func (srv *testServer) write(w io.Writer, messageID int64, op *ber.Packet) {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "Message ID"))
+	packet.AppendChild(op)
+	_, _ = w.Write(packet.Bytes())
+}
+
+func matchesFilter(entry *goldap.Entry, filter *ber.Packet) bool {
+	if filter.Tag != goldap.FilterEqualityMatch {
+		return false
+	}
+	name := filter.Children[0].Data.String()
+	value := filter.Children[1].Data.String()
+	for _, v := range entry.GetEqualFoldAttributeValues(name) {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+// Package geoip contains functions for looking up the location of an IP
+// address using a MaxMind-format database.
+package geoip
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// A Database is a MaxMind-format database that can be used to look up the
+// country of an IP address.
+type Database struct {
+	reader *maxminddb.Reader
+}
+
+// Open reads the MaxMind-format database at the given path into memory.
+func Open(path string) (*Database, error) {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("geoip: error reading database: %w", err)
+	}
+
+	reader, err := maxminddb.FromBytes(bs)
+	if err != nil {
+		return nil, fmt.Errorf("geoip: invalid database: %w", err)
+	}
+
+	return &Database{reader: reader}, nil
+}
+
+type countryRecord struct {
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	RegisteredCountry struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"registered_country"`
+}
+
+// LookupCountry returns the ISO 3166-1 alpha-2 country code for the given IP
+// address. If the address is not found in the database, an empty string is
+// returned.
+func (db *Database) LookupCountry(rawIP string) (string, error) {
+	ip := net.ParseIP(rawIP)
+	if ip == nil {
+		return "", fmt.Errorf("geoip: invalid ip address: %q", rawIP)
+	}
+
+	var record countryRecord
+	err := db.reader.Lookup(ip, &record)
+	if err != nil {
+		return "", fmt.Errorf("geoip: error looking up ip address: %w", err)
+	}
+
+	if record.Country.ISOCode != "" {
+		return record.Country.ISOCode, nil
+	}
+	return record.RegisteredCountry.ISOCode, nil
+}
@@ -0,0 +1,35 @@
+package geoip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase(t *testing.T) {
+	t.Parallel()
+
+	db, err := Open("testdata/country.mmdb")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		ip      string
+		country string
+	}{
+		{"81.2.69.142", "GB"},
+		{"2001:db8:1::1", "DE"},
+		{"89.160.20.112", "SE"},
+		{"127.0.0.1", ""},
+	} {
+		country, err := db.LookupCountry(tc.ip)
+		assert.NoError(t, err, tc.ip)
+		assert.Equal(t, tc.country, country, tc.ip)
+	}
+
+	_, err = db.LookupCountry("not-an-ip")
+	assert.Error(t, err)
+
+	_, err = Open("testdata/missing.mmdb")
+	assert.Error(t, err)
+}
@@ -0,0 +1,317 @@
+// Package clientcert contains handlers for enrolling client certificates as devices.
+package clientcert
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/middleware"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/device"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/webauthnutil"
+	"github.com/pomerium/pomerium/ui"
+)
+
+var (
+	errMissingClientCertificate = httputil.NewError(http.StatusBadRequest, errors.New(
+		"a client certificate is required"))
+	errMissingDeviceCredentialID = httputil.NewError(http.StatusBadRequest, errors.New(
+		urlutil.QueryDeviceCredentialID+" is a required parameter"))
+	errMissingDeviceType = httputil.NewError(http.StatusBadRequest, errors.New(
+		urlutil.QueryDeviceType+" is a required parameter"))
+	errMissingRedirectURI = httputil.NewError(http.StatusBadRequest, errors.New(
+		urlutil.QueryRedirectURI+" is a required parameter"))
+	errInvalidDeviceCredential = httputil.NewError(http.StatusBadRequest, errors.New(
+		"invalid device credential"))
+	errInvalidDeviceType = httputil.NewError(http.StatusBadRequest, errors.New(
+		"device type does not support client certificates"))
+)
+
+// State is the state needed by the Handler to handle requests.
+type State struct {
+	AuthenticateURL         *url.URL
+	InternalAuthenticateURL *url.URL
+	Client                  databroker.DataBrokerServiceClient
+	Session                 *session.Session
+	SharedKey               []byte
+	BrandingOptions         httputil.BrandingOptions
+}
+
+// A StateProvider provides state for the handler.
+type StateProvider = func(*http.Request) (*State, error)
+
+// Handler is the client certificate device handler.
+//
+// The client certificate is the one presented to Envoy when the request was
+// made. It is passed to the handler by the authorize service, along with any
+// intermediate certificates, using the x-pomerium-client-certificate header.
+type Handler struct {
+	getState StateProvider
+}
+
+// New creates a new Handler.
+func New(getState StateProvider) *Handler {
+	return &Handler{
+		getState: getState,
+	}
+}
+
+// ServeHTTP serves the HTTP handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	httputil.HandlerFunc(h.handle).ServeHTTP(w, r)
+}
+
+func (h *Handler) handle(w http.ResponseWriter, r *http.Request) error {
+	s, err := h.getState(r)
+	if err != nil {
+		return err
+	}
+
+	err = middleware.ValidateRequestURL(
+		urlutil.GetExternalRequest(s.InternalAuthenticateURL, s.AuthenticateURL, r),
+		s.SharedKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case r.Method == http.MethodGet:
+		return h.handleView(w, r, s)
+	case r.FormValue("action") == "register":
+		return h.handleRegister(w, r, s)
+	case r.FormValue("action") == "unregister":
+		return h.handleUnregister(w, r, s)
+	}
+
+	return httputil.NewError(http.StatusNotFound, errors.New(http.StatusText(http.StatusNotFound)))
+}
+
+func (h *Handler) handleRegister(w http.ResponseWriter, r *http.Request, state *State) error {
+	ctx := r.Context()
+
+	deviceTypeParam := r.FormValue(urlutil.QueryDeviceType)
+	if deviceTypeParam == "" {
+		return errMissingDeviceType
+	}
+
+	redirectURIParam := r.FormValue(urlutil.QueryRedirectURI)
+	if redirectURIParam == "" {
+		return errMissingRedirectURI
+	}
+
+	cert, intermediates, err := getClientCertificate(r)
+	if err != nil {
+		return err
+	}
+
+	// get the stored device type
+	deviceType := webauthnutil.GetDeviceType(ctx, state.Client, deviceTypeParam)
+	err = verifyClientCertificate(deviceType, cert, intermediates)
+	if err != nil {
+		return err
+	}
+
+	// get the user information
+	u, err := user.Get(ctx, state.Client, state.Session.GetUserId())
+	if err != nil {
+		return fmt.Errorf("error retrieving user record: %w", err)
+	}
+
+	fingerprint := device.ClientCertificateFingerprint(cert.Raw)
+	deviceCredentialID := device.ClientCertificateCredentialID(deviceType.GetId(), fingerprint)
+
+	// a client certificate can only be enrolled once per device type
+	existing, err := device.GetCredential(ctx, state.Client, deviceCredentialID)
+	switch {
+	case status.Code(err) == codes.NotFound:
+	case err != nil:
+		return httputil.NewError(http.StatusInternalServerError,
+			fmt.Errorf("error retrieving device credential: %w", err))
+	case existing.GetUserId() != u.GetId():
+		return httputil.NewError(http.StatusForbidden, errors.New("client certificate is enrolled for another user"))
+	default:
+		httputil.Redirect(w, r, redirectURIParam, http.StatusFound)
+		return nil
+	}
+
+	deviceEnrollment, err := webauthnutil.GetOrCreateDeviceEnrollment(ctx, r, state.Client, state.SharedKey,
+		deviceType.GetId(), deviceCredentialID, u)
+	if err != nil {
+		return err
+	}
+
+	// save the credential
+	deviceCredential := &device.Credential{
+		Id:           deviceCredentialID,
+		TypeId:       deviceType.GetId(),
+		EnrollmentId: deviceEnrollment.GetId(),
+		UserId:       u.GetId(),
+		Specifier: &device.Credential_ClientCertificate_{
+			ClientCertificate: &device.Credential_ClientCertificate{
+				Fingerprint: fingerprint,
+				Certificate: cert.Raw,
+			},
+		},
+	}
+	err = device.PutCredential(ctx, state.Client, deviceCredential)
+	if err != nil {
+		return err
+	}
+
+	// save the user
+	u.AddDeviceCredentialID(deviceCredential.GetId())
+	_, err = databroker.Put(ctx, state.Client, u)
+	if err != nil {
+		return err
+	}
+
+	httputil.Redirect(w, r, redirectURIParam, http.StatusFound)
+	return nil
+}
+
+func (h *Handler) handleUnregister(w http.ResponseWriter, r *http.Request, state *State) error {
+	ctx := r.Context()
+
+	// get the user information
+	u, err := user.Get(ctx, state.Client, state.Session.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	deviceCredentialID := r.FormValue(urlutil.QueryDeviceCredentialID)
+	if deviceCredentialID == "" {
+		return errMissingDeviceCredentialID
+	}
+
+	// ensure we only allow removing a device credential the user owns
+	if !u.HasDeviceCredentialID(deviceCredentialID) {
+		return errInvalidDeviceCredential
+	}
+
+	// delete the credential
+	deviceCredential, err := device.DeleteCredential(ctx, state.Client, deviceCredentialID)
+	if err != nil {
+		return err
+	}
+
+	// delete the corresponding enrollment
+	_, err = device.DeleteEnrollment(ctx, state.Client, deviceCredential.GetEnrollmentId())
+	if err != nil {
+		return err
+	}
+
+	// remove the credential from the user
+	u.RemoveDeviceCredentialID(deviceCredentialID)
+	_, err = databroker.Put(ctx, state.Client, u)
+	if err != nil {
+		return err
+	}
+
+	httputil.Redirect(w, r, urlutil.GetAbsoluteURL(r).ResolveReference(&url.URL{
+		Path: "/.pomerium",
+	}).String(), http.StatusFound)
+	return nil
+}
+
+func (h *Handler) handleView(w http.ResponseWriter, r *http.Request, state *State) error {
+	deviceTypeParam := r.FormValue(urlutil.QueryDeviceType)
+	if deviceTypeParam == "" {
+		return errMissingDeviceType
+	}
+
+	m := map[string]any{
+		"selfUrl": r.URL.String(),
+	}
+	if cert, intermediates, err := getClientCertificate(r); err == nil {
+		fingerprint := device.ClientCertificateFingerprint(cert.Raw)
+		deviceType := webauthnutil.GetDeviceType(r.Context(), state.Client, deviceTypeParam)
+		deviceCredentialID := device.ClientCertificateCredentialID(deviceType.GetId(), fingerprint)
+		_, err := device.GetCredential(r.Context(), state.Client, deviceCredentialID)
+
+		m["certificate"] = map[string]any{
+			"subject":     cert.Subject.String(),
+			"issuer":      cert.Issuer.String(),
+			"notAfter":    cert.NotAfter,
+			"fingerprint": fingerprint,
+		}
+		m["enrolled"] = err == nil
+		m["supported"] = verifyClientCertificate(deviceType, cert, intermediates) == nil
+	}
+	httputil.AddBrandingOptionsToMap(m, state.BrandingOptions)
+	return ui.ServePage(w, r, "ClientCertificateRegistration", "Device Registration", m)
+}
+
+// getClientCertificate returns the client certificate passed by the authorize
+// service, and the intermediate certificates presented with it.
+func getClientCertificate(r *http.Request) (*x509.Certificate, *x509.CertPool, error) {
+	rawChain, err := url.QueryUnescape(r.Header.Get(httputil.HeaderPomeriumClientCertificate))
+	if err != nil || rawChain == "" {
+		return nil, nil, errMissingClientCertificate
+	}
+
+	block, rest := pem.Decode([]byte(rawChain))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, nil, errMissingClientCertificate
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, nil, errMissingClientCertificate
+	}
+
+	// the intermediates are unverified, they are only used to build a chain
+	// to the device type's certificate authority
+	intermediates := x509.NewCertPool()
+	intermediates.AppendCertsFromPEM(rest)
+	return cert, intermediates, nil
+}
+
+// verifyClientCertificate verifies that the client certificate may be
+// enrolled for the device type.
+func verifyClientCertificate(deviceType *device.Type, cert *x509.Certificate, intermediates *x509.CertPool) error {
+	specifier := deviceType.GetClientCertificate()
+	if specifier == nil {
+		return errInvalidDeviceType
+	}
+	if specifier.GetCa() == "" {
+		// any certificate may be enrolled, as long as it is currently valid
+		now := time.Now()
+		if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+			return httputil.NewError(http.StatusForbidden, fmt.Errorf("client certificate is not valid at %s: valid from %s until %s",
+				now.UTC().Format(time.RFC3339), cert.NotBefore.UTC().Format(time.RFC3339), cert.NotAfter.UTC().Format(time.RFC3339)))
+		}
+		return nil
+	}
+
+	ca, err := base64.StdEncoding.DecodeString(specifier.GetCa())
+	if err != nil {
+		return fmt.Errorf("invalid device type certificate authority: %w", err)
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(ca) {
+		return errors.New("invalid device type certificate authority: no certificates found")
+	}
+
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return httputil.NewError(http.StatusForbidden, fmt.Errorf("client certificate not allowed for device type: %w", err))
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package clientcert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/pkg/grpc/device"
+)
+
+func TestGetClientCertificate(t *testing.T) {
+	t.Parallel()
+
+	caKey, ca := newCertificate(t, nil, nil)
+	intermediateKey, intermediate := newCertificate(t, caKey, ca, asCA)
+	_, leaf := newCertificate(t, intermediateKey, intermediate)
+
+	r, _ := http.NewRequest(http.MethodGet, "https://example.com/.pomerium/client-certificate", nil)
+	_, _, err := getClientCertificate(r)
+	assert.ErrorIs(t, err, errMissingClientCertificate)
+
+	r.Header.Set(httputil.HeaderPomeriumClientCertificate, url.QueryEscape(encodePEM(leaf)+encodePEM(intermediate)))
+	cert, intermediates, err := getClientCertificate(r)
+	assert.NoError(t, err)
+	assert.Equal(t, leaf.Raw, cert.Raw)
+	assert.True(t, intermediates.Equal(newCertPool(intermediate)))
+}
+
+func TestVerifyClientCertificate(t *testing.T) {
+	t.Parallel()
+
+	caKey, ca := newCertificate(t, nil, nil)
+	_, leaf := newCertificate(t, caKey, ca)
+	_, otherLeaf := newCertificate(t, nil, nil)
+	intermediateKey, intermediate := newCertificate(t, caKey, ca, asCA)
+	_, intermediateLeaf := newCertificate(t, intermediateKey, intermediate)
+	_, expiredLeaf := newCertificate(t, nil, nil, func(tmpl *x509.Certificate) {
+		tmpl.NotAfter = time.Now().Add(-time.Minute)
+	})
+	_, futureLeaf := newCertificate(t, nil, nil, func(tmpl *x509.Certificate) {
+		tmpl.NotBefore = time.Now().Add(time.Minute)
+	})
+	noIntermediates := x509.NewCertPool()
+
+	webauthnType := &device.Type{Specifier: &device.Type_Webauthn{Webauthn: &device.Type_WebAuthn{}}}
+	anyType := &device.Type{Specifier: &device.Type_ClientCertificate_{
+		ClientCertificate: &device.Type_ClientCertificate{},
+	}}
+	caType := &device.Type{Specifier: &device.Type_ClientCertificate_{
+		ClientCertificate: &device.Type_ClientCertificate{
+			Ca: base64.StdEncoding.EncodeToString([]byte(encodePEM(ca))),
+		},
+	}}
+
+	assert.ErrorIs(t, verifyClientCertificate(webauthnType, leaf, noIntermediates), errInvalidDeviceType)
+	assert.NoError(t, verifyClientCertificate(anyType, otherLeaf, noIntermediates))
+	assert.Error(t, verifyClientCertificate(anyType, expiredLeaf, noIntermediates), "should reject expired certificates")
+	assert.Error(t, verifyClientCertificate(anyType, futureLeaf, noIntermediates), "should reject certificates that aren't valid yet")
+	assert.NoError(t, verifyClientCertificate(caType, leaf, noIntermediates))
+	assert.Error(t, verifyClientCertificate(caType, otherLeaf, noIntermediates))
+	assert.Error(t, verifyClientCertificate(caType, intermediateLeaf, noIntermediates))
+	assert.NoError(t, verifyClientCertificate(caType, intermediateLeaf, newCertPool(intermediate)),
+		"should build a chain using the presented intermediates")
+}
+
+// newCertificate creates a certificate signed by the parent, or a self-signed
+// CA certificate if there is no parent. The template may be modified by the
+// given functions.
+func newCertificate(
+	t *testing.T, parentKey *ecdsa.PrivateKey, parent *x509.Certificate, modify ...func(*x509.Certificate),
+) (*ecdsa.PrivateKey, *x509.Certificate) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Device"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, f := range modify {
+		f(tmpl)
+	}
+	if parent == nil {
+		asCA(tmpl)
+		parent, parentKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return key, cert
+}
+
+func asCA(tmpl *x509.Certificate) {
+	tmpl.IsCA = true
+	tmpl.BasicConstraintsValid = true
+	tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+}
+
+func newCertPool(certs ...*x509.Certificate) *x509.CertPool {
+	pool := x509.NewCertPool()
+	for _, cert := range certs {
+		pool.AddCert(cert)
+	}
+	return pool
+}
+
+func encodePEM(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/ui"
+)
+
+// SelectIdentityProviderOption is an identity provider a user can choose to sign in with.
+type SelectIdentityProviderOption struct {
+	Name string
+	URL  string
+}
+
+// SelectIdentityProviderData is the data for the SelectIdentityProvider page.
+type SelectIdentityProviderData struct {
+	IdentityProviders []SelectIdentityProviderOption
+	BrandingOptions   httputil.BrandingOptions
+}
+
+// ToJSON converts the data into a JSON map.
+func (data SelectIdentityProviderData) ToJSON() map[string]any {
+	idps := make([]map[string]any, 0, len(data.IdentityProviders))
+	for _, idp := range data.IdentityProviders {
+		idps = append(idps, map[string]any{
+			"name": idp.Name,
+			"url":  idp.URL,
+		})
+	}
+	m := map[string]any{
+		"identityProviders": idps,
+	}
+	httputil.AddBrandingOptionsToMap(m, data.BrandingOptions)
+	return m
+}
+
+// SelectIdentityProvider returns a handler that renders the identity provider selection page.
+func SelectIdentityProvider(data SelectIdentityProviderData) http.Handler {
+	return httputil.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		return ui.ServePage(w, r, "SelectIdentityProvider", "Select Identity Provider", data.ToJSON())
+	})
+}
@@ -10,11 +10,9 @@ import (
 	"net/http"
 	"net/url"
 
-	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/pomerium/pomerium/internal/httputil"
 	"github.com/pomerium/pomerium/internal/middleware"
@@ -256,10 +254,6 @@ func (h *Handler) handleRegister(w http.ResponseWriter, r *http.Request, state *
 	if err != nil {
 		return httputil.NewError(http.StatusBadRequest, errors.New("invalid register response"))
 	}
-	credentialJSON, err := json.Marshal(credential)
-	if err != nil {
-		return err
-	}
 
 	// get the user information
 	u, err := user.Get(ctx, state.Client, state.Session.GetUserId())
@@ -270,61 +264,8 @@ func (h *Handler) handleRegister(w http.ResponseWriter, r *http.Request, state *
 	// get the stored device type
 	deviceType := webauthnutil.GetDeviceType(ctx, state.Client, deviceTypeParam)
 
-	creationOptions, err := webauthnutil.GetCreationOptionsForCredential(
-		r,
-		state.SharedKey,
-		deviceType,
-		u,
-		&credential,
-	)
-	if err != nil {
-		return httputil.NewError(http.StatusBadRequest, fmt.Errorf("invalid register options: %w", err))
-	}
-	creationOptionsJSON, err := json.Marshal(creationOptions)
-	if err != nil {
-		return err
-	}
-
-	serverCredential, err := state.RelyingParty.VerifyRegistrationCeremony(
-		ctx,
-		creationOptions,
-		&credential,
-	)
-	if err != nil {
-		return httputil.NewError(http.StatusBadRequest, fmt.Errorf("error verifying registration: %w", err))
-	}
-
-	deviceCredentialID := webauthnutil.GetDeviceCredentialID(serverCredential.ID)
-
-	deviceEnrollment, err := getOrCreateDeviceEnrollment(ctx, r, state, deviceType.GetId(), deviceCredentialID, u)
-	if err != nil {
-		return err
-	}
-
-	// save the credential
-	deviceCredential := &device.Credential{
-		Id:           deviceCredentialID,
-		TypeId:       deviceType.GetId(),
-		EnrollmentId: deviceEnrollment.GetId(),
-		UserId:       u.GetId(),
-		Specifier: &device.Credential_Webauthn{
-			Webauthn: &device.Credential_WebAuthn{
-				Id:        serverCredential.ID,
-				PublicKey: serverCredential.PublicKey,
-
-				RegisterOptions:  creationOptionsJSON,
-				RegisterResponse: credentialJSON,
-			},
-		},
-	}
-	err = device.PutCredential(ctx, state.Client, deviceCredential)
-	if err != nil {
-		return err
-	}
-
-	// save the user
-	u.AddDeviceCredentialID(deviceCredential.GetId())
-	_, err = databroker.Put(ctx, state.Client, u)
+	deviceCredential, err := webauthnutil.RegisterDeviceCredential(ctx, r, state.Client, state.RelyingParty,
+		state.SharedKey, deviceType, u, &credential)
 	if err != nil {
 		return err
 	}
@@ -333,7 +274,7 @@ func (h *Handler) handleRegister(w http.ResponseWriter, r *http.Request, state *
 	state.Session.DeviceCredentials = append(state.Session.DeviceCredentials, &session.Session_DeviceCredential{
 		TypeId: deviceType.GetId(),
 		Credential: &session.Session_DeviceCredential_Id{
-			Id: webauthnutil.GetDeviceCredentialID(serverCredential.ID),
+			Id: deviceCredential.GetId(),
 		},
 	})
 
@@ -448,58 +389,3 @@ func getKnownDeviceCredentials(
 	}
 	return knownDeviceCredentials, nil
 }
-
-func getOrCreateDeviceEnrollment(
-	ctx context.Context,
-	r *http.Request,
-	state *State,
-	deviceTypeID string,
-	deviceCredentialID string,
-	u *user.User,
-) (*device.Enrollment, error) {
-	var deviceEnrollment *device.Enrollment
-
-	enrollmentTokenParam := r.FormValue(urlutil.QueryEnrollmentToken)
-	if enrollmentTokenParam == "" {
-		// create a new enrollment
-		deviceEnrollment = &device.Enrollment{
-			Id:     uuid.New().String(),
-			TypeId: deviceTypeID,
-			UserId: u.GetId(),
-		}
-	} else {
-		// use an existing enrollment
-		deviceEnrollmentID, err := webauthnutil.ParseAndVerifyEnrollmentToken(state.SharedKey, enrollmentTokenParam)
-		if err != nil {
-			return nil, httputil.NewError(http.StatusBadRequest, fmt.Errorf("invalid enrollment token: %w", err))
-		}
-
-		deviceEnrollment, err = device.GetEnrollment(ctx, state.Client, deviceEnrollmentID)
-		if err != nil {
-			return nil, err
-		}
-
-		if deviceEnrollment.GetTypeId() != deviceTypeID {
-			return nil, httputil.NewError(http.StatusForbidden, fmt.Errorf("invalid enrollment token: wrong device type"))
-		}
-
-		if deviceEnrollment.GetUserId() != u.GetId() {
-			return nil, httputil.NewError(http.StatusForbidden, fmt.Errorf("invalid enrollment token: wrong user id"))
-		}
-
-		if deviceEnrollment.GetEnrolledAt().IsValid() {
-			return nil, httputil.NewError(http.StatusForbidden, fmt.Errorf("invalid enrollment token: already used for existing credential"))
-		}
-	}
-
-	deviceEnrollment.CredentialId = deviceCredentialID
-	deviceEnrollment.EnrolledAt = timestamppb.Now()
-	deviceEnrollment.UserAgent = r.UserAgent()
-	deviceEnrollment.IpAddress = httputil.GetClientIPAddress(r)
-
-	err := device.PutEnrollment(ctx, state.Client, deviceEnrollment)
-	if err != nil {
-		return nil, err
-	}
-	return deviceEnrollment, nil
-}
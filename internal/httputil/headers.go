@@ -30,6 +30,10 @@ const (
 	HeaderPomeriumReproxyPolicy = "x-pomerium-reproxy-policy"
 	// HeaderPomeriumReproxyPolicyHMAC is an HMAC of the HeaderPomeriumReproxyPolicy header.
 	HeaderPomeriumReproxyPolicyHMAC = "x-pomerium-reproxy-policy-hmac"
+	// HeaderPomeriumClientCertificate is the header key containing the URL-escaped PEM client
+	// certificate chain presented to Envoy, starting with the leaf certificate. It is only set
+	// for requests to Pomerium's own endpoints.
+	HeaderPomeriumClientCertificate = "x-pomerium-client-certificate"
 	// HeaderPomeriumRoutingKey is a string used for routing user requests to a consistent upstream server.
 	HeaderPomeriumRoutingKey = "x-pomerium-routing-key"
 )
@@ -0,0 +1,454 @@
+package scim
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// A filter is a parsed SCIM filter expression (RFC 7644 §3.4.2.2).
+type filter interface {
+	matches(resource map[string]any) bool
+}
+
+type (
+	andFilter struct{ left, right filter }
+	orFilter  struct{ left, right filter }
+	notFilter struct{ filter filter }
+	// presentFilter matches resources with a non-empty value for the attribute.
+	presentFilter struct{ attr attrPath }
+	// compareFilter compares an attribute to a value.
+	compareFilter struct {
+		attr  attrPath
+		op    string
+		value any
+	}
+	// valuePathFilter matches resources with a multi-valued attribute
+	// containing a value matching the filter, e.g. emails[type eq "work"].
+	valuePathFilter struct {
+		attr   attrPath
+		filter filter
+	}
+)
+
+func (f andFilter) matches(resource map[string]any) bool {
+	return f.left.matches(resource) && f.right.matches(resource)
+}
+
+func (f orFilter) matches(resource map[string]any) bool {
+	return f.left.matches(resource) || f.right.matches(resource)
+}
+
+func (f notFilter) matches(resource map[string]any) bool {
+	return !f.filter.matches(resource)
+}
+
+func (f presentFilter) matches(resource map[string]any) bool {
+	for _, v := range f.attr.values(resource) {
+		switch v := v.(type) {
+		case nil:
+		case string:
+			if v != "" {
+				return true
+			}
+		case []any:
+			if len(v) > 0 {
+				return true
+			}
+		case map[string]any:
+			if len(v) > 0 {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+func (f compareFilter) matches(resource map[string]any) bool {
+	for _, v := range f.attr.values(resource) {
+		if compareValues(v, f.op, f.value, f.attr.isCaseExact()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f valuePathFilter) matches(resource map[string]any) bool {
+	for _, v := range f.attr.values(resource) {
+		if m, ok := v.(map[string]any); ok && f.filter.matches(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// An attrPath is an attribute name with an optional sub-attribute name, e.g.
+// name.familyName.
+type attrPath struct {
+	name    string
+	subAttr string
+}
+
+func parseAttrPath(raw string) attrPath {
+	// attributes may be prefixed with their schema URN, e.g.
+	// urn:ietf:params:scim:schemas:core:2.0:User:userName. Core attributes
+	// are stored at the top level of the resource and extension attributes
+	// under the extension's URN.
+	if strings.HasPrefix(strings.ToLower(raw), "urn:") {
+		idx := strings.LastIndex(raw, ":")
+		schema, name := raw[:idx], raw[idx+1:]
+		if strings.EqualFold(schema, UserSchema) || strings.EqualFold(schema, GroupSchema) {
+			raw = name
+		} else {
+			return attrPath{name: schema, subAttr: name}
+		}
+	}
+	name, subAttr, _ := strings.Cut(raw, ".")
+	return attrPath{name: name, subAttr: subAttr}
+}
+
+// values returns the values of the attribute in the resource. Multi-valued
+// attributes are flattened.
+func (p attrPath) values(resource map[string]any) []any {
+	v, ok := lookup(resource, p.name)
+	if !ok {
+		return nil
+	}
+
+	var values []any
+	if vs, ok := v.([]any); ok {
+		values = vs
+	} else {
+		values = []any{v}
+	}
+
+	if p.subAttr == "" {
+		return values
+	}
+
+	var subValues []any
+	for _, v := range values {
+		if m, ok := v.(map[string]any); ok {
+			if sv, ok := lookup(m, p.subAttr); ok {
+				subValues = append(subValues, sv)
+			}
+		}
+	}
+	return subValues
+}
+
+func (p attrPath) isCaseExact() bool {
+	return p.subAttr == "" && (strings.EqualFold(p.name, "id") || strings.EqualFold(p.name, "externalId"))
+}
+
+func compareValues(actual any, op string, expected any, caseExact bool) bool {
+	switch expected := expected.(type) {
+	case nil:
+		switch op {
+		case "eq":
+			return actual == nil
+		case "ne":
+			return actual != nil
+		}
+	case bool:
+		actual, ok := actual.(bool)
+		if !ok {
+			return false
+		}
+		switch op {
+		case "eq":
+			return actual == expected
+		case "ne":
+			return actual != expected
+		}
+	case float64:
+		actual, ok := actual.(float64)
+		if !ok {
+			return false
+		}
+		switch op {
+		case "eq":
+			return actual == expected
+		case "ne":
+			return actual != expected
+		case "gt":
+			return actual > expected
+		case "ge":
+			return actual >= expected
+		case "lt":
+			return actual < expected
+		case "le":
+			return actual <= expected
+		}
+	case string:
+		actual, ok := actual.(string)
+		if !ok {
+			return false
+		}
+		if !caseExact {
+			actual, expected = strings.ToLower(actual), strings.ToLower(expected)
+		}
+		switch op {
+		case "eq":
+			return actual == expected
+		case "ne":
+			return actual != expected
+		case "co":
+			return strings.Contains(actual, expected)
+		case "sw":
+			return strings.HasPrefix(actual, expected)
+		case "ew":
+			return strings.HasSuffix(actual, expected)
+		case "gt":
+			return actual > expected
+		case "ge":
+			return actual >= expected
+		case "lt":
+			return actual < expected
+		case "le":
+			return actual <= expected
+		}
+	}
+	return false
+}
+
+// parseFilter parses a SCIM filter expression.
+func parseFilter(raw string) (filter, error) {
+	p := &filterParser{tokens: tokenizeFilter(raw)}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok, ok := p.peek(); ok {
+		return nil, fmt.Errorf("unexpected token: %q", tok)
+	}
+	return f, nil
+}
+
+type filterParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *filterParser) peek() (string, bool) {
+	if p.pos >= len(p.tokens) {
+		return "", false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *filterParser) next() (string, bool) {
+	tok, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+	return tok, ok
+}
+
+func (p *filterParser) expect(expected string) error {
+	tok, ok := p.next()
+	if !ok {
+		return fmt.Errorf("expected %q, got end of filter", expected)
+	} else if tok != expected {
+		return fmt.Errorf("expected %q, got %q", expected, tok)
+	}
+	return nil
+}
+
+func (p *filterParser) parseOr() (filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok, ok := p.peek()
+		if !ok || !strings.EqualFold(tok, "or") {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orFilter{left, right}
+	}
+}
+
+func (p *filterParser) parseAnd() (filter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok, ok := p.peek()
+		if !ok || !strings.EqualFold(tok, "and") {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andFilter{left, right}
+	}
+}
+
+func (p *filterParser) parseUnary() (filter, error) {
+	tok, ok := p.next()
+	if !ok {
+		return nil, fmt.Errorf("unexpected end of filter")
+	}
+
+	switch {
+	case tok == "(":
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return f, p.expect(")")
+	case strings.EqualFold(tok, "not"):
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return notFilter{f}, p.expect(")")
+	case !isAttrName(tok):
+		return nil, fmt.Errorf("expected attribute name, got %q", tok)
+	}
+
+	attr := parseAttrPath(tok)
+
+	if next, ok := p.peek(); ok && next == "[" {
+		p.pos++
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		return valuePathFilter{attr: attr, filter: f}, nil
+	}
+
+	op, ok := p.next()
+	if !ok {
+		return nil, fmt.Errorf("expected operator after %q", tok)
+	}
+	op = strings.ToLower(op)
+	switch op {
+	case "pr":
+		return presentFilter{attr: attr}, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, fmt.Errorf("unsupported operator: %q", op)
+	}
+
+	rawValue, ok := p.next()
+	if !ok {
+		return nil, fmt.Errorf("expected value after %q", op)
+	}
+	value, err := parseFilterValue(rawValue)
+	if err != nil {
+		return nil, err
+	}
+	return compareFilter{attr: attr, op: op, value: value}, nil
+}
+
+func parseFilterValue(raw string) (any, error) {
+	switch {
+	case strings.HasPrefix(raw, `"`):
+		s, err := strconv.Unquote(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid string value: %s", raw)
+		}
+		return s, nil
+	case raw == "true":
+		return true, nil
+	case raw == "false":
+		return false, nil
+	case raw == "null":
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value: %s", raw)
+	}
+	return f, nil
+}
+
+func isAttrName(tok string) bool {
+	if tok == "" {
+		return false
+	}
+	r := rune(tok[0])
+	return unicode.IsLetter(r) || r == '$'
+}
+
+// tokenizeFilter splits a filter into tokens. Quoted strings are returned
+// with their quotes.
+func tokenizeFilter(raw string) []string {
+	var tokens []string
+	for i := 0; i < len(raw); {
+		c := raw[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')' || c == '[' || c == ']':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '"':
+			j := i + 1
+			for j < len(raw) && raw[j] != '"' {
+				if raw[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			j = min(j+1, len(raw))
+			tokens = append(tokens, raw[i:j])
+			i = j
+		default:
+			j := i
+			for j < len(raw) && !strings.ContainsRune(" \t\n\r()[]\"", rune(raw[j])) {
+				j++
+			}
+			tokens = append(tokens, raw[i:j])
+			i = j
+		}
+	}
+	return tokens
+}
+
+// lookup returns the value of the attribute, matching the name
+// case-insensitively as attribute names in SCIM are case-insensitive.
+func lookup(m map[string]any, name string) (any, bool) {
+	if v, ok := m[name]; ok {
+		return v, true
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// keyFor returns the key used for the attribute in the map, or name if the
+// attribute isn't set.
+func keyFor(m map[string]any, name string) string {
+	if _, ok := m[name]; ok {
+		return name
+	}
+	for k := range m {
+		if strings.EqualFold(k, name) {
+			return k
+		}
+	}
+	return name
+}
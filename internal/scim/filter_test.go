@@ -0,0 +1,78 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	resource := map[string]any{
+		"id":         "U1",
+		"externalId": "abc",
+		"userName":   "Jane@Example.com",
+		"active":     true,
+		"name": map[string]any{
+			"givenName":  "Jane",
+			"familyName": "Doe",
+		},
+		"emails": []any{
+			map[string]any{"type": "work", "value": "jane@example.com", "primary": true},
+			map[string]any{"type": "home", "value": "jane@home.example.com"},
+		},
+		"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": map[string]any{
+			"department": "engineering",
+		},
+	}
+
+	for _, tc := range []struct {
+		filter string
+		expect bool
+	}{
+		{`userName eq "jane@example.com"`, true},
+		{`USERNAME EQ "JANE@EXAMPLE.COM"`, true},
+		{`userName eq "john@example.com"`, false},
+		{`userName ne "john@example.com"`, true},
+		{`externalId eq "ABC"`, false},
+		{`externalId eq "abc"`, true},
+		{`userName sw "jane"`, true},
+		{`userName ew "example.com"`, true},
+		{`userName co "@"`, true},
+		{`active eq true`, true},
+		{`active eq false`, false},
+		{`title pr`, false},
+		{`name.familyName eq "Doe"`, true},
+		{`emails.value eq "jane@home.example.com"`, true},
+		{`emails[type eq "work" and value co "example.com"]`, true},
+		{`emails[type eq "other"]`, false},
+		{`urn:ietf:params:scim:schemas:core:2.0:User:userName eq "jane@example.com"`, true},
+		{`urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department eq "engineering"`, true},
+		{`userName eq "john@example.com" or name.givenName eq "Jane"`, true},
+		{`userName eq "john@example.com" or (name.givenName eq "Jane" and active eq false)`, false},
+		{`not (userName eq "john@example.com")`, true},
+	} {
+		f, err := parseFilter(tc.filter)
+		require.NoError(t, err, tc.filter)
+		assert.Equal(t, tc.expect, f.matches(resource), tc.filter)
+	}
+}
+
+func TestParseFilterErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, filter := range []string{
+		`userName`,
+		`userName eq`,
+		`userName xx "jane"`,
+		`userName eq jane`,
+		`(userName eq "jane"`,
+		`userName eq "jane" extra`,
+		`"jane" eq userName`,
+	} {
+		_, err := parseFilter(filter)
+		assert.Error(t, err, filter)
+	}
+}
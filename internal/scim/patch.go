@@ -0,0 +1,288 @@
+package scim
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// A patchRequest is a SCIM PATCH request (RFC 7644 §3.5.2).
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+// A patchPath is the target of a PATCH operation, e.g.
+// emails[type eq "work"].value.
+type patchPath struct {
+	attrPath
+	filter filter
+}
+
+func parsePatchPath(raw string) (patchPath, error) {
+	start := strings.IndexByte(raw, '[')
+	if start < 0 {
+		return patchPath{attrPath: parseAttrPath(raw)}, nil
+	}
+
+	end := strings.LastIndexByte(raw, ']')
+	if end < start {
+		return patchPath{}, fmt.Errorf("invalid path: %s", raw)
+	}
+
+	f, err := parseFilter(raw[start+1 : end])
+	if err != nil {
+		return patchPath{}, fmt.Errorf("invalid path filter: %w", err)
+	}
+
+	p := patchPath{attrPath: parseAttrPath(raw[:start]), filter: f}
+	if rest := raw[end+1:]; rest != "" {
+		if !strings.HasPrefix(rest, ".") || p.subAttr != "" {
+			return patchPath{}, fmt.Errorf("invalid path: %s", raw)
+		}
+		p.subAttr = rest[1:]
+	}
+	return p, nil
+}
+
+// applyPatch applies the PATCH operations to the resource.
+func applyPatch(resource map[string]any, req *patchRequest) error {
+	if !containsFold(req.Schemas, PatchOpSchema) {
+		return newError(http.StatusBadRequest, scimTypeInvalidSyntax, "missing PatchOp schema")
+	}
+	for _, op := range req.Operations {
+		if err := applyPatchOperation(resource, op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyPatchOperation(resource map[string]any, op patchOperation) error {
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+		if op.Path == "" {
+			values, ok := op.Value.(map[string]any)
+			if !ok {
+				return newError(http.StatusBadRequest, scimTypeInvalidValue, "value must be an object when no path is specified")
+			}
+			for name, value := range values {
+				// extension attributes are nested under the extension URN
+				if m, ok := value.(map[string]any); ok && strings.HasPrefix(strings.ToLower(name), "urn:") {
+					key := keyFor(resource, name)
+					existing, _ := resource[key].(map[string]any)
+					resource[key] = mergeObjects(existing, m)
+					continue
+				}
+				err := applyPatchOperation(resource, patchOperation{Op: op.Op, Path: name, Value: value})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		p, err := parsePatchPath(op.Path)
+		if err != nil {
+			return newError(http.StatusBadRequest, scimTypeInvalidPath, err.Error())
+		}
+		return setPatchValue(resource, p, op.Value, strings.EqualFold(op.Op, "add"))
+	case "remove":
+		if op.Path == "" {
+			return newError(http.StatusBadRequest, scimTypeNoTarget, "path is required for remove operations")
+		}
+
+		p, err := parsePatchPath(op.Path)
+		if err != nil {
+			return newError(http.StatusBadRequest, scimTypeInvalidPath, err.Error())
+		}
+		removePatchValue(resource, p, op.Value)
+		return nil
+	default:
+		return newError(http.StatusBadRequest, scimTypeInvalidSyntax, fmt.Sprintf("unsupported operation: %q", op.Op))
+	}
+}
+
+func setPatchValue(resource map[string]any, p patchPath, value any, add bool) error {
+	key := keyFor(resource, p.name)
+
+	if p.filter == nil {
+		if p.subAttr != "" {
+			obj, _ := resource[key].(map[string]any)
+			if obj == nil {
+				obj = make(map[string]any)
+			}
+			obj[keyFor(obj, p.subAttr)] = value
+			resource[key] = obj
+			return nil
+		}
+
+		switch existing := resource[key].(type) {
+		case []any:
+			if add {
+				resource[key] = appendValues(existing, value)
+				return nil
+			}
+		case map[string]any:
+			if m, ok := value.(map[string]any); ok {
+				resource[key] = mergeObjects(existing, m)
+				return nil
+			}
+		}
+		resource[key] = value
+		return nil
+	}
+
+	values, _ := resource[key].([]any)
+	matched := false
+	for i, v := range values {
+		elem, ok := v.(map[string]any)
+		if !ok || !p.filter.matches(elem) {
+			continue
+		}
+		matched = true
+		values[i] = setElementValue(elem, p.subAttr, value)
+	}
+	if !matched {
+		// create the element if the filter identifies it by an attribute
+		// value, e.g. emails[type eq "work"].value
+		f, ok := p.filter.(compareFilter)
+		if !ok || f.op != "eq" || f.attr.subAttr != "" {
+			return newError(http.StatusBadRequest, scimTypeNoTarget, fmt.Sprintf("no values match the path: %s", p.name))
+		}
+		elem := map[string]any{f.attr.name: f.value}
+		values = append(values, setElementValue(elem, p.subAttr, value))
+	}
+	resource[key] = values
+	return nil
+}
+
+func setElementValue(elem map[string]any, subAttr string, value any) map[string]any {
+	if subAttr != "" {
+		elem[keyFor(elem, subAttr)] = value
+		return elem
+	}
+	if m, ok := value.(map[string]any); ok {
+		return mergeObjects(elem, m)
+	}
+	return elem
+}
+
+func removePatchValue(resource map[string]any, p patchPath, value any) {
+	key := keyFor(resource, p.name)
+
+	if p.filter == nil {
+		if p.subAttr != "" {
+			switch existing := resource[key].(type) {
+			case map[string]any:
+				delete(existing, keyFor(existing, p.subAttr))
+			case []any:
+				for _, v := range existing {
+					if elem, ok := v.(map[string]any); ok {
+						delete(elem, keyFor(elem, p.subAttr))
+					}
+				}
+			}
+			return
+		}
+
+		// some providers remove specific values from a multi-valued
+		// attribute by listing them, e.g. {"path": "members", "value": [{"value": "ID"}]}
+		existing, ok := resource[key].([]any)
+		remove, ok2 := value.([]any)
+		if ok && ok2 {
+			resource[key] = removeValues(existing, remove)
+			return
+		}
+		delete(resource, key)
+		return
+	}
+
+	values, _ := resource[key].([]any)
+	remaining := values[:0]
+	for _, v := range values {
+		elem, ok := v.(map[string]any)
+		if !ok || !p.filter.matches(elem) {
+			remaining = append(remaining, v)
+			continue
+		}
+		if p.subAttr != "" {
+			delete(elem, keyFor(elem, p.subAttr))
+			remaining = append(remaining, elem)
+		}
+	}
+	resource[key] = remaining
+}
+
+func appendValues(existing []any, value any) []any {
+	values, ok := value.([]any)
+	if !ok {
+		values = []any{value}
+	}
+	for _, v := range values {
+		if !containsValue(existing, v) {
+			existing = append(existing, v)
+		}
+	}
+	return existing
+}
+
+func removeValues(existing, remove []any) []any {
+	remaining := make([]any, 0, len(existing))
+	for _, v := range existing {
+		if !containsValue(remove, v) {
+			remaining = append(remaining, v)
+		}
+	}
+	return remaining
+}
+
+// containsValue returns true if the values contain the value. Complex values
+// with a "value" sub-attribute are compared by that sub-attribute.
+func containsValue(values []any, value any) bool {
+	for _, v := range values {
+		if valuesEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func valuesEqual(a, b any) bool {
+	am, ok1 := a.(map[string]any)
+	bm, ok2 := b.(map[string]any)
+	if ok1 && ok2 {
+		av, ok1 := lookup(am, "value")
+		bv, ok2 := lookup(bm, "value")
+		if ok1 && ok2 {
+			return av == bv
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func mergeObjects(dst, src map[string]any) map[string]any {
+	if dst == nil {
+		dst = make(map[string]any, len(src))
+	}
+	for k, v := range src {
+		dst[keyFor(dst, k)] = v
+	}
+	return dst
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
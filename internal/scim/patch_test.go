@@ -0,0 +1,105 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyPatch(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name       string
+		resource   string
+		operations string
+		expect     string
+	}{
+		{
+			"replace attribute",
+			`{"userName": "jane", "active": true}`,
+			`[{"op": "Replace", "path": "active", "value": false}]`,
+			`{"userName": "jane", "active": false}`,
+		},
+		{
+			"replace without path",
+			`{"userName": "jane", "name": {"givenName": "Jane", "familyName": "Doe"}}`,
+			`[{"op": "replace", "value": {"displayName": "Jane Doe", "name.familyName": "Smith"}}]`,
+			`{"userName": "jane", "displayName": "Jane Doe", "name": {"givenName": "Jane", "familyName": "Smith"}}`,
+		},
+		{
+			"replace with value filter",
+			`{"emails": [{"type": "work", "value": "jane@example.com"}]}`,
+			`[{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "jane@corp.example.com"}]`,
+			`{"emails": [{"type": "work", "value": "jane@corp.example.com"}]}`,
+		},
+		{
+			"replace creates filtered value",
+			`{"userName": "jane"}`,
+			`[{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "jane@example.com"}]`,
+			`{"userName": "jane", "emails": [{"type": "work", "value": "jane@example.com"}]}`,
+		},
+		{
+			"add members",
+			`{"members": [{"value": "U1"}]}`,
+			`[{"op": "add", "path": "members", "value": [{"value": "U1"}, {"value": "U2"}]}]`,
+			`{"members": [{"value": "U1"}, {"value": "U2"}]}`,
+		},
+		{
+			"remove member with filter",
+			`{"members": [{"value": "U1"}, {"value": "U2"}]}`,
+			`[{"op": "remove", "path": "members[value eq \"U1\"]"}]`,
+			`{"members": [{"value": "U2"}]}`,
+		},
+		{
+			"remove member with value",
+			`{"members": [{"value": "U1"}, {"value": "U2"}]}`,
+			`[{"op": "remove", "path": "members", "value": [{"value": "U2"}]}]`,
+			`{"members": [{"value": "U1"}]}`,
+		},
+		{
+			"remove attribute",
+			`{"userName": "jane", "title": "Engineer"}`,
+			`[{"op": "remove", "path": "title"}]`,
+			`{"userName": "jane"}`,
+		},
+		{
+			"add extension attribute",
+			`{"userName": "jane"}`,
+			`[{"op": "add", "value": {"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": {"department": "engineering"}}}]`,
+			`{"userName": "jane", "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": {"department": "engineering"}}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var resource map[string]any
+			require.NoError(t, json.Unmarshal([]byte(tc.resource), &resource))
+			req := &patchRequest{Schemas: []string{PatchOpSchema}}
+			require.NoError(t, json.Unmarshal([]byte(tc.operations), &req.Operations))
+
+			require.NoError(t, applyPatch(resource, req))
+			actual, err := json.Marshal(resource)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expect, string(actual))
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+
+		for _, operations := range []string{
+			`[{"op": "remove"}]`,
+			`[{"op": "move", "path": "userName"}]`,
+			`[{"op": "replace", "value": "jane"}]`,
+			`[{"op": "replace", "path": "emails[type eq \"work\"", "value": "jane"}]`,
+			`[{"op": "replace", "path": "emails[type pr].value", "value": "jane"}]`,
+		} {
+			req := &patchRequest{Schemas: []string{PatchOpSchema}}
+			require.NoError(t, json.Unmarshal([]byte(operations), &req.Operations))
+			assert.Error(t, applyPatch(map[string]any{}, req), operations)
+		}
+	})
+}
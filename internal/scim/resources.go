@@ -0,0 +1,356 @@
+package scim
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pomerium/datasource/pkg/directory"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+// SCIM schema URNs.
+const (
+	UserSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	GroupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	EnterpriseUserSchema        = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	ServiceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	ResourceTypeSchema          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	SchemaSchema                = "urn:ietf:params:scim:schemas:core:2.0:Schema"
+	ListResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	PatchOpSchema               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ErrorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// the key used to store the SCIM resource on the directory record
+const scimKey = "scim"
+
+// A user is a SCIM user stored as a directory user record.
+type user struct {
+	id       string
+	resource map[string]any
+	groupIDs []string
+}
+
+// A group is a SCIM group stored as a directory group record.
+type group struct {
+	id        string
+	resource  map[string]any
+	memberIDs []string
+}
+
+// newUserResource validates a user resource sent by a client and removes the
+// attributes managed by the server.
+func newUserResource(body map[string]any) (map[string]any, error) {
+	resource := withoutAttributes(body, "id", "schemas", "meta", "groups")
+
+	userName, _ := getString(resource, "userName")
+	if userName == "" {
+		return nil, newError(http.StatusBadRequest, scimTypeInvalidValue, "userName is required")
+	}
+
+	// some providers send booleans as strings
+	if key := keyFor(resource, "active"); resource[key] != nil {
+		switch v := resource[key].(type) {
+		case bool:
+		case string:
+			resource[key] = strings.EqualFold(v, "true")
+		default:
+			return nil, newError(http.StatusBadRequest, scimTypeInvalidValue, "active must be a boolean")
+		}
+	}
+
+	return resource, nil
+}
+
+// newGroupResource validates a group resource sent by a client and removes
+// the attributes managed by the server. The member ids are returned
+// separately.
+func newGroupResource(body map[string]any) (map[string]any, []string, error) {
+	resource := withoutAttributes(body, "id", "schemas", "meta", "members")
+
+	displayName, _ := getString(resource, "displayName")
+	if displayName == "" {
+		return nil, nil, newError(http.StatusBadRequest, scimTypeInvalidValue, "displayName is required")
+	}
+
+	members, _ := lookup(body, "members")
+	memberIDs, err := getMemberIDs(members)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource, memberIDs, nil
+}
+
+func getMemberIDs(members any) ([]string, error) {
+	if members == nil {
+		return nil, nil
+	}
+
+	values, ok := members.([]any)
+	if !ok {
+		return nil, newError(http.StatusBadRequest, scimTypeInvalidValue, "members must be an array")
+	}
+
+	ids := make([]string, 0, len(values))
+	for _, v := range values {
+		m, _ := v.(map[string]any)
+		id, _ := getString(m, "value")
+		if id == "" {
+			return nil, newError(http.StatusBadRequest, scimTypeInvalidValue, "members must have a value")
+		}
+		if t, _ := getString(m, "type"); t != "" && !strings.EqualFold(t, "User") {
+			return nil, newError(http.StatusBadRequest, scimTypeInvalidValue, "only users are supported as group members")
+		}
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids), nil
+}
+
+// displayName returns the name used for the user on the directory record.
+func (u *user) displayName() string {
+	if v, _ := getString(u.resource, "displayName"); v != "" {
+		return v
+	}
+	name, _ := lookup(u.resource, "name")
+	if m, ok := name.(map[string]any); ok {
+		if v, _ := getString(m, "formatted"); v != "" {
+			return v
+		}
+		givenName, _ := getString(m, "givenName")
+		familyName, _ := getString(m, "familyName")
+		if v := strings.TrimSpace(givenName + " " + familyName); v != "" {
+			return v
+		}
+	}
+	v, _ := getString(u.resource, "userName")
+	return v
+}
+
+// email returns the primary email address of the user.
+func (u *user) email() string {
+	var emails []string
+	values, _ := lookup(u.resource, "emails")
+	vs, _ := values.([]any)
+	for _, v := range vs {
+		m, _ := v.(map[string]any)
+		email, _ := getString(m, "value")
+		if email == "" {
+			continue
+		}
+		if primary, _ := lookup(m, "primary"); primary == true {
+			return email
+		}
+		emails = append(emails, email)
+	}
+	if len(emails) > 0 {
+		return emails[0]
+	}
+	if v, _ := getString(u.resource, "userName"); strings.Contains(v, "@") {
+		return v
+	}
+	return ""
+}
+
+func (u *user) userName() string {
+	v, _ := getString(u.resource, "userName")
+	return v
+}
+
+func (u *user) toRecord() *databroker.Record {
+	return newRecord(directory.UserRecordType, u.id, map[string]any{
+		"id":           u.id,
+		"display_name": u.displayName(),
+		"email":        u.email(),
+		"group_ids":    u.groupIDs,
+		scimKey:        u.resource,
+	})
+}
+
+// render returns the SCIM representation of the user.
+func (u *user) render(baseURL string, groupNames map[string]string) map[string]any {
+	resource := render(u.resource, u.id, "User", baseURL+"/Users/"+u.id, UserSchema)
+	groups := make([]any, 0, len(u.groupIDs))
+	for _, groupID := range u.groupIDs {
+		g := map[string]any{
+			"value": groupID,
+			"$ref":  baseURL + "/Groups/" + groupID,
+		}
+		if name, ok := groupNames[groupID]; ok {
+			g["display"] = name
+		}
+		groups = append(groups, g)
+	}
+	resource["groups"] = groups
+	return resource
+}
+
+func (g *group) displayName() string {
+	v, _ := getString(g.resource, "displayName")
+	return v
+}
+
+func (g *group) toRecord() *databroker.Record {
+	return newRecord(directory.GroupRecordType, g.id, map[string]any{
+		"id":         g.id,
+		"name":       g.displayName(),
+		"member_ids": g.memberIDs,
+		scimKey:      g.resource,
+	})
+}
+
+// render returns the SCIM representation of the group.
+func (g *group) render(baseURL string) map[string]any {
+	resource := render(g.resource, g.id, "Group", baseURL+"/Groups/"+g.id, GroupSchema)
+	members := make([]any, 0, len(g.memberIDs))
+	for _, memberID := range g.memberIDs {
+		members = append(members, map[string]any{
+			"value": memberID,
+			"type":  "User",
+			"$ref":  baseURL + "/Users/" + memberID,
+		})
+	}
+	resource["members"] = members
+	return resource
+}
+
+func userFromRecord(record *databroker.Record) (*user, bool) {
+	data, ok := recordData(record)
+	if !ok {
+		return nil, false
+	}
+	return &user{
+		id:       record.GetId(),
+		resource: data[scimKey].(map[string]any),
+		groupIDs: getStrings(data, "group_ids"),
+	}, true
+}
+
+func groupFromRecord(record *databroker.Record) (*group, bool) {
+	data, ok := recordData(record)
+	if !ok {
+		return nil, false
+	}
+	return &group{
+		id:        record.GetId(),
+		resource:  data[scimKey].(map[string]any),
+		memberIDs: getStrings(data, "member_ids"),
+	}, true
+}
+
+// recordData returns the data of a directory record if it was created via
+// SCIM. Records created by other sources, such as LDAP, are ignored.
+func recordData(record *databroker.Record) (map[string]any, bool) {
+	if record == nil || record.GetDeletedAt() != nil {
+		return nil, false
+	}
+	var s structpb.Struct
+	if err := record.GetData().UnmarshalTo(&s); err != nil {
+		return nil, false
+	}
+	data := s.AsMap()
+	if _, ok := data[scimKey].(map[string]any); !ok {
+		return nil, false
+	}
+	return data, true
+}
+
+func newRecord(recordType, recordID string, m map[string]any) *databroker.Record {
+	return &databroker.Record{
+		Type: recordType,
+		Id:   recordID,
+		Data: protoutil.NewAny(protoutil.ToStruct(m).GetStructValue()),
+	}
+}
+
+// render returns the SCIM representation of a stored resource.
+func render(stored map[string]any, id, resourceType, location, schema string) map[string]any {
+	resource := make(map[string]any, len(stored)+3)
+	schemas := []any{schema}
+	for k, v := range stored {
+		resource[k] = v
+		if strings.HasPrefix(strings.ToLower(k), "urn:") {
+			schemas = append(schemas, k)
+		}
+	}
+	resource["schemas"] = schemas
+	resource["id"] = id
+
+	meta, _ := stored["meta"].(map[string]any)
+	meta = mergeObjects(nil, meta)
+	meta["resourceType"] = resourceType
+	meta["location"] = location
+	resource["meta"] = meta
+	return resource
+}
+
+// setMeta sets the created and last modified timestamps on the resource.
+func setMeta(resource map[string]any, previous map[string]any, now time.Time) {
+	ts := now.UTC().Format(time.RFC3339)
+	created := ts
+	if meta, ok := previous["meta"].(map[string]any); ok {
+		if v, ok := meta["created"].(string); ok {
+			created = v
+		}
+	}
+	resource["meta"] = map[string]any{
+		"created":      created,
+		"lastModified": ts,
+	}
+}
+
+// project applies the attributes and excludedAttributes query parameters to
+// a rendered resource.
+func project(resource map[string]any, attributes, excludedAttributes []string) map[string]any {
+	alwaysReturned := []string{"id", "schemas", "meta"}
+	if len(attributes) > 0 {
+		projected := make(map[string]any, len(attributes)+len(alwaysReturned))
+		for _, name := range append(attributes, alwaysReturned...) {
+			p := parseAttrPath(name)
+			if v, ok := lookup(resource, p.name); ok {
+				projected[keyFor(resource, p.name)] = v
+			}
+		}
+		return projected
+	}
+	for _, name := range excludedAttributes {
+		p := parseAttrPath(name)
+		if !containsFold(alwaysReturned, p.name) {
+			delete(resource, keyFor(resource, p.name))
+		}
+	}
+	return resource
+}
+
+func withoutAttributes(m map[string]any, names ...string) map[string]any {
+	dst := make(map[string]any, len(m))
+	for k, v := range m {
+		if !containsFold(names, k) {
+			dst[k] = v
+		}
+	}
+	return dst
+}
+
+func getString(m map[string]any, name string) (string, bool) {
+	v, _ := lookup(m, name)
+	s, ok := v.(string)
+	return s, ok
+}
+
+func getStrings(m map[string]any, name string) []string {
+	vs, _ := m[name].([]any)
+	strs := make([]string, 0, len(vs))
+	for _, v := range vs {
+		if s, ok := v.(string); ok {
+			strs = append(strs, s)
+		}
+	}
+	return strs
+}
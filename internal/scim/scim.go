@@ -0,0 +1,681 @@
+// Package scim implements a SCIM 2.0 (RFC 7643, RFC 7644) server which
+// provisions directory users and groups into the databroker.
+//
+// Users and groups are stored as directory records, so they can be used by
+// the groups policy criterion and the JWT groups claim. The id of a resource
+// is its externalId if one is provided, otherwise a random UUID. For
+// policies to match, identity providers should be configured to send the
+// user's identity provider subject as the externalId.
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/pomerium/datasource/pkg/directory"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// BasePath is the path the SCIM endpoints are served under.
+const BasePath = "/scim/v2"
+
+const (
+	maxRequestBodySize = 1 << 20
+	maxResults         = 1000
+)
+
+// SCIM error types (RFC 7644 §3.12).
+const (
+	scimTypeInvalidFilter = "invalidFilter"
+	scimTypeInvalidSyntax = "invalidSyntax"
+	scimTypeInvalidPath   = "invalidPath"
+	scimTypeInvalidValue  = "invalidValue"
+	scimTypeNoTarget      = "noTarget"
+	scimTypeUniqueness    = "uniqueness"
+)
+
+// A Handler serves the SCIM endpoints.
+type Handler struct {
+	router      *mux.Router
+	client      databroker.DataBrokerServiceClient
+	bearerToken string
+	now         func() time.Time
+
+	// mu serializes writes, which read and update multiple records
+	mu sync.Mutex
+}
+
+// New creates a new SCIM Handler. Requests must be authenticated with the
+// given bearer token.
+func New(client databroker.DataBrokerServiceClient, bearerToken string) *Handler {
+	h := &Handler{
+		router:      mux.NewRouter(),
+		client:      client,
+		bearerToken: bearerToken,
+		now:         time.Now,
+	}
+	h.router.NotFoundHandler = handlerFunc(func(_ http.ResponseWriter, _ *http.Request) error {
+		return newError(http.StatusNotFound, "", "not found")
+	})
+	h.router.MethodNotAllowedHandler = handlerFunc(func(_ http.ResponseWriter, _ *http.Request) error {
+		return newError(http.StatusMethodNotAllowed, "", "method not allowed")
+	})
+
+	sr := h.router.PathPrefix(BasePath).Subrouter()
+	sr.Use(h.authorize)
+	sr.Path("/ServiceProviderConfig").Handler(handlerFunc(h.serviceProviderConfig)).Methods(http.MethodGet)
+	sr.Path("/ResourceTypes").Handler(handlerFunc(h.resourceTypes)).Methods(http.MethodGet)
+	sr.Path("/Users").Handler(handlerFunc(h.listUsersHandler)).Methods(http.MethodGet)
+	sr.Path("/Users").Handler(handlerFunc(h.createUser)).Methods(http.MethodPost)
+	sr.Path("/Users/{id}").Handler(handlerFunc(h.getUserHandler)).Methods(http.MethodGet)
+	sr.Path("/Users/{id}").Handler(handlerFunc(h.replaceUser)).Methods(http.MethodPut)
+	sr.Path("/Users/{id}").Handler(handlerFunc(h.patchUser)).Methods(http.MethodPatch)
+	sr.Path("/Users/{id}").Handler(handlerFunc(h.deleteUserHandler)).Methods(http.MethodDelete)
+	sr.Path("/Groups").Handler(handlerFunc(h.listGroupsHandler)).Methods(http.MethodGet)
+	sr.Path("/Groups").Handler(handlerFunc(h.createGroup)).Methods(http.MethodPost)
+	sr.Path("/Groups/{id}").Handler(handlerFunc(h.getGroupHandler)).Methods(http.MethodGet)
+	sr.Path("/Groups/{id}").Handler(handlerFunc(h.replaceGroup)).Methods(http.MethodPut)
+	sr.Path("/Groups/{id}").Handler(handlerFunc(h.patchGroup)).Methods(http.MethodPatch)
+	sr.Path("/Groups/{id}").Handler(handlerFunc(h.deleteGroupHandler)).Methods(http.MethodDelete)
+	return h
+}
+
+// ServeHTTP serves a SCIM request.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.router.ServeHTTP(w, r)
+}
+
+func (h *Handler) authorize(next http.Handler) http.Handler {
+	return handlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+		if h.bearerToken == "" || !strings.EqualFold(scheme, "Bearer") ||
+			subtle.ConstantTimeCompare([]byte(token), []byte(h.bearerToken)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+			return newError(http.StatusUnauthorized, "", "invalid bearer token")
+		}
+		next.ServeHTTP(w, r)
+		return nil
+	})
+}
+
+func (h *Handler) serviceProviderConfig(w http.ResponseWriter, r *http.Request) error {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas":          []string{ServiceProviderConfigSchema},
+		"documentationUri": "https://www.pomerium.com/docs",
+		"patch":            map[string]any{"supported": true},
+		"bulk":             map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":           map[string]any{"supported": true, "maxResults": maxResults},
+		"changePassword":   map[string]any{"supported": false},
+		"sort":             map[string]any{"supported": false},
+		"etag":             map[string]any{"supported": false},
+		"authenticationSchemes": []any{map[string]any{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication using a bearer token",
+			"primary":     true,
+		}},
+		"meta": map[string]any{
+			"resourceType": "ServiceProviderConfig",
+			"location":     getBaseURL(r) + "/ServiceProviderConfig",
+		},
+	})
+	return nil
+}
+
+func (h *Handler) resourceTypes(w http.ResponseWriter, r *http.Request) error {
+	baseURL := getBaseURL(r)
+	resources := []map[string]any{
+		{
+			"schemas":  []string{ResourceTypeSchema},
+			"id":       "User",
+			"name":     "User",
+			"endpoint": "/Users",
+			"schema":   UserSchema,
+			"schemaExtensions": []any{map[string]any{
+				"schema":   EnterpriseUserSchema,
+				"required": false,
+			}},
+			"meta": map[string]any{
+				"resourceType": "ResourceType",
+				"location":     baseURL + "/ResourceTypes/User",
+			},
+		},
+		{
+			"schemas":  []string{ResourceTypeSchema},
+			"id":       "Group",
+			"name":     "Group",
+			"endpoint": "/Groups",
+			"schema":   GroupSchema,
+			"meta": map[string]any{
+				"resourceType": "ResourceType",
+				"location":     baseURL + "/ResourceTypes/Group",
+			},
+		},
+	}
+	writeJSON(w, http.StatusOK, (&listOptions{startIndex: 1, count: maxResults}).response(resources))
+	return nil
+}
+
+func (h *Handler) listUsersHandler(w http.ResponseWriter, r *http.Request) error {
+	opts, err := parseListOptions(r)
+	if err != nil {
+		return err
+	}
+
+	users, err := h.listUsers(r.Context())
+	if err != nil {
+		return err
+	}
+	groups, err := h.listGroups(r.Context())
+	if err != nil {
+		return err
+	}
+	groupNames := make(map[string]string, len(groups))
+	for _, g := range groups {
+		groupNames[g.id] = g.displayName()
+	}
+
+	baseURL := getBaseURL(r)
+	resources := make([]map[string]any, 0, len(users))
+	for _, u := range users {
+		resources = append(resources, u.render(baseURL, groupNames))
+	}
+	writeJSON(w, http.StatusOK, opts.response(resources))
+	return nil
+}
+
+func (h *Handler) getUserHandler(w http.ResponseWriter, r *http.Request) error {
+	opts, err := parseListOptions(r)
+	if err != nil {
+		return err
+	}
+
+	u, err := h.getUser(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	return h.writeUser(w, r, http.StatusOK, u, opts)
+}
+
+func (h *Handler) createUser(w http.ResponseWriter, r *http.Request) error {
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	resource, err := newUserResource(body)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	u := &user{resource: resource}
+	if err := h.checkUserNameIsUnique(r.Context(), u); err != nil {
+		return err
+	}
+
+	u.id, err = h.newID(r.Context(), directory.UserRecordType, resource)
+	if err != nil {
+		return err
+	}
+
+	setMeta(resource, nil, h.now())
+	if err := h.put(r.Context(), u.toRecord()); err != nil {
+		return err
+	}
+
+	log.Ctx(r.Context()).Info().Str("user_id", u.id).Msg("scim: created user")
+	w.Header().Set("Location", getBaseURL(r)+"/Users/"+u.id)
+	return h.writeUser(w, r, http.StatusCreated, u, nil)
+}
+
+func (h *Handler) replaceUser(w http.ResponseWriter, r *http.Request) error {
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	resource, err := newUserResource(body)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	u, err := h.getUser(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	setMeta(resource, u.resource, h.now())
+	u.resource = resource
+	if err := h.checkUserNameIsUnique(r.Context(), u); err != nil {
+		return err
+	}
+
+	if err := h.put(r.Context(), u.toRecord()); err != nil {
+		return err
+	}
+
+	return h.writeUser(w, r, http.StatusOK, u, nil)
+}
+
+func (h *Handler) patchUser(w http.ResponseWriter, r *http.Request) error {
+	var req patchRequest
+	if err := decodeBody(r, &req); err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	u, err := h.getUser(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	patched := u.render(getBaseURL(r), nil)
+	if err := applyPatch(patched, &req); err != nil {
+		return err
+	}
+	resource, err := newUserResource(patched)
+	if err != nil {
+		return err
+	}
+
+	setMeta(resource, u.resource, h.now())
+	u.resource = resource
+	if err := h.checkUserNameIsUnique(r.Context(), u); err != nil {
+		return err
+	}
+
+	if err := h.put(r.Context(), u.toRecord()); err != nil {
+		return err
+	}
+
+	return h.writeUser(w, r, http.StatusOK, u, nil)
+}
+
+func (h *Handler) deleteUserHandler(w http.ResponseWriter, r *http.Request) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	u, err := h.getUser(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	if err := h.deleteUser(r.Context(), u); err != nil {
+		return err
+	}
+
+	log.Ctx(r.Context()).Info().Str("user_id", u.id).Msg("scim: deleted user")
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (h *Handler) listGroupsHandler(w http.ResponseWriter, r *http.Request) error {
+	opts, err := parseListOptions(r)
+	if err != nil {
+		return err
+	}
+
+	groups, err := h.listGroups(r.Context())
+	if err != nil {
+		return err
+	}
+
+	baseURL := getBaseURL(r)
+	resources := make([]map[string]any, 0, len(groups))
+	for _, g := range groups {
+		resources = append(resources, g.render(baseURL))
+	}
+	writeJSON(w, http.StatusOK, opts.response(resources))
+	return nil
+}
+
+func (h *Handler) getGroupHandler(w http.ResponseWriter, r *http.Request) error {
+	opts, err := parseListOptions(r)
+	if err != nil {
+		return err
+	}
+
+	g, err := h.getGroup(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	writeJSON(w, http.StatusOK, opts.project(g.render(getBaseURL(r))))
+	return nil
+}
+
+func (h *Handler) createGroup(w http.ResponseWriter, r *http.Request) error {
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	resource, memberIDs, err := newGroupResource(body)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	g := &group{resource: resource, memberIDs: memberIDs}
+	g.id, err = h.newID(r.Context(), directory.GroupRecordType, resource)
+	if err != nil {
+		return err
+	}
+
+	setMeta(resource, nil, h.now())
+	if err := h.saveGroup(r.Context(), g, nil); err != nil {
+		return err
+	}
+
+	log.Ctx(r.Context()).Info().Str("group_id", g.id).Msg("scim: created group")
+	w.Header().Set("Location", getBaseURL(r)+"/Groups/"+g.id)
+	writeJSON(w, http.StatusCreated, g.render(getBaseURL(r)))
+	return nil
+}
+
+func (h *Handler) replaceGroup(w http.ResponseWriter, r *http.Request) error {
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	resource, memberIDs, err := newGroupResource(body)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	g, err := h.getGroup(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	previousMemberIDs := g.memberIDs
+	setMeta(resource, g.resource, h.now())
+	g.resource, g.memberIDs = resource, memberIDs
+	if err := h.saveGroup(r.Context(), g, previousMemberIDs); err != nil {
+		return err
+	}
+
+	writeJSON(w, http.StatusOK, g.render(getBaseURL(r)))
+	return nil
+}
+
+func (h *Handler) patchGroup(w http.ResponseWriter, r *http.Request) error {
+	var req patchRequest
+	if err := decodeBody(r, &req); err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	g, err := h.getGroup(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	patched := g.render(getBaseURL(r))
+	if err := applyPatch(patched, &req); err != nil {
+		return err
+	}
+	resource, memberIDs, err := newGroupResource(patched)
+	if err != nil {
+		return err
+	}
+
+	previousMemberIDs := g.memberIDs
+	setMeta(resource, g.resource, h.now())
+	g.resource, g.memberIDs = resource, memberIDs
+	if err := h.saveGroup(r.Context(), g, previousMemberIDs); err != nil {
+		return err
+	}
+
+	writeJSON(w, http.StatusOK, g.render(getBaseURL(r)))
+	return nil
+}
+
+func (h *Handler) deleteGroupHandler(w http.ResponseWriter, r *http.Request) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	g, err := h.getGroup(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+
+	if err := h.deleteGroup(r.Context(), g); err != nil {
+		return err
+	}
+
+	log.Ctx(r.Context()).Info().Str("group_id", g.id).Msg("scim: deleted group")
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (h *Handler) writeUser(w http.ResponseWriter, r *http.Request, code int, u *user, opts *listOptions) error {
+	groupNames := make(map[string]string, len(u.groupIDs))
+	for _, groupID := range u.groupIDs {
+		g, err := h.getGroup(r.Context(), groupID)
+		if isNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		groupNames[g.id] = g.displayName()
+	}
+	writeJSON(w, code, opts.project(u.render(getBaseURL(r), groupNames)))
+	return nil
+}
+
+func (h *Handler) checkUserNameIsUnique(ctx context.Context, u *user) error {
+	existing, err := h.findUserByUserName(ctx, u.userName())
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.id != u.id {
+		return newError(http.StatusConflict, scimTypeUniqueness, fmt.Sprintf("userName %s already exists", u.userName()))
+	}
+	return nil
+}
+
+// newID returns the id for a new resource: the externalId if one was
+// provided, otherwise a random UUID.
+func (h *Handler) newID(ctx context.Context, recordType string, resource map[string]any) (string, error) {
+	id, _ := getString(resource, "externalId")
+	if id == "" {
+		return uuid.NewString(), nil
+	}
+
+	record, err := h.getRecord(ctx, recordType, id)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := recordData(record); ok {
+		return "", newError(http.StatusConflict, scimTypeUniqueness, fmt.Sprintf("externalId %s already exists", id))
+	}
+	return id, nil
+}
+
+// listOptions are the query parameters used for listing resources
+// (RFC 7644 §3.4.2).
+type listOptions struct {
+	filter             filter
+	startIndex         int
+	count              int
+	attributes         []string
+	excludedAttributes []string
+}
+
+func parseListOptions(r *http.Request) (*listOptions, error) {
+	q := r.URL.Query()
+	opts := &listOptions{
+		startIndex:         1,
+		count:              maxResults,
+		attributes:         splitAttributes(q.Get("attributes")),
+		excludedAttributes: splitAttributes(q.Get("excludedAttributes")),
+	}
+
+	if raw := q.Get("filter"); raw != "" {
+		f, err := parseFilter(raw)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, scimTypeInvalidFilter, err.Error())
+		}
+		opts.filter = f
+	}
+
+	if raw := q.Get("startIndex"); raw != "" {
+		startIndex, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, scimTypeInvalidValue, "invalid startIndex")
+		}
+		opts.startIndex = max(startIndex, 1)
+	}
+
+	if raw := q.Get("count"); raw != "" {
+		count, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, scimTypeInvalidValue, "invalid count")
+		}
+		opts.count = min(max(count, 0), maxResults)
+	}
+
+	return opts, nil
+}
+
+// response returns a list response for the resources matching the options.
+func (opts *listOptions) response(resources []map[string]any) map[string]any {
+	matching := make([]map[string]any, 0, len(resources))
+	for _, resource := range resources {
+		if opts.filter == nil || opts.filter.matches(resource) {
+			matching = append(matching, resource)
+		}
+	}
+
+	start := min(opts.startIndex-1, len(matching))
+	end := min(start+opts.count, len(matching))
+	page := make([]map[string]any, 0, end-start)
+	for _, resource := range matching[start:end] {
+		page = append(page, opts.project(resource))
+	}
+
+	return map[string]any{
+		"schemas":      []string{ListResponseSchema},
+		"totalResults": len(matching),
+		"startIndex":   opts.startIndex,
+		"itemsPerPage": len(page),
+		"Resources":    page,
+	}
+}
+
+func (opts *listOptions) project(resource map[string]any) map[string]any {
+	if opts == nil {
+		return resource
+	}
+	return project(resource, opts.attributes, opts.excludedAttributes)
+}
+
+func splitAttributes(raw string) []string {
+	var attributes []string
+	for _, attribute := range strings.Split(raw, ",") {
+		if attribute = strings.TrimSpace(attribute); attribute != "" {
+			attributes = append(attributes, attribute)
+		}
+	}
+	return attributes
+}
+
+func readBody(r *http.Request) (map[string]any, error) {
+	var body map[string]any
+	if err := decodeBody(r, &body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func decodeBody(r *http.Request, dst any) error {
+	err := json.NewDecoder(http.MaxBytesReader(nil, r.Body, maxRequestBodySize)).Decode(dst)
+	if err != nil {
+		return newError(http.StatusBadRequest, scimTypeInvalidSyntax, fmt.Sprintf("invalid request body: %v", err))
+	}
+	return nil
+}
+
+func getBaseURL(r *http.Request) string {
+	return "https://" + r.Host + BasePath
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// A scimError is a SCIM error response (RFC 7644 §3.12).
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func newError(status int, scimType, detail string) *scimError {
+	return &scimError{status: status, scimType: scimType, detail: detail}
+}
+
+func (err *scimError) Error() string {
+	return fmt.Sprintf("scim: %d %s", err.status, err.detail)
+}
+
+func isNotFound(err error) bool {
+	var e *scimError
+	return errors.As(err, &e) && e.status == http.StatusNotFound
+}
+
+// handlerFunc is an http.HandlerFunc which renders returned errors as SCIM
+// errors.
+type handlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+func (f handlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	err := f(w, r)
+	if err == nil {
+		return
+	}
+
+	var e *scimError
+	if !errors.As(err, &e) {
+		log.Ctx(r.Context()).Error().Err(err).Msg("scim: error handling request")
+		e = newError(http.StatusInternalServerError, "", "internal server error")
+	}
+
+	body := map[string]any{
+		"schemas": []string{ErrorSchema},
+		"status":  strconv.Itoa(e.status),
+		"detail":  e.detail,
+	}
+	if e.scimType != "" {
+		body["scimType"] = e.scimType
+	}
+	writeJSON(w, e.status, body)
+}
@@ -0,0 +1,234 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pomerium/datasource/pkg/directory"
+	"github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/testutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	ctx, clearTimeout := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(clearTimeout)
+
+	cc := testutil.NewGRPCServer(t, func(srv *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(srv, databroker.New(ctx))
+	})
+	t.Cleanup(func() { cc.Close() })
+	client := databrokerpb.NewDataBrokerServiceClient(cc)
+
+	h := New(client, "TOKEN")
+	h.now = func() time.Time { return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	do := func(t *testing.T, method, path, body string) (int, map[string]any) {
+		t.Helper()
+
+		r := httptest.NewRequest(method, "https://authenticate.example.com"+BasePath+path, strings.NewReader(body))
+		r.Header.Set("Authorization", "Bearer TOKEN")
+		r.Header.Set("Content-Type", "application/scim+json")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		var res map[string]any
+		if w.Body.Len() > 0 {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		}
+		return w.Code, res
+	}
+	getRecord := func(t *testing.T, recordType, id string) map[string]any {
+		t.Helper()
+
+		res, err := client.Get(ctx, &databrokerpb.GetRequest{Type: recordType, Id: id})
+		if databrokerpb.IsNotFound(err) {
+			return nil
+		}
+		require.NoError(t, err)
+		var s structpb.Struct
+		require.NoError(t, res.GetRecord().GetData().UnmarshalTo(&s))
+		return s.AsMap()
+	}
+
+	t.Run("unauthorized", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "https://authenticate.example.com"+BasePath+"/Users", nil)
+		r.Header.Set("Authorization", "Bearer WRONG")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.JSONEq(t, `{
+			"schemas": ["urn:ietf:params:scim:api:messages:2.0:Error"],
+			"status": "401",
+			"detail": "invalid bearer token"
+		}`, w.Body.String())
+	})
+
+	t.Run("create user", func(t *testing.T) {
+		code, res := do(t, http.MethodPost, "/Users", `{
+			"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+			"externalId": "U1",
+			"userName": "jane@example.com",
+			"name": {"givenName": "Jane", "familyName": "Doe"},
+			"emails": [{"type": "work", "value": "jane@example.com", "primary": true}],
+			"active": "True"
+		}`)
+		require.Equal(t, http.StatusCreated, code, res)
+		assert.Equal(t, map[string]any{
+			"schemas":    []any{UserSchema},
+			"id":         "U1",
+			"externalId": "U1",
+			"userName":   "jane@example.com",
+			"name":       map[string]any{"givenName": "Jane", "familyName": "Doe"},
+			"emails":     []any{map[string]any{"type": "work", "value": "jane@example.com", "primary": true}},
+			"active":     true,
+			"groups":     []any{},
+			"meta": map[string]any{
+				"resourceType": "User",
+				"created":      "2024-01-02T03:04:05Z",
+				"lastModified": "2024-01-02T03:04:05Z",
+				"location":     "https://authenticate.example.com/scim/v2/Users/U1",
+			},
+		}, res)
+
+		record := getRecord(t, directory.UserRecordType, "U1")
+		assert.Equal(t, "U1", record["id"])
+		assert.Equal(t, "Jane Doe", record["display_name"])
+		assert.Equal(t, "jane@example.com", record["email"])
+		assert.Equal(t, []any{}, record["group_ids"])
+
+		code, res = do(t, http.MethodPost, "/Users", `{"userName": "JANE@example.com"}`)
+		assert.Equal(t, http.StatusConflict, code)
+		assert.Equal(t, "uniqueness", res["scimType"])
+
+		code, res = do(t, http.MethodPost, "/Users", `{"name": {"givenName": "John"}}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "invalidValue", res["scimType"])
+	})
+
+	t.Run("create group", func(t *testing.T) {
+		code, res := do(t, http.MethodPost, "/Groups", `{
+			"externalId": "G1",
+			"displayName": "admins",
+			"members": [{"value": "U1"}]
+		}`)
+		require.Equal(t, http.StatusCreated, code, res)
+		assert.Equal(t, []any{map[string]any{
+			"value": "U1",
+			"type":  "User",
+			"$ref":  "https://authenticate.example.com/scim/v2/Users/U1",
+		}}, res["members"])
+
+		assert.Equal(t, "admins", getRecord(t, directory.GroupRecordType, "G1")["name"])
+		assert.Equal(t, []any{"G1"}, getRecord(t, directory.UserRecordType, "U1")["group_ids"])
+
+		code, _ = do(t, http.MethodPost, "/Groups", `{"displayName": "other", "members": [{"value": "MISSING"}]}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("list users", func(t *testing.T) {
+		code, res := do(t, http.MethodGet, `/Users?filter=userName+eq+"Jane@Example.com"&attributes=userName,groups`, "")
+		require.Equal(t, http.StatusOK, code, res)
+		assert.Equal(t, map[string]any{
+			"schemas":      []any{ListResponseSchema},
+			"totalResults": 1.0,
+			"startIndex":   1.0,
+			"itemsPerPage": 1.0,
+			"Resources": []any{map[string]any{
+				"schemas":  []any{UserSchema},
+				"id":       "U1",
+				"userName": "jane@example.com",
+				"groups": []any{map[string]any{
+					"value":   "G1",
+					"display": "admins",
+					"$ref":    "https://authenticate.example.com/scim/v2/Groups/G1",
+				}},
+				"meta": map[string]any{
+					"resourceType": "User",
+					"created":      "2024-01-02T03:04:05Z",
+					"lastModified": "2024-01-02T03:04:05Z",
+					"location":     "https://authenticate.example.com/scim/v2/Users/U1",
+				},
+			}},
+		}, res)
+
+		code, res = do(t, http.MethodGet, `/Users?filter=userName+eq+"john@example.com"`, "")
+		require.Equal(t, http.StatusOK, code, res)
+		assert.Equal(t, 0.0, res["totalResults"])
+
+		code, res = do(t, http.MethodGet, `/Users?filter=userName+xx`, "")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "invalidFilter", res["scimType"])
+	})
+
+	t.Run("patch user", func(t *testing.T) {
+		code, res := do(t, http.MethodPatch, "/Users/U1", `{
+			"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+			"Operations": [
+				{"op": "replace", "path": "displayName", "value": "Jane Smith"},
+				{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "jane.smith@example.com"}
+			]
+		}`)
+		require.Equal(t, http.StatusOK, code, res)
+		assert.Equal(t, "Jane Smith", res["displayName"])
+
+		record := getRecord(t, directory.UserRecordType, "U1")
+		assert.Equal(t, "Jane Smith", record["display_name"])
+		assert.Equal(t, "jane.smith@example.com", record["email"])
+		assert.Equal(t, []any{"G1"}, record["group_ids"], "should keep group memberships")
+	})
+
+	t.Run("patch group", func(t *testing.T) {
+		code, res := do(t, http.MethodPatch, "/Groups/G1", `{
+			"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+			"Operations": [
+				{"op": "remove", "path": "members[value eq \"U1\"]"},
+				{"op": "replace", "path": "displayName", "value": "administrators"}
+			]
+		}`)
+		require.Equal(t, http.StatusOK, code, res)
+		assert.Equal(t, []any{}, res["members"])
+		assert.Equal(t, "administrators", getRecord(t, directory.GroupRecordType, "G1")["name"])
+		assert.Equal(t, []any{}, getRecord(t, directory.UserRecordType, "U1")["group_ids"])
+
+		code, res = do(t, http.MethodPatch, "/Groups/G1", `{
+			"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+			"Operations": [{"op": "add", "path": "members", "value": [{"value": "U1"}]}]
+		}`)
+		require.Equal(t, http.StatusOK, code, res)
+		assert.Equal(t, []any{"G1"}, getRecord(t, directory.UserRecordType, "U1")["group_ids"])
+	})
+
+	t.Run("delete user", func(t *testing.T) {
+		code, _ := do(t, http.MethodDelete, "/Users/U1", "")
+		assert.Equal(t, http.StatusNoContent, code)
+		assert.Nil(t, getRecord(t, directory.UserRecordType, "U1"))
+		assert.Equal(t, []any{}, getRecord(t, directory.GroupRecordType, "G1")["member_ids"])
+
+		code, res := do(t, http.MethodGet, "/Users/U1", "")
+		assert.Equal(t, http.StatusNotFound, code)
+		assert.Equal(t, "404", res["status"])
+	})
+
+	t.Run("delete group", func(t *testing.T) {
+		code, _ := do(t, http.MethodDelete, "/Groups/G1", "")
+		assert.Equal(t, http.StatusNoContent, code)
+		assert.Nil(t, getRecord(t, directory.GroupRecordType, "G1"))
+
+		code, res := do(t, http.MethodGet, "/Groups", "")
+		require.Equal(t, http.StatusOK, code, res)
+		assert.Equal(t, 0.0, res["totalResults"])
+	})
+}
@@ -0,0 +1,196 @@
+package scim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/datasource/pkg/directory"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+func (h *Handler) getUser(ctx context.Context, id string) (*user, error) {
+	record, err := h.getRecord(ctx, directory.UserRecordType, id)
+	if err != nil {
+		return nil, err
+	}
+	u, ok := userFromRecord(record)
+	if !ok {
+		return nil, newError(http.StatusNotFound, "", fmt.Sprintf("user %s not found", id))
+	}
+	return u, nil
+}
+
+func (h *Handler) getGroup(ctx context.Context, id string) (*group, error) {
+	record, err := h.getRecord(ctx, directory.GroupRecordType, id)
+	if err != nil {
+		return nil, err
+	}
+	g, ok := groupFromRecord(record)
+	if !ok {
+		return nil, newError(http.StatusNotFound, "", fmt.Sprintf("group %s not found", id))
+	}
+	return g, nil
+}
+
+func (h *Handler) getRecord(ctx context.Context, recordType, id string) (*databroker.Record, error) {
+	res, err := h.client.Get(ctx, &databroker.GetRequest{
+		Type: recordType,
+		Id:   id,
+	})
+	if databroker.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("scim: error getting %s record: %w", recordType, err)
+	}
+	return res.GetRecord(), nil
+}
+
+func (h *Handler) listUsers(ctx context.Context) ([]*user, error) {
+	records, err := h.listRecords(ctx, directory.UserRecordType)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*user, 0, len(records))
+	for _, record := range records {
+		if u, ok := userFromRecord(record); ok {
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
+func (h *Handler) listGroups(ctx context.Context) ([]*group, error) {
+	records, err := h.listRecords(ctx, directory.GroupRecordType)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]*group, 0, len(records))
+	for _, record := range records {
+		if g, ok := groupFromRecord(record); ok {
+			groups = append(groups, g)
+		}
+	}
+	return groups, nil
+}
+
+func (h *Handler) listRecords(ctx context.Context, recordType string) ([]*databroker.Record, error) {
+	records, _, _, err := databroker.InitialSync(ctx, h.client, &databroker.SyncLatestRequest{
+		Type: recordType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scim: error listing %s records: %w", recordType, err)
+	}
+	slices.SortFunc(records, func(a, b *databroker.Record) int {
+		return strings.Compare(a.GetId(), b.GetId())
+	})
+	return records, nil
+}
+
+// findUserByUserName returns the user with the given user name, or nil if
+// there is no such user. User names are case-insensitive.
+func (h *Handler) findUserByUserName(ctx context.Context, userName string) (*user, error) {
+	users, err := h.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		if strings.EqualFold(u.userName(), userName) {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+// saveGroup saves the group and updates the group ids of any users added to
+// or removed from the group.
+func (h *Handler) saveGroup(ctx context.Context, g *group, previousMemberIDs []string) error {
+	records := []*databroker.Record{g.toRecord()}
+
+	for _, userID := range g.memberIDs {
+		if slices.Contains(previousMemberIDs, userID) {
+			continue
+		}
+		u, err := h.getUser(ctx, userID)
+		if isNotFound(err) {
+			return newError(http.StatusBadRequest, scimTypeInvalidValue, fmt.Sprintf("member %s not found", userID))
+		} else if err != nil {
+			return err
+		}
+		if !slices.Contains(u.groupIDs, g.id) {
+			u.groupIDs = append(u.groupIDs, g.id)
+			slices.Sort(u.groupIDs)
+		}
+		records = append(records, u.toRecord())
+	}
+
+	for _, userID := range previousMemberIDs {
+		if slices.Contains(g.memberIDs, userID) {
+			continue
+		}
+		u, err := h.getUser(ctx, userID)
+		if isNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		u.groupIDs = slices.DeleteFunc(u.groupIDs, func(id string) bool { return id == g.id })
+		records = append(records, u.toRecord())
+	}
+
+	return h.put(ctx, records...)
+}
+
+// deleteUser deletes the user and removes them from any groups.
+func (h *Handler) deleteUser(ctx context.Context, u *user) error {
+	var records []*databroker.Record
+	for _, groupID := range u.groupIDs {
+		g, err := h.getGroup(ctx, groupID)
+		if isNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		g.memberIDs = slices.DeleteFunc(g.memberIDs, func(id string) bool { return id == u.id })
+		records = append(records, g.toRecord())
+	}
+
+	record := u.toRecord()
+	record.DeletedAt = timestamppb.Now()
+	records = append(records, record)
+
+	return h.put(ctx, records...)
+}
+
+// deleteGroup deletes the group and removes it from its members.
+func (h *Handler) deleteGroup(ctx context.Context, g *group) error {
+	var records []*databroker.Record
+	for _, userID := range g.memberIDs {
+		u, err := h.getUser(ctx, userID)
+		if isNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		u.groupIDs = slices.DeleteFunc(u.groupIDs, func(id string) bool { return id == g.id })
+		records = append(records, u.toRecord())
+	}
+
+	record := g.toRecord()
+	record.DeletedAt = timestamppb.Now()
+	records = append(records, record)
+
+	return h.put(ctx, records...)
+}
+
+func (h *Handler) put(ctx context.Context, records ...*databroker.Record) error {
+	_, err := h.client.Put(ctx, &databroker.PutRequest{Records: records})
+	if err != nil {
+		return fmt.Errorf("scim: error saving records: %w", err)
+	}
+	return nil
+}
@@ -21,6 +21,9 @@ var (
 
 	TagKeyCgroup     = tag.MustNewKey("cgroup")
 	TagKeyActionName = tag.MustNewKey("action_name")
+
+	TagKeyRouteID         = tag.MustNewKey("route_id")
+	TagKeyRateLimitResult = tag.MustNewKey("result")
 )
 
 // Default distributions used by views in this package.
@@ -48,5 +51,6 @@ var (
 		InfoViews,
 		StorageViews,
 		EnvoyViews,
+		RateLimitViews,
 	}
 )
@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/bridge/opencensus"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+
+	"github.com/pomerium/pomerium/internal/telemetry/otlp"
+	"github.com/pomerium/pomerium/internal/telemetry/prometheus"
+)
+
+// An OTLPExporter periodically pushes pomerium and envoy metrics to an OTLP
+// collector.
+type OTLPExporter struct {
+	meterProvider *sdkmetric.MeterProvider
+}
+
+// NewOTLPExporter creates a new OTLPExporter. Envoy metrics are scraped from
+// envoyScrapeURL every interval.
+func NewOTLPExporter(
+	ctx context.Context,
+	options *otlp.Options,
+	serviceName string,
+	envoyScrapeURL string,
+	interval time.Duration,
+) (*OTLPExporter, error) {
+	// views are only recorded once registered, which is otherwise done by
+	// the prometheus exporter
+	if err := registerDefaultViews(); err != nil {
+		return nil, fmt.Errorf("telemetry/metrics: failed registering views: %w", err)
+	}
+
+	exporter, err := otlp.NewMetricExporter(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("telemetry/metrics: could not create otlp exporter: %w", err)
+	}
+
+	reader := sdkmetric.NewPeriodicReader(exporter,
+		sdkmetric.WithInterval(interval),
+		sdkmetric.WithProducer(opencensus.NewMetricProducer()),
+		sdkmetric.WithProducer(prometheus.NewProducer(
+			prometheus.WithScope(instrumentation.Scope{Name: "envoy"}),
+			prometheus.WithScrapeURL(envoyScrapeURL),
+			prometheus.WithStartTime(time.Now()),
+			prometheus.WithIncludeAllMetrics(),
+		)),
+	)
+	return &OTLPExporter{
+		meterProvider: sdkmetric.NewMeterProvider(
+			sdkmetric.WithReader(reader),
+			sdkmetric.WithResource(options.Resource(serviceName)),
+		),
+	}, nil
+}
+
+// Shutdown pushes any remaining metrics and stops the exporter.
+func (e *OTLPExporter) Shutdown(ctx context.Context) error {
+	return e.meterProvider.Shutdown(ctx)
+}
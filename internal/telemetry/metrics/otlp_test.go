@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collectormetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pomerium/pomerium/internal/telemetry/otlp"
+)
+
+func TestOTLPExporter(t *testing.T) {
+	t.Parallel()
+
+	envoy := httptest.NewServer(newEnvoyMetricsHandler())
+	defer envoy.Close()
+
+	requests := make(chan *collectormetricspb.ExportMetricsServiceRequest, 1)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, otlp.MetricsPath, r.URL.Path)
+		assert.Equal(t, "secret", r.Header.Get("X-API-Key"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		req := new(collectormetricspb.ExportMetricsServiceRequest)
+		require.NoError(t, proto.Unmarshal(body, req))
+		select {
+		case requests <- req:
+		default:
+		}
+
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		b, _ := proto.Marshal(new(collectormetricspb.ExportMetricsServiceResponse))
+		_, _ = w.Write(b)
+	}))
+	defer collector.Close()
+
+	endpoint, err := url.Parse(collector.URL)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	exporter, err := NewOTLPExporter(ctx, &otlp.Options{
+		Endpoint: endpoint,
+		Protocol: otlp.ProtocolHTTP,
+		Headers:  map[string]string{"X-API-Key": "secret"},
+	}, "pomerium", envoy.URL, time.Hour)
+	require.NoError(t, err)
+
+	// shutdown pushes the metrics collected so far
+	require.NoError(t, exporter.Shutdown(ctx))
+
+	select {
+	case req := <-requests:
+		var names []string
+		for _, rm := range req.GetResourceMetrics() {
+			for _, sm := range rm.GetScopeMetrics() {
+				for _, m := range sm.GetMetrics() {
+					names = append(names, m.GetName())
+				}
+			}
+		}
+		assert.Contains(t, names, "envoy_server_initialization_time_ms")
+	case <-time.After(10 * time.Second):
+		t.Fatal("no metrics were pushed")
+	}
+}
@@ -168,6 +168,7 @@ func writeMetricsWithLabels(w io.Writer, r io.Reader, extra []*io_prometheus_cli
 	if err != nil {
 		return fmt.Errorf("telemetry/metric: failed to read prometheus metrics: %w", err)
 	}
+	addUpstreamHealthMetrics(ms)
 
 	for _, m := range ms {
 		for _, mm := range m.Metric {
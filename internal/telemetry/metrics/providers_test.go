@@ -24,6 +24,12 @@ envoy_server_initialization_time_ms_bucket{le="100"} 0
 envoy_server_initialization_time_ms_bucket{le="250"} 0
 envoy_server_initialization_time_ms_bucket{le="500"} 1
 envoy_server_initialization_time_ms_bucket{le="1000"} 1
+# TYPE envoy_cluster_membership_total gauge
+envoy_cluster_membership_total{envoy_cluster_name="route-1"} 3
+envoy_cluster_membership_total{envoy_cluster_name="route-2"} 2
+# TYPE envoy_cluster_membership_healthy gauge
+envoy_cluster_membership_healthy{envoy_cluster_name="route-1"} 1
+envoy_cluster_membership_healthy{envoy_cluster_name="route-2"} 2
 `))
 	}
 }
@@ -69,6 +75,12 @@ func Test_PrometheusHandler(t *testing.T) {
 		if m, _ := regexp.Match(`(?m)^# TYPE envoy_.*`, b); !m {
 			t.Errorf("Metrics endpoint did not contain envoy metrics: %s", b)
 		}
+		if m, _ := regexp.Match(`(?m)^pomerium_upstream_unhealthy_endpoints\{envoy_cluster_name="route-1",.*\} 2$`, b); !m {
+			t.Errorf("Metrics endpoint did not contain upstream unhealthy endpoints: %s", b)
+		}
+		if m, _ := regexp.Match(`(?m)^pomerium_upstream_unhealthy_endpoints\{envoy_cluster_name="route-2",.*\} 0$`, b); !m {
+			t.Errorf("Metrics endpoint did not contain upstream unhealthy endpoints: %s", b)
+		}
 	})
 
 	t.Run("with envoy, request protobuf format", func(t *testing.T) {
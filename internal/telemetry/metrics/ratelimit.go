@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"context"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/metrics"
+)
+
+var (
+	// RateLimitViews contains the rate limit views.
+	RateLimitViews = []*view.View{
+		RateLimitRequestsView,
+	}
+
+	rateLimitRequests = stats.Int64(
+		metrics.RateLimitRequestsTotal,
+		"Total rate limit checks by route and result",
+		stats.UnitDimensionless,
+	)
+
+	// RateLimitRequestsView is an OpenCensus view which counts rate limit
+	// checks by route and result.
+	RateLimitRequestsView = &view.View{
+		Name:        rateLimitRequests.Name(),
+		Description: rateLimitRequests.Description(),
+		TagKeys:     []tag.Key{TagKeyRouteID, TagKeyRateLimitResult},
+		Measure:     rateLimitRequests,
+		Aggregation: view.Count(),
+	}
+)
+
+// RecordRateLimitRequest records the result of a rate limit check for a route.
+// The result is either "ok" or "over_limit".
+func RecordRateLimitRequest(ctx context.Context, routeID, result string) {
+	err := stats.RecordWithTags(ctx,
+		[]tag.Mutator{
+			tag.Upsert(TagKeyRouteID, routeID),
+			tag.Upsert(TagKeyRateLimitResult, result),
+		},
+		rateLimitRequests.M(1),
+	)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("internal/telemetry/metrics: failed to record")
+	}
+}
@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"strings"
+
+	io_prometheus_client "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pomerium/pomerium/pkg/metrics"
+)
+
+const (
+	envoyClusterMembershipTotal   = "envoy_cluster_membership_total"
+	envoyClusterMembershipHealthy = "envoy_cluster_membership_healthy"
+)
+
+// addUpstreamHealthMetrics derives the number of unhealthy endpoints of each
+// upstream cluster from envoy's cluster membership gauges.
+func addUpstreamHealthMetrics(ms map[string]*io_prometheus_client.MetricFamily) {
+	total, healthy := ms[envoyClusterMembershipTotal], ms[envoyClusterMembershipHealthy]
+	if total == nil || healthy == nil {
+		return
+	}
+
+	healthyByLabels := make(map[string]float64, len(healthy.Metric))
+	for _, m := range healthy.Metric {
+		healthyByLabels[labelsKey(m.Label)] = m.GetGauge().GetValue()
+	}
+
+	name := "pomerium_" + metrics.UpstreamUnhealthyEndpoints
+	family := &io_prometheus_client.MetricFamily{
+		Name: proto.String(name),
+		Help: proto.String("Number of upstream endpoints failing health checks or ejected by outlier detection"),
+		Type: io_prometheus_client.MetricType_GAUGE.Enum(),
+	}
+	for _, m := range total.Metric {
+		h, ok := healthyByLabels[labelsKey(m.Label)]
+		if !ok {
+			continue
+		}
+		labels := make([]*io_prometheus_client.LabelPair, 0, len(m.Label))
+		for _, l := range m.Label {
+			labels = append(labels, proto.Clone(l).(*io_prometheus_client.LabelPair))
+		}
+		family.Metric = append(family.Metric, &io_prometheus_client.Metric{
+			Label: labels,
+			Gauge: &io_prometheus_client.Gauge{Value: proto.Float64(m.GetGauge().GetValue() - h)},
+		})
+	}
+	if len(family.Metric) > 0 {
+		ms[name] = family
+	}
+}
+
+func labelsKey(labels []*io_prometheus_client.LabelPair) string {
+	var sb strings.Builder
+	for _, l := range labels {
+		sb.WriteString(l.GetName())
+		sb.WriteByte('=')
+		sb.WriteString(l.GetValue())
+		sb.WriteByte(',')
+	}
+	return sb.String()
+}
@@ -0,0 +1,208 @@
+// Package otlp contains support for exporting traces and metrics to an
+// OpenTelemetry collector using the OpenTelemetry Protocol (OTLP).
+package otlp
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sort"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/pomerium/pomerium/internal/version"
+)
+
+// Supported OTLP protocols.
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http/protobuf"
+)
+
+// Default OTLP ports and paths.
+const (
+	DefaultGRPCPort = "4317"
+	DefaultHTTPPort = "4318"
+
+	TracesPath  = "/v1/traces"
+	MetricsPath = "/v1/metrics"
+)
+
+// Options are the options for connecting to an OTLP collector.
+type Options struct {
+	// Endpoint is the URL of the collector. The http scheme disables TLS.
+	Endpoint *url.URL
+	// Protocol is either grpc or http/protobuf.
+	Protocol string
+	// Headers are sent with every export request.
+	Headers map[string]string `json:"-"`
+	// CA is the PEM-encoded certificate authority used to verify the
+	// collector's certificate. If empty, the system roots are used.
+	CA []byte
+	// TLSSkipVerify disables verification of the collector's certificate.
+	TLSSkipVerify bool
+	// ResourceAttributes are added to the exported resource.
+	ResourceAttributes map[string]string
+}
+
+// ParseEndpoint parses an OTLP collector endpoint. If the endpoint has no
+// port, the default port for the protocol is used.
+func ParseEndpoint(rawURL, protocol string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "http", "https":
+	default:
+		return nil, fmt.Errorf("unsupported url scheme: %q", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return nil, errors.New("url is missing a host")
+	}
+
+	if u.Port() == "" {
+		port := DefaultGRPCPort
+		if protocol == ProtocolHTTP {
+			port = DefaultHTTPPort
+		}
+		u.Host = net.JoinHostPort(u.Hostname(), port)
+	}
+	return u, nil
+}
+
+// GetProtocol returns the protocol, defaulting to grpc.
+func (o *Options) GetProtocol() string {
+	if o.Protocol == "" {
+		return ProtocolGRPC
+	}
+	return o.Protocol
+}
+
+// SignalURL returns the URL used to export a signal. For HTTP, if the
+// endpoint has no path, the default signal path is used.
+func (o *Options) SignalURL(signalPath string) string {
+	u := *o.Endpoint
+	if o.GetProtocol() == ProtocolHTTP && (u.Path == "" || u.Path == "/") {
+		u.Path = signalPath
+	}
+	return u.String()
+}
+
+// TLSConfig returns the TLS config used to connect to the collector, or nil
+// if the defaults should be used.
+func (o *Options) TLSConfig() (*tls.Config, error) {
+	if o.Endpoint.Scheme != "https" || (len(o.CA) == 0 && !o.TLSSkipVerify) {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: o.TLSSkipVerify, //nolint:gosec
+		MinVersion:         tls.VersionTLS12,
+	}
+	if len(o.CA) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(o.CA) {
+			return nil, errors.New("otlp: no certificates found in CA")
+		}
+	}
+	return tlsConfig, nil
+}
+
+// Resource returns the resource describing this process. The resource
+// attributes override the defaults.
+func (o *Options) Resource(serviceName string) *resource.Resource {
+	attrs := []attribute.KeyValue{
+		semconv.ServiceNameKey.String(serviceName),
+		semconv.ServiceVersionKey.String(version.FullVersion()),
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		attrs = append(attrs, semconv.HostNameKey.String(hostname))
+	}
+
+	keys := make([]string, 0, len(o.ResourceAttributes))
+	for k := range o.ResourceAttributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		attrs = append(attrs, attribute.String(k, o.ResourceAttributes[k]))
+	}
+
+	// later attributes with the same key take precedence
+	return resource.NewSchemaless(attrs...)
+}
+
+// NewTraceExporter creates a new OTLP trace exporter.
+func NewTraceExporter(ctx context.Context, o *Options) (*otlptrace.Exporter, error) {
+	tlsConfig, err := o.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	switch o.GetProtocol() {
+	case ProtocolGRPC:
+		opts := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpointURL(o.SignalURL(TracesPath)),
+			otlptracegrpc.WithHeaders(o.Headers),
+		}
+		if tlsConfig != nil {
+			opts = append(opts, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(tlsConfig)))
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case ProtocolHTTP:
+		opts := []otlptracehttp.Option{
+			otlptracehttp.WithEndpointURL(o.SignalURL(TracesPath)),
+			otlptracehttp.WithHeaders(o.Headers),
+		}
+		if tlsConfig != nil {
+			opts = append(opts, otlptracehttp.WithTLSClientConfig(tlsConfig))
+		}
+		return otlptracehttp.New(ctx, opts...)
+	}
+	return nil, fmt.Errorf("otlp: unsupported protocol: %q", o.Protocol)
+}
+
+// NewMetricExporter creates a new OTLP metric exporter.
+func NewMetricExporter(ctx context.Context, o *Options) (sdkmetric.Exporter, error) {
+	tlsConfig, err := o.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	switch o.GetProtocol() {
+	case ProtocolGRPC:
+		opts := []otlpmetricgrpc.Option{
+			otlpmetricgrpc.WithEndpointURL(o.SignalURL(MetricsPath)),
+			otlpmetricgrpc.WithHeaders(o.Headers),
+		}
+		if tlsConfig != nil {
+			opts = append(opts, otlpmetricgrpc.WithTLSCredentials(credentials.NewTLS(tlsConfig)))
+		}
+		return otlpmetricgrpc.New(ctx, opts...)
+	case ProtocolHTTP:
+		opts := []otlpmetrichttp.Option{
+			otlpmetrichttp.WithEndpointURL(o.SignalURL(MetricsPath)),
+			otlpmetrichttp.WithHeaders(o.Headers),
+		}
+		if tlsConfig != nil {
+			opts = append(opts, otlpmetrichttp.WithTLSClientConfig(tlsConfig))
+		}
+		return otlpmetrichttp.New(ctx, opts...)
+	}
+	return nil, fmt.Errorf("otlp: unsupported protocol: %q", o.Protocol)
+}
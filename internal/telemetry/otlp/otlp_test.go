@@ -0,0 +1,91 @@
+package otlp
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestParseEndpoint(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		rawURL   string
+		protocol string
+		expect   string
+		err      string
+	}{
+		{"http://collector", "", "http://collector:4317", ""},
+		{"https://collector", ProtocolGRPC, "https://collector:4317", ""},
+		{"https://collector", ProtocolHTTP, "https://collector:4318", ""},
+		{"https://collector:443/otlp", ProtocolHTTP, "https://collector:443/otlp", ""},
+		{"collector:4317", "", "", `unsupported url scheme: "collector"`},
+		{"http://", "", "", "url is missing a host"},
+	} {
+		u, err := ParseEndpoint(tc.rawURL, tc.protocol)
+		if tc.err != "" {
+			assert.EqualError(t, err, tc.err, tc.rawURL)
+			continue
+		}
+		require.NoError(t, err, tc.rawURL)
+		assert.Equal(t, tc.expect, u.String(), tc.rawURL)
+	}
+}
+
+func TestSignalURL(t *testing.T) {
+	t.Parallel()
+
+	endpoint := func(rawURL string) *url.URL {
+		u, err := url.Parse(rawURL)
+		require.NoError(t, err)
+		return u
+	}
+
+	assert.Equal(t, "http://collector:4317",
+		(&Options{Endpoint: endpoint("http://collector:4317")}).SignalURL(TracesPath))
+	assert.Equal(t, "http://collector:4318/v1/traces",
+		(&Options{Endpoint: endpoint("http://collector:4318"), Protocol: ProtocolHTTP}).SignalURL(TracesPath))
+	assert.Equal(t, "http://collector:4318/v1/metrics",
+		(&Options{Endpoint: endpoint("http://collector:4318/"), Protocol: ProtocolHTTP}).SignalURL(MetricsPath))
+	assert.Equal(t, "http://collector:4318/custom",
+		(&Options{Endpoint: endpoint("http://collector:4318/custom"), Protocol: ProtocolHTTP}).SignalURL(MetricsPath))
+}
+
+func TestTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	tlsConfig, err := (&Options{Endpoint: &url.URL{Scheme: "https", Host: "collector:4317"}}).TLSConfig()
+	assert.NoError(t, err)
+	assert.Nil(t, tlsConfig, "should use the defaults")
+
+	tlsConfig, err = (&Options{Endpoint: &url.URL{Scheme: "http", Host: "collector:4317"}, TLSSkipVerify: true}).TLSConfig()
+	assert.NoError(t, err)
+	assert.Nil(t, tlsConfig, "should ignore tls options without https")
+
+	tlsConfig, err = (&Options{Endpoint: &url.URL{Scheme: "https", Host: "collector:4317"}, TLSSkipVerify: true}).TLSConfig()
+	assert.NoError(t, err)
+	assert.True(t, tlsConfig.InsecureSkipVerify)
+
+	_, err = (&Options{Endpoint: &url.URL{Scheme: "https", Host: "collector:4317"}, CA: []byte("foo")}).TLSConfig()
+	assert.EqualError(t, err, "otlp: no certificates found in CA")
+}
+
+func TestResource(t *testing.T) {
+	t.Parallel()
+
+	res := (&Options{ResourceAttributes: map[string]string{
+		"deployment.environment": "production",
+		"service.name":           "edge-proxy",
+	}}).Resource("pomerium")
+
+	value, ok := res.Set().Value(attribute.Key("deployment.environment"))
+	assert.True(t, ok)
+	assert.Equal(t, "production", value.AsString())
+
+	value, ok = res.Set().Value(attribute.Key("service.name"))
+	assert.True(t, ok)
+	assert.Equal(t, "edge-proxy", value.AsString(), "resource attributes should override the defaults")
+}
@@ -16,6 +16,7 @@ type producerConfig struct {
 	scrapeURL string
 	scope     instrumentation.Scope
 	startTime time.Time
+	all       bool
 	metrics   map[string]struct{}
 	labels    map[string]struct{}
 }
@@ -51,6 +52,14 @@ func WithIncludeMetrics(metrics ...string) ProducerOption {
 	}
 }
 
+// WithIncludeAllMetrics includes every scraped metric, rather than only the
+// metrics set with WithIncludeMetrics.
+func WithIncludeAllMetrics() ProducerOption {
+	return func(cfg *producerConfig) {
+		cfg.all = true
+	}
+}
+
 func WithIncludeLabels(labels ...string) ProducerOption {
 	return func(cfg *producerConfig) {
 		if cfg.labels == nil {
@@ -101,7 +110,7 @@ func (p *Producer) UpdateConfig(opts ...ProducerOption) {
 func (p *Producer) Produce(ctx context.Context) ([]metricdata.ScopeMetrics, error) {
 	cfg := p.loadConfig()
 
-	if len(cfg.metrics) == 0 {
+	if len(cfg.metrics) == 0 && !cfg.all {
 		return nil, nil
 	}
 
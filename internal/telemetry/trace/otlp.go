@@ -0,0 +1,53 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	octrace "go.opencensus.io/trace"
+	"go.opentelemetry.io/otel/bridge/opencensus"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/pomerium/pomerium/internal/telemetry/otlp"
+)
+
+// otlpProvider exports OpenCensus spans to an OTLP collector by installing the
+// OpenTelemetry bridge as the default OpenCensus tracer.
+type otlpProvider struct {
+	tracerProvider *sdktrace.TracerProvider
+	previousTracer octrace.Tracer
+}
+
+func (provider *otlpProvider) Register(opts *TracingOptions) error {
+	if opts.OTLP == nil || opts.OTLP.Endpoint == nil {
+		return errors.New("telemetry/trace: otlp endpoint is required")
+	}
+
+	exporter, err := otlp.NewTraceExporter(context.Background(), opts.OTLP)
+	if err != nil {
+		return fmt.Errorf("telemetry/trace: could not create otlp exporter: %w", err)
+	}
+
+	// the bridge ignores the OpenCensus sampler, so sampling is done by the
+	// OpenTelemetry SDK instead
+	provider.tracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(opts.OTLP.Resource(opts.Service)),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRate))),
+	)
+	provider.previousTracer = octrace.DefaultTracer
+	opencensus.InstallTraceBridge(opencensus.WithTracerProvider(provider.tracerProvider))
+	return nil
+}
+
+func (provider *otlpProvider) Unregister() error {
+	if provider.tracerProvider == nil {
+		return nil
+	}
+
+	octrace.DefaultTracer = provider.previousTracer
+	err := provider.tracerProvider.Shutdown(context.Background())
+	provider.tracerProvider, provider.previousTracer = nil, nil
+	return err
+}
@@ -8,6 +8,7 @@ import (
 	octrace "go.opencensus.io/trace"
 
 	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/telemetry/otlp"
 )
 
 const (
@@ -17,6 +18,8 @@ const (
 	JaegerTracingProviderName = "jaeger"
 	// ZipkinTracingProviderName is the name of the tracing provider Zipkin.
 	ZipkinTracingProviderName = "zipkin"
+	// OTLPTracingProviderName is the name of the tracing provider OTLP.
+	OTLPTracingProviderName = "otlp"
 )
 
 // Provider is a trace provider.
@@ -50,6 +53,11 @@ type TracingOptions struct {
 	// Example: http://zipkin:9411/api/v2/spans
 	ZipkinEndpoint *url.URL
 
+	// OTLP
+
+	// OTLP configures the OpenTelemetry collector spans are exported to.
+	OTLP *otlp.Options
+
 	// SampleRate is percentage of requests which are sampled
 	SampleRate float64
 }
@@ -69,6 +77,8 @@ func GetProvider(opts *TracingOptions) (Provider, error) {
 		provider = new(jaegerProvider)
 	case ZipkinTracingProviderName:
 		provider = new(zipkinProvider)
+	case OTLPTracingProviderName:
+		provider = new(otlpProvider)
 	default:
 		return nil, fmt.Errorf("telemetry/trace: provider %s unknown", opts.Provider)
 	}
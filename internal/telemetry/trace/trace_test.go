@@ -3,6 +3,8 @@ package trace
 import (
 	"net/url"
 	"testing"
+
+	"github.com/pomerium/pomerium/internal/telemetry/otlp"
 )
 
 func TestGetProvider(t *testing.T) {
@@ -16,6 +18,7 @@ func TestGetProvider(t *testing.T) {
 		{"jaeger no endpoint", &TracingOptions{JaegerAgentEndpoint: "", Service: "all", Provider: "jaeger"}, false},
 		{"unknown provider", &TracingOptions{JaegerAgentEndpoint: "localhost:0", Service: "all", Provider: "Lucius Cornelius Sulla"}, true},
 		{"zipkin with debug", &TracingOptions{ZipkinEndpoint: &url.URL{Host: "localhost"}, Service: "all", Provider: "zipkin", Debug: true}, false},
+		{"otlp", &TracingOptions{OTLP: &otlp.Options{Endpoint: &url.URL{Scheme: "http", Host: "localhost:4317"}}, Service: "all", Provider: "otlp"}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -141,14 +141,24 @@ func SignOutURL(r *http.Request, authenticateURL *url.URL, key []byte) string {
 
 // Device paths
 const (
-	WebAuthnURLPath    = "/.pomerium/webauthn"
-	DeviceEnrolledPath = "/.pomerium/device-enrolled"
+	WebAuthnURLPath          = "/.pomerium/webauthn"
+	ClientCertificateURLPath = "/.pomerium/client-certificate"
+	DeviceEnrolledPath       = "/.pomerium/device-enrolled"
 )
 
 // WebAuthnURL returns the /.pomerium/webauthn URL.
 func WebAuthnURL(_ *http.Request, authenticateURL *url.URL, key []byte, values url.Values) string {
+	return deviceURL(WebAuthnURLPath, authenticateURL, key, values)
+}
+
+// ClientCertificateURL returns the /.pomerium/client-certificate URL.
+func ClientCertificateURL(_ *http.Request, authenticateURL *url.URL, key []byte, values url.Values) string {
+	return deviceURL(ClientCertificateURLPath, authenticateURL, key, values)
+}
+
+func deviceURL(path string, authenticateURL *url.URL, key []byte, values url.Values) string {
 	u := authenticateURL.ResolveReference(&url.URL{
-		Path: WebAuthnURLPath,
+		Path: path,
 		RawQuery: buildURLValues(values, url.Values{
 			QueryDeviceType:      {DefaultDeviceType},
 			QueryEnrollmentToken: nil,
@@ -13,7 +13,10 @@ const (
 	QueryIdentityProviderID = "pomerium_idp_id"
 	QueryIsProgrammatic     = "pomerium_programmatic"
 	QueryIssued             = "pomerium_issued"
+	QueryACRValues          = "pomerium_acr_values"
+	QueryMaxAge             = "pomerium_max_age"
 	QueryPomeriumJWT        = "pomerium_jwt"
+	QueryPrompt             = "pomerium_prompt"
 	QueryRedirectURI        = "pomerium_redirect_uri"
 	QuerySession            = "pomerium_session"
 	QuerySessionEncrypted   = "pomerium_session_encrypted"
@@ -22,6 +25,13 @@ const (
 	QueryRequestUUID        = "pomerium_request_uuid"
 )
 
+// Query params which may be added to a signed authenticate URL by the user
+// and so are not covered by the URL signature.
+const (
+	QueryLoginHint                  = "login_hint"
+	QuerySelectedIdentityProviderID = "pomerium_selected_idp_id"
+)
+
 // URL signature based query params used for verifying the authenticity of a URL.
 const (
 	QueryHmacExpiry    = "pomerium_expiry"
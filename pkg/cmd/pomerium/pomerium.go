@@ -10,6 +10,7 @@ import (
 	"syscall"
 
 	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	envoy_service_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
 	"go.uber.org/automaxprocs/maxprocs"
 	"golang.org/x/sync/errgroup"
 
@@ -28,6 +29,7 @@ import (
 	derivecert_config "github.com/pomerium/pomerium/pkg/derivecert/config"
 	"github.com/pomerium/pomerium/pkg/envoy"
 	"github.com/pomerium/pomerium/pkg/envoy/files"
+	policypb "github.com/pomerium/pomerium/pkg/grpc/policy"
 	"github.com/pomerium/pomerium/proxy"
 )
 
@@ -215,6 +217,8 @@ func setupAuthorize(ctx context.Context, src config.Source, controlPlane *contro
 		return nil, fmt.Errorf("error creating authorize service: %w", err)
 	}
 	envoy_service_auth_v3.RegisterAuthorizationServer(controlPlane.GRPCServer, svc)
+	envoy_service_ratelimit_v3.RegisterRateLimitServiceServer(controlPlane.GRPCServer, svc)
+	policypb.RegisterPolicyEvaluatorServer(controlPlane.GRPCServer, svc)
 
 	log.Ctx(ctx).Info().Msg("enabled authorize service")
 	src.OnConfigChange(ctx, svc.OnConfigChange)
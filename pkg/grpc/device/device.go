@@ -3,6 +3,8 @@ package device
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 
 	"google.golang.org/grpc/codes"
@@ -14,6 +16,22 @@ import (
 	"github.com/pomerium/pomerium/pkg/protoutil"
 )
 
+// ClientCertificateFingerprint returns the fingerprint of a DER-encoded client
+// certificate: the hex-encoded SHA-256 of the certificate.
+func ClientCertificateFingerprint(der []byte) string {
+	h := sha256.Sum256(der)
+	return hex.EncodeToString(h[:])
+}
+
+// ClientCertificateCredentialID returns the id of the credential for a client
+// certificate enrolled for the given device type. The id is derived from the
+// certificate fingerprint so the credential can be found from the certificate
+// presented on a request. It must match the id computed by the
+// get_device_credential rego function.
+func ClientCertificateCredentialID(deviceTypeID, fingerprint string) string {
+	return ClientCertificateFingerprint([]byte(deviceTypeID + "|" + fingerprint))
+}
+
 // DeleteCredential deletes a credential from the databroker.
 func DeleteCredential(
 	ctx context.Context,
@@ -223,7 +241,7 @@ var maxCredentialSize = 256 * 1024
 // until its within the max credential size
 func shrinkCredential(credential *Credential) {
 	for len(protoutil.NewAny(credential).GetValue()) > maxCredentialSize {
-		if specifier := credential.Specifier.(*Credential_Webauthn); specifier != nil {
+		if specifier, ok := credential.Specifier.(*Credential_Webauthn); ok && specifier != nil {
 			// (1) remove authenticate responses
 			if len(specifier.Webauthn.AuthenticateResponse) > 0 {
 				specifier.Webauthn.AuthenticateResponse = specifier.Webauthn.AuthenticateResponse[1:]
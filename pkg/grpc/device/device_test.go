@@ -103,4 +103,28 @@ func TestShrinkCredential(t *testing.T) {
 		assert.Empty(t, credential.GetWebauthn().GetRegisterResponse())
 		assert.Empty(t, credential.GetWebauthn().GetAuthenticateResponse())
 	})
+	t.Run("client certificate", func(t *testing.T) {
+		credential := &Credential{
+			Id:     "c1",
+			TypeId: "t1",
+			Specifier: &Credential_ClientCertificate_{
+				ClientCertificate: &Credential_ClientCertificate{
+					Fingerprint: "f1",
+					Certificate: bytes.Repeat([]byte{1}, 256*1024),
+				},
+			},
+		}
+		assert.NotPanics(t, func() { shrinkCredential(credential) })
+		assert.Equal(t, "f1", credential.GetClientCertificate().GetFingerprint())
+	})
+}
+
+func TestClientCertificateCredentialID(t *testing.T) {
+	assert.Equal(t,
+		"677075606d90efc5fab33c9a4b317e6d8c2c5eb331fdec968f3e86c9b1d4ebeb",
+		ClientCertificateCredentialID("t1", "f1"))
+	assert.NotEqual(t,
+		ClientCertificateCredentialID("t1", "f1"),
+		ClientCertificateCredentialID("t2", "f1"),
+		"should depend on the device type")
 }
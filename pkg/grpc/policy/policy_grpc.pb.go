@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.7
+// source: policy.proto
+
+package policy
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PolicyEvaluator_Evaluate_FullMethodName = "/pomerium.policy.PolicyEvaluator/Evaluate"
+)
+
+// PolicyEvaluatorClient is the client API for PolicyEvaluator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PolicyEvaluator evaluates route policies for synthetic requests.
+type PolicyEvaluatorClient interface {
+	// Evaluate returns the decision for a synthetic request without making the
+	// request or creating a session.
+	Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error)
+}
+
+type policyEvaluatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPolicyEvaluatorClient(cc grpc.ClientConnInterface) PolicyEvaluatorClient {
+	return &policyEvaluatorClient{cc}
+}
+
+func (c *policyEvaluatorClient) Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateResponse)
+	err := c.cc.Invoke(ctx, PolicyEvaluator_Evaluate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PolicyEvaluatorServer is the server API for PolicyEvaluator service.
+// All implementations should embed UnimplementedPolicyEvaluatorServer
+// for forward compatibility.
+//
+// PolicyEvaluator evaluates route policies for synthetic requests.
+type PolicyEvaluatorServer interface {
+	// Evaluate returns the decision for a synthetic request without making the
+	// request or creating a session.
+	Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error)
+}
+
+// UnimplementedPolicyEvaluatorServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPolicyEvaluatorServer struct{}
+
+func (UnimplementedPolicyEvaluatorServer) Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evaluate not implemented")
+}
+func (UnimplementedPolicyEvaluatorServer) testEmbeddedByValue() {}
+
+// UnsafePolicyEvaluatorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PolicyEvaluatorServer will
+// result in compilation errors.
+type UnsafePolicyEvaluatorServer interface {
+	mustEmbedUnimplementedPolicyEvaluatorServer()
+}
+
+func RegisterPolicyEvaluatorServer(s grpc.ServiceRegistrar, srv PolicyEvaluatorServer) {
+	// If the following call pancis, it indicates UnimplementedPolicyEvaluatorServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PolicyEvaluator_ServiceDesc, srv)
+}
+
+func _PolicyEvaluator_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyEvaluatorServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyEvaluator_Evaluate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyEvaluatorServer).Evaluate(ctx, req.(*EvaluateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PolicyEvaluator_ServiceDesc is the grpc.ServiceDesc for PolicyEvaluator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PolicyEvaluator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pomerium.policy.PolicyEvaluator",
+	HandlerType: (*PolicyEvaluatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Evaluate",
+			Handler:    _PolicyEvaluator_Evaluate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "policy.proto",
+}
@@ -81,6 +81,7 @@ _sub_directories=(
   device
   events
   identity
+  policy
   registry
   session
   user
@@ -0,0 +1,436 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.21.7
+// source: local_user_admin.proto
+
+package user
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddLocalUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user_id is the id of the user, which is also the username used to sign
+	// in. If unset, the email is used.
+	UserId string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email  string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name   string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Groups []string `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
+	// password_hash is the argon2id or bcrypt hash of the password.
+	PasswordHash string `protobuf:"bytes,5,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
+	// totp_secret is the base32 encoded TOTP secret. If unset, TOTP is not
+	// required.
+	TotpSecret *string `protobuf:"bytes,6,opt,name=totp_secret,json=totpSecret,proto3,oneof" json:"totp_secret,omitempty"`
+}
+
+func (x *AddLocalUserRequest) Reset() {
+	*x = AddLocalUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_local_user_admin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddLocalUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLocalUserRequest) ProtoMessage() {}
+
+func (x *AddLocalUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_local_user_admin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLocalUserRequest.ProtoReflect.Descriptor instead.
+func (*AddLocalUserRequest) Descriptor() ([]byte, []int) {
+	return file_local_user_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddLocalUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddLocalUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AddLocalUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddLocalUserRequest) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *AddLocalUserRequest) GetPasswordHash() string {
+	if x != nil {
+		return x.PasswordHash
+	}
+	return ""
+}
+
+func (x *AddLocalUserRequest) GetTotpSecret() string {
+	if x != nil && x.TotpSecret != nil {
+		return *x.TotpSecret
+	}
+	return ""
+}
+
+type AddLocalUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *AddLocalUserResponse) Reset() {
+	*x = AddLocalUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_local_user_admin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddLocalUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLocalUserResponse) ProtoMessage() {}
+
+func (x *AddLocalUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_local_user_admin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLocalUserResponse.ProtoReflect.Descriptor instead.
+func (*AddLocalUserResponse) Descriptor() ([]byte, []int) {
+	return file_local_user_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddLocalUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type SetLocalUserPasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// password_hash is the argon2id or bcrypt hash of the password.
+	PasswordHash string `protobuf:"bytes,2,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
+	// totp_secret replaces the TOTP secret of the user if set.
+	TotpSecret *string `protobuf:"bytes,3,opt,name=totp_secret,json=totpSecret,proto3,oneof" json:"totp_secret,omitempty"`
+	// disable_totp removes the TOTP secret of the user.
+	DisableTotp bool `protobuf:"varint,4,opt,name=disable_totp,json=disableTotp,proto3" json:"disable_totp,omitempty"`
+}
+
+func (x *SetLocalUserPasswordRequest) Reset() {
+	*x = SetLocalUserPasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_local_user_admin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLocalUserPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLocalUserPasswordRequest) ProtoMessage() {}
+
+func (x *SetLocalUserPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_local_user_admin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLocalUserPasswordRequest.ProtoReflect.Descriptor instead.
+func (*SetLocalUserPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_local_user_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetLocalUserPasswordRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetLocalUserPasswordRequest) GetPasswordHash() string {
+	if x != nil {
+		return x.PasswordHash
+	}
+	return ""
+}
+
+func (x *SetLocalUserPasswordRequest) GetTotpSecret() string {
+	if x != nil && x.TotpSecret != nil {
+		return *x.TotpSecret
+	}
+	return ""
+}
+
+func (x *SetLocalUserPasswordRequest) GetDisableTotp() bool {
+	if x != nil {
+		return x.DisableTotp
+	}
+	return false
+}
+
+type SetLocalUserPasswordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetLocalUserPasswordResponse) Reset() {
+	*x = SetLocalUserPasswordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_local_user_admin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLocalUserPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLocalUserPasswordResponse) ProtoMessage() {}
+
+func (x *SetLocalUserPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_local_user_admin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLocalUserPasswordResponse.ProtoReflect.Descriptor instead.
+func (*SetLocalUserPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_local_user_admin_proto_rawDescGZIP(), []int{3}
+}
+
+var File_local_user_admin_proto protoreflect.FileDescriptor
+
+var file_local_user_admin_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x0a,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcb, 0x01, 0x0a, 0x13, 0x41,
+	0x64, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x70, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x74,
+	0x70, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x36, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1e, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x22, 0xb4, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x24,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x70, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x74, 0x6f, 0x74, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x54, 0x6f, 0x74, 0x70, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x74, 0x70,
+	0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb6, 0x01, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x45, 0x0a, 0x0c, 0x41, 0x64,
+	0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5d, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x6f, 0x6d, 0x65, 0x72, 0x69, 0x75, 0x6d, 0x2f, 0x70, 0x6f, 0x6d, 0x65, 0x72, 0x69, 0x75, 0x6d,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_local_user_admin_proto_rawDescOnce sync.Once
+	file_local_user_admin_proto_rawDescData = file_local_user_admin_proto_rawDesc
+)
+
+func file_local_user_admin_proto_rawDescGZIP() []byte {
+	file_local_user_admin_proto_rawDescOnce.Do(func() {
+		file_local_user_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_local_user_admin_proto_rawDescData)
+	})
+	return file_local_user_admin_proto_rawDescData
+}
+
+var file_local_user_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_local_user_admin_proto_goTypes = []any{
+	(*AddLocalUserRequest)(nil),          // 0: user.AddLocalUserRequest
+	(*AddLocalUserResponse)(nil),         // 1: user.AddLocalUserResponse
+	(*SetLocalUserPasswordRequest)(nil),  // 2: user.SetLocalUserPasswordRequest
+	(*SetLocalUserPasswordResponse)(nil), // 3: user.SetLocalUserPasswordResponse
+	(*User)(nil),                         // 4: user.User
+}
+var file_local_user_admin_proto_depIdxs = []int32{
+	4, // 0: user.AddLocalUserResponse.user:type_name -> user.User
+	0, // 1: user.LocalUserAdmin.AddLocalUser:input_type -> user.AddLocalUserRequest
+	2, // 2: user.LocalUserAdmin.SetLocalUserPassword:input_type -> user.SetLocalUserPasswordRequest
+	1, // 3: user.LocalUserAdmin.AddLocalUser:output_type -> user.AddLocalUserResponse
+	3, // 4: user.LocalUserAdmin.SetLocalUserPassword:output_type -> user.SetLocalUserPasswordResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_local_user_admin_proto_init() }
+func file_local_user_admin_proto_init() {
+	if File_local_user_admin_proto != nil {
+		return
+	}
+	file_user_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_local_user_admin_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*AddLocalUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_local_user_admin_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*AddLocalUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_local_user_admin_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*SetLocalUserPasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_local_user_admin_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*SetLocalUserPasswordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_local_user_admin_proto_msgTypes[0].OneofWrappers = []any{}
+	file_local_user_admin_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_local_user_admin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_local_user_admin_proto_goTypes,
+		DependencyIndexes: file_local_user_admin_proto_depIdxs,
+		MessageInfos:      file_local_user_admin_proto_msgTypes,
+	}.Build()
+	File_local_user_admin_proto = out.File
+	file_local_user_admin_proto_rawDesc = nil
+	file_local_user_admin_proto_goTypes = nil
+	file_local_user_admin_proto_depIdxs = nil
+}
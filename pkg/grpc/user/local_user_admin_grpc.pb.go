@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.7
+// source: local_user_admin.proto
+
+package user
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LocalUserAdmin_AddLocalUser_FullMethodName         = "/user.LocalUserAdmin/AddLocalUser"
+	LocalUserAdmin_SetLocalUserPassword_FullMethodName = "/user.LocalUserAdmin/SetLocalUserPassword"
+)
+
+// LocalUserAdminClient is the client API for LocalUserAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// LocalUserAdmin manages users of the local identity provider.
+type LocalUserAdminClient interface {
+	// AddLocalUser creates a user with a password.
+	AddLocalUser(ctx context.Context, in *AddLocalUserRequest, opts ...grpc.CallOption) (*AddLocalUserResponse, error)
+	// SetLocalUserPassword replaces the password of a user and unlocks them.
+	SetLocalUserPassword(ctx context.Context, in *SetLocalUserPasswordRequest, opts ...grpc.CallOption) (*SetLocalUserPasswordResponse, error)
+}
+
+type localUserAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLocalUserAdminClient(cc grpc.ClientConnInterface) LocalUserAdminClient {
+	return &localUserAdminClient{cc}
+}
+
+func (c *localUserAdminClient) AddLocalUser(ctx context.Context, in *AddLocalUserRequest, opts ...grpc.CallOption) (*AddLocalUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddLocalUserResponse)
+	err := c.cc.Invoke(ctx, LocalUserAdmin_AddLocalUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localUserAdminClient) SetLocalUserPassword(ctx context.Context, in *SetLocalUserPasswordRequest, opts ...grpc.CallOption) (*SetLocalUserPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLocalUserPasswordResponse)
+	err := c.cc.Invoke(ctx, LocalUserAdmin_SetLocalUserPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LocalUserAdminServer is the server API for LocalUserAdmin service.
+// All implementations should embed UnimplementedLocalUserAdminServer
+// for forward compatibility.
+//
+// LocalUserAdmin manages users of the local identity provider.
+type LocalUserAdminServer interface {
+	// AddLocalUser creates a user with a password.
+	AddLocalUser(context.Context, *AddLocalUserRequest) (*AddLocalUserResponse, error)
+	// SetLocalUserPassword replaces the password of a user and unlocks them.
+	SetLocalUserPassword(context.Context, *SetLocalUserPasswordRequest) (*SetLocalUserPasswordResponse, error)
+}
+
+// UnimplementedLocalUserAdminServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLocalUserAdminServer struct{}
+
+func (UnimplementedLocalUserAdminServer) AddLocalUser(context.Context, *AddLocalUserRequest) (*AddLocalUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLocalUser not implemented")
+}
+func (UnimplementedLocalUserAdminServer) SetLocalUserPassword(context.Context, *SetLocalUserPasswordRequest) (*SetLocalUserPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLocalUserPassword not implemented")
+}
+func (UnimplementedLocalUserAdminServer) testEmbeddedByValue() {}
+
+// UnsafeLocalUserAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LocalUserAdminServer will
+// result in compilation errors.
+type UnsafeLocalUserAdminServer interface {
+	mustEmbedUnimplementedLocalUserAdminServer()
+}
+
+func RegisterLocalUserAdminServer(s grpc.ServiceRegistrar, srv LocalUserAdminServer) {
+	// If the following call pancis, it indicates UnimplementedLocalUserAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LocalUserAdmin_ServiceDesc, srv)
+}
+
+func _LocalUserAdmin_AddLocalUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLocalUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalUserAdminServer).AddLocalUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocalUserAdmin_AddLocalUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalUserAdminServer).AddLocalUser(ctx, req.(*AddLocalUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalUserAdmin_SetLocalUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLocalUserPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalUserAdminServer).SetLocalUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocalUserAdmin_SetLocalUserPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalUserAdminServer).SetLocalUserPassword(ctx, req.(*SetLocalUserPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LocalUserAdmin_ServiceDesc is the grpc.ServiceDesc for LocalUserAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LocalUserAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.LocalUserAdmin",
+	HandlerType: (*LocalUserAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddLocalUser",
+			Handler:    _LocalUserAdmin_AddLocalUser_Handler,
+		},
+		{
+			MethodName: "SetLocalUserPassword",
+			Handler:    _LocalUserAdmin_SetLocalUserPassword_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "local_user_admin.proto",
+}
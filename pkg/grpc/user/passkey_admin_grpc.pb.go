@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.7
+// source: passkey_admin.proto
+
+package user
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PasskeyAdmin_CreatePasskeyEnrollment_FullMethodName = "/user.PasskeyAdmin/CreatePasskeyEnrollment"
+)
+
+// PasskeyAdminClient is the client API for PasskeyAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PasskeyAdmin manages users of the passkey identity provider.
+type PasskeyAdminClient interface {
+	// CreatePasskeyEnrollment creates or updates a user and returns a one-time
+	// link the user can use to enroll a passkey.
+	CreatePasskeyEnrollment(ctx context.Context, in *CreatePasskeyEnrollmentRequest, opts ...grpc.CallOption) (*CreatePasskeyEnrollmentResponse, error)
+}
+
+type passkeyAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPasskeyAdminClient(cc grpc.ClientConnInterface) PasskeyAdminClient {
+	return &passkeyAdminClient{cc}
+}
+
+func (c *passkeyAdminClient) CreatePasskeyEnrollment(ctx context.Context, in *CreatePasskeyEnrollmentRequest, opts ...grpc.CallOption) (*CreatePasskeyEnrollmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePasskeyEnrollmentResponse)
+	err := c.cc.Invoke(ctx, PasskeyAdmin_CreatePasskeyEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PasskeyAdminServer is the server API for PasskeyAdmin service.
+// All implementations should embed UnimplementedPasskeyAdminServer
+// for forward compatibility.
+//
+// PasskeyAdmin manages users of the passkey identity provider.
+type PasskeyAdminServer interface {
+	// CreatePasskeyEnrollment creates or updates a user and returns a one-time
+	// link the user can use to enroll a passkey.
+	CreatePasskeyEnrollment(context.Context, *CreatePasskeyEnrollmentRequest) (*CreatePasskeyEnrollmentResponse, error)
+}
+
+// UnimplementedPasskeyAdminServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPasskeyAdminServer struct{}
+
+func (UnimplementedPasskeyAdminServer) CreatePasskeyEnrollment(context.Context, *CreatePasskeyEnrollmentRequest) (*CreatePasskeyEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePasskeyEnrollment not implemented")
+}
+func (UnimplementedPasskeyAdminServer) testEmbeddedByValue() {}
+
+// UnsafePasskeyAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PasskeyAdminServer will
+// result in compilation errors.
+type UnsafePasskeyAdminServer interface {
+	mustEmbedUnimplementedPasskeyAdminServer()
+}
+
+func RegisterPasskeyAdminServer(s grpc.ServiceRegistrar, srv PasskeyAdminServer) {
+	// If the following call pancis, it indicates UnimplementedPasskeyAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PasskeyAdmin_ServiceDesc, srv)
+}
+
+func _PasskeyAdmin_CreatePasskeyEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePasskeyEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasskeyAdminServer).CreatePasskeyEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PasskeyAdmin_CreatePasskeyEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasskeyAdminServer).CreatePasskeyEnrollment(ctx, req.(*CreatePasskeyEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PasskeyAdmin_ServiceDesc is the grpc.ServiceDesc for PasskeyAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PasskeyAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.PasskeyAdmin",
+	HandlerType: (*PasskeyAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePasskeyEnrollment",
+			Handler:    _PasskeyAdmin_CreatePasskeyEnrollment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "passkey_admin.proto",
+}
@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.7
+// source: service_account_admin.proto
+
+package user
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ServiceAccountAdmin_CreateServiceAccount_FullMethodName  = "/user.ServiceAccountAdmin/CreateServiceAccount"
+	ServiceAccountAdmin_ListServiceAccounts_FullMethodName   = "/user.ServiceAccountAdmin/ListServiceAccounts"
+	ServiceAccountAdmin_RotateServiceAccount_FullMethodName  = "/user.ServiceAccountAdmin/RotateServiceAccount"
+	ServiceAccountAdmin_RevokeServiceAccounts_FullMethodName = "/user.ServiceAccountAdmin/RevokeServiceAccounts"
+)
+
+// ServiceAccountAdminClient is the client API for ServiceAccountAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ServiceAccountAdmin creates, lists, rotates and revokes service accounts.
+type ServiceAccountAdminClient interface {
+	// CreateServiceAccount creates a service account and returns its JWT.
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountWithToken, error)
+	// ListServiceAccounts returns the service accounts matching the request,
+	// least recently accessed first.
+	ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
+	// RotateServiceAccount replaces a service account with a new one with the
+	// same settings and returns its JWT. The JWT of the replaced service account
+	// stops working immediately.
+	RotateServiceAccount(ctx context.Context, in *RotateServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountWithToken, error)
+	// RevokeServiceAccounts deletes service accounts.
+	RevokeServiceAccounts(ctx context.Context, in *RevokeServiceAccountsRequest, opts ...grpc.CallOption) (*RevokeServiceAccountsResponse, error)
+}
+
+type serviceAccountAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceAccountAdminClient(cc grpc.ClientConnInterface) ServiceAccountAdminClient {
+	return &serviceAccountAdminClient{cc}
+}
+
+func (c *serviceAccountAdminClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountWithToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceAccountWithToken)
+	err := c.cc.Invoke(ctx, ServiceAccountAdmin_CreateServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountAdminClient) ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, ServiceAccountAdmin_ListServiceAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountAdminClient) RotateServiceAccount(ctx context.Context, in *RotateServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountWithToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceAccountWithToken)
+	err := c.cc.Invoke(ctx, ServiceAccountAdmin_RotateServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountAdminClient) RevokeServiceAccounts(ctx context.Context, in *RevokeServiceAccountsRequest, opts ...grpc.CallOption) (*RevokeServiceAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, ServiceAccountAdmin_RevokeServiceAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceAccountAdminServer is the server API for ServiceAccountAdmin service.
+// All implementations should embed UnimplementedServiceAccountAdminServer
+// for forward compatibility.
+//
+// ServiceAccountAdmin creates, lists, rotates and revokes service accounts.
+type ServiceAccountAdminServer interface {
+	// CreateServiceAccount creates a service account and returns its JWT.
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*ServiceAccountWithToken, error)
+	// ListServiceAccounts returns the service accounts matching the request,
+	// least recently accessed first.
+	ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error)
+	// RotateServiceAccount replaces a service account with a new one with the
+	// same settings and returns its JWT. The JWT of the replaced service account
+	// stops working immediately.
+	RotateServiceAccount(context.Context, *RotateServiceAccountRequest) (*ServiceAccountWithToken, error)
+	// RevokeServiceAccounts deletes service accounts.
+	RevokeServiceAccounts(context.Context, *RevokeServiceAccountsRequest) (*RevokeServiceAccountsResponse, error)
+}
+
+// UnimplementedServiceAccountAdminServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceAccountAdminServer struct{}
+
+func (UnimplementedServiceAccountAdminServer) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*ServiceAccountWithToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedServiceAccountAdminServer) ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedServiceAccountAdminServer) RotateServiceAccount(context.Context, *RotateServiceAccountRequest) (*ServiceAccountWithToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateServiceAccount not implemented")
+}
+func (UnimplementedServiceAccountAdminServer) RevokeServiceAccounts(context.Context, *RevokeServiceAccountsRequest) (*RevokeServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeServiceAccounts not implemented")
+}
+func (UnimplementedServiceAccountAdminServer) testEmbeddedByValue() {}
+
+// UnsafeServiceAccountAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceAccountAdminServer will
+// result in compilation errors.
+type UnsafeServiceAccountAdminServer interface {
+	mustEmbedUnimplementedServiceAccountAdminServer()
+}
+
+func RegisterServiceAccountAdminServer(s grpc.ServiceRegistrar, srv ServiceAccountAdminServer) {
+	// If the following call pancis, it indicates UnimplementedServiceAccountAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ServiceAccountAdmin_ServiceDesc, srv)
+}
+
+func _ServiceAccountAdmin_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountAdminServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountAdmin_CreateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountAdminServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountAdmin_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountAdminServer).ListServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountAdmin_ListServiceAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountAdminServer).ListServiceAccounts(ctx, req.(*ListServiceAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountAdmin_RotateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountAdminServer).RotateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountAdmin_RotateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountAdminServer).RotateServiceAccount(ctx, req.(*RotateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountAdmin_RevokeServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeServiceAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountAdminServer).RevokeServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountAdmin_RevokeServiceAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountAdminServer).RevokeServiceAccounts(ctx, req.(*RevokeServiceAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceAccountAdmin_ServiceDesc is the grpc.ServiceDesc for ServiceAccountAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceAccountAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.ServiceAccountAdmin",
+	HandlerType: (*ServiceAccountAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _ServiceAccountAdmin_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _ServiceAccountAdmin_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "RotateServiceAccount",
+			Handler:    _ServiceAccountAdmin_RotateServiceAccount_Handler,
+		},
+		{
+			MethodName: "RevokeServiceAccounts",
+			Handler:    _ServiceAccountAdmin_RevokeServiceAccounts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_account_admin.proto",
+}
@@ -31,6 +31,17 @@ func PutServiceAccount(ctx context.Context, client databroker.DataBrokerServiceC
 	return databroker.Put(ctx, client, serviceAccount)
 }
 
+// GetLocalUser gets the local identity provider credentials of a user from the databroker.
+func GetLocalUser(ctx context.Context, client databroker.DataBrokerServiceClient, userID string) (*LocalUser, error) {
+	lu := &LocalUser{Id: userID}
+	return lu, databroker.Get(ctx, client, lu)
+}
+
+// PutLocalUser saves the local identity provider credentials of a user to the databroker.
+func PutLocalUser(ctx context.Context, client databroker.DataBrokerServiceClient, localUser *LocalUser) (*databroker.PutResponse, error) {
+	return databroker.Put(ctx, client, localUser)
+}
+
 // ErrServiceAccountExpired indicates the service account has expired.
 var ErrServiceAccountExpired = fmt.Errorf("service account has expired")
 
@@ -14,6 +14,7 @@ var (
 
 type config struct {
 	authenticator                 Authenticator
+	authenticators                map[string]Authenticator
 	dataBrokerClient              databroker.DataBrokerServiceClient
 	sessionRefreshGracePeriod     time.Duration
 	sessionRefreshCoolOffDuration time.Duration
@@ -44,6 +45,14 @@ func WithAuthenticator(authenticator Authenticator) Option {
 	}
 }
 
+// WithIdentityProviderAuthenticators sets the authenticators, keyed by
+// identity provider id, used for sessions issued by named identity providers.
+func WithIdentityProviderAuthenticators(authenticators map[string]Authenticator) Option {
+	return func(cfg *config) {
+		cfg.authenticators = authenticators
+	}
+}
+
 // WithDataBrokerClient sets the databroker client in the config.
 func WithDataBrokerClient(dataBrokerClient databroker.DataBrokerServiceClient) Option {
 	return func(cfg *config) {
@@ -85,3 +94,12 @@ func WithEnabled(enabled bool) Option {
 		cfg.enabled = enabled
 	}
 }
+
+// getAuthenticator returns the authenticator for the given identity provider
+// id, falling back to the default authenticator.
+func (cfg *config) getAuthenticator(idpID string) Authenticator {
+	if authenticator, ok := cfg.authenticators[idpID]; ok {
+		return authenticator
+	}
+	return cfg.authenticator
+}
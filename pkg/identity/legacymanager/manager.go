@@ -232,7 +232,7 @@ func (mgr *Manager) refreshSession(ctx context.Context, userID, sessionID string
 func (mgr *Manager) refreshSessionInternal(
 	ctx context.Context, userID, sessionID string, s *Session,
 ) bool {
-	authenticator := mgr.cfg.Load().authenticator
+	authenticator := mgr.cfg.Load().getAuthenticator(s.GetIdpId())
 	if authenticator == nil {
 		log.Ctx(ctx).Info().
 			Str("user_id", userID).
@@ -317,11 +317,6 @@ func (mgr *Manager) refreshUser(ctx context.Context, userID string) {
 		Str("user_id", userID).
 		Msg("refreshing user")
 
-	authenticator := mgr.cfg.Load().authenticator
-	if authenticator == nil {
-		return
-	}
-
 	u, ok := mgr.users.Get(userID)
 	if !ok {
 		log.Ctx(ctx).Info().
@@ -340,6 +335,11 @@ func (mgr *Manager) refreshUser(ctx context.Context, userID string) {
 			continue
 		}
 
+		authenticator := mgr.cfg.Load().getAuthenticator(s.GetIdpId())
+		if authenticator == nil {
+			continue
+		}
+
 		err := authenticator.UpdateUserInfo(ctx, FromOAuthToken(s.OauthToken), &u)
 		metrics.RecordIdentityManagerUserRefresh(ctx, err)
 		mgr.recordLastError(metrics_ids.IdentityManagerLastUserRefreshError, err)
@@ -0,0 +1,103 @@
+// Package local implements sign-in with a username, password and optional
+// TOTP code against users stored in the databroker, without an upstream
+// identity provider.
+//
+// The sign-in form is served by the authenticate service at SignInPath, which
+// creates sessions directly rather than exchanging an authorization code at
+// the callback endpoint.
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/pkg/identity/identity"
+	"github.com/pomerium/pomerium/pkg/identity/oauth"
+	"github.com/pomerium/pomerium/pkg/identity/oidc"
+)
+
+// Name identifies the local identity provider.
+const Name = "local"
+
+const (
+	// SignInPath is the path of the local sign-in page.
+	SignInPath = "/.pomerium/local"
+
+	// TokenType is the token type of the oauth2 tokens of local sessions.
+	TokenType = "local"
+)
+
+const (
+	// MaxFailedAttempts is the number of consecutive failed sign-in attempts
+	// after which a user is locked out.
+	MaxFailedAttempts = 5
+	// LockoutDuration is how long a user is locked out for.
+	LockoutDuration = 15 * time.Minute
+)
+
+// ErrCodeNotSupported is returned when an authorization code is redeemed
+// with the local provider.
+var ErrCodeNotSupported = errors.New("identity/local: authorization codes are not supported")
+
+// Provider is the local identity provider.
+type Provider struct {
+	signInURL *url.URL
+}
+
+// New creates a new local identity provider.
+//
+// Only the RedirectURL option is used, to locate the authenticate service.
+func New(_ context.Context, o *oauth.Options) (*Provider, error) {
+	if o.RedirectURL == nil {
+		return nil, fmt.Errorf("identity/local: missing redirect url")
+	}
+	return &Provider{
+		signInURL: o.RedirectURL.ResolveReference(&url.URL{Path: SignInPath}),
+	}, nil
+}
+
+// Authenticate is not supported, local sessions are created by the
+// authenticate service once the password has been verified.
+func (p *Provider) Authenticate(_ context.Context, _ string, _ identity.State) (*oauth2.Token, error) {
+	return nil, ErrCodeNotSupported
+}
+
+// Refresh is a no-op for local users, sessions last until they expire.
+func (p *Provider) Refresh(_ context.Context, t *oauth2.Token, _ identity.State) (*oauth2.Token, error) {
+	return t, nil
+}
+
+// Revoke is not implemented for local users.
+func (p *Provider) Revoke(_ context.Context, _ *oauth2.Token) error {
+	return oidc.ErrRevokeNotImplemented
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	return Name
+}
+
+// UpdateUserInfo is a no-op for local users, the user info is stored in the databroker.
+func (p *Provider) UpdateUserInfo(_ context.Context, _ *oauth2.Token, _ any) error {
+	return nil
+}
+
+// SignIn redirects to the local sign-in page.
+func (p *Provider) SignIn(w http.ResponseWriter, r *http.Request, state string) error {
+	u := *p.signInURL
+	u.RawQuery = url.Values{"state": {state}}.Encode()
+	httputil.Redirect(w, r, u.String(), http.StatusFound)
+	return nil
+}
+
+// SignOut is not implemented for local users.
+func (p *Provider) SignOut(_ http.ResponseWriter, _ *http.Request, _, _, _ string) error {
+	return oidc.ErrSignoutNotImplemented
+}
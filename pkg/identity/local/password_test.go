@@ -0,0 +1,47 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPassword(t *testing.T) {
+	t.Parallel()
+
+	t.Run("argon2id", func(t *testing.T) {
+		hash, err := HashPassword("correct horse battery staple")
+		require.NoError(t, err)
+		assert.Regexp(t, `^\$argon2id\$v=19\$m=19456,t=2,p=1\$`, hash)
+		assert.NoError(t, ValidatePasswordHash(hash))
+		assert.True(t, VerifyPassword(hash, "correct horse battery staple"))
+		assert.False(t, VerifyPassword(hash, "Tr0ub4dor&3"))
+
+		other, err := HashPassword("correct horse battery staple")
+		require.NoError(t, err)
+		assert.NotEqual(t, hash, other, "should use a random salt")
+	})
+	t.Run("bcrypt", func(t *testing.T) {
+		hash, err := bcrypt.GenerateFromPassword([]byte("hunter2"), bcrypt.MinCost)
+		require.NoError(t, err)
+		assert.NoError(t, ValidatePasswordHash(string(hash)))
+		assert.True(t, VerifyPassword(string(hash), "hunter2"))
+		assert.False(t, VerifyPassword(string(hash), "hunter3"))
+	})
+	t.Run("invalid", func(t *testing.T) {
+		for _, hash := range []string{
+			"",
+			"hunter2",
+			"$argon2i$v=19$m=65536,t=3,p=4$c2FsdA$a2V5",
+			"$argon2id$v=16$m=65536,t=3,p=4$c2FsdA$a2V5",
+			"$argon2id$v=19$m=0,t=3,p=4$c2FsdA$a2V5",
+			"$argon2id$v=19$m=65536,t=3,p=4$c2FsdA$",
+			"$2a$10$invalid",
+		} {
+			assert.ErrorIs(t, ValidatePasswordHash(hash), ErrInvalidPasswordHash, hash)
+			assert.False(t, VerifyPassword(hash, ""), hash)
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package local
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTP(t *testing.T) {
+	t.Parallel()
+
+	// test vectors from RFC 6238, truncated to 6 digits
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	for _, tc := range []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	} {
+		step, valid := VerifyTOTP(secret, tc.code, time.Unix(tc.unix, 0), 0)
+		assert.True(t, valid, tc.unix)
+		assert.Equal(t, tc.unix/30, step, tc.unix)
+	}
+
+	now := time.Unix(1234567890, 0)
+	step, valid := VerifyTOTP(secret, "005924", now.Add(30*time.Second), 0)
+	assert.True(t, valid, "should allow clock drift")
+	assert.Equal(t, now.Unix()/30, step)
+	_, valid = VerifyTOTP(secret, "005924", now.Add(90*time.Second), 0)
+	assert.False(t, valid)
+	_, valid = VerifyTOTP(secret, "5924", now, 0)
+	assert.False(t, valid)
+	_, valid = VerifyTOTP("not base32!", "005924", now, 0)
+	assert.False(t, valid)
+
+	// a code can't be used again
+	_, valid = VerifyTOTP(secret, "005924", now, now.Unix()/30)
+	assert.False(t, valid, "should reject a replayed code")
+	_, valid = VerifyTOTP(secret, "005924", now.Add(30*time.Second), now.Unix()/30)
+	assert.False(t, valid, "should reject a replayed code within the skew window")
+	_, valid = VerifyTOTP(secret, "005924", now, now.Unix()/30-1)
+	assert.True(t, valid)
+
+	generated, err := GenerateTOTPSecret()
+	require.NoError(t, err)
+	assert.NoError(t, ValidateTOTPSecret(generated))
+	assert.Error(t, ValidateTOTPSecret("not base32!"))
+
+	u, err := url.Parse(TOTPURL("Pomerium", "alice@example.com", secret))
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/Pomerium:alice@example.com", u.Path)
+	assert.Equal(t, secret, u.Query().Get("secret"))
+	assert.Equal(t, "Pomerium", u.Query().Get("issuer"))
+}
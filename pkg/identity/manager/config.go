@@ -15,6 +15,7 @@ var (
 
 type config struct {
 	authenticator                 Authenticator
+	authenticators                map[string]Authenticator
 	dataBrokerClient              databroker.DataBrokerServiceClient
 	sessionRefreshGracePeriod     time.Duration
 	sessionRefreshCoolOffDuration time.Duration
@@ -47,6 +48,14 @@ func WithAuthenticator(authenticator Authenticator) Option {
 	}
 }
 
+// WithIdentityProviderAuthenticators sets the authenticators, keyed by
+// identity provider id, used for sessions issued by named identity providers.
+func WithIdentityProviderAuthenticators(authenticators map[string]Authenticator) Option {
+	return func(cfg *config) {
+		cfg.authenticators = authenticators
+	}
+}
+
 // WithDataBrokerClient sets the databroker client in the config.
 func WithDataBrokerClient(dataBrokerClient databroker.DataBrokerServiceClient) Option {
 	return func(cfg *config) {
@@ -95,3 +104,12 @@ func WithUpdateUserInfoInterval(dur time.Duration) Option {
 		cfg.updateUserInfoInterval = dur
 	}
 }
+
+// getAuthenticator returns the authenticator for the given identity provider
+// id, falling back to the default authenticator.
+func (cfg *config) getAuthenticator(idpID string) Authenticator {
+	if authenticator, ok := cfg.authenticators[idpID]; ok {
+		return authenticator
+	}
+	return cfg.authenticator
+}
@@ -206,7 +206,7 @@ func (mgr *Manager) refreshSession(ctx context.Context, sessionID string) {
 		return
 	}
 
-	authenticator := mgr.cfg.Load().authenticator
+	authenticator := mgr.cfg.Load().getAuthenticator(s.GetIdpId())
 	if authenticator == nil {
 		log.Ctx(ctx).Info().
 			Str("user_id", s.GetUserId()).
@@ -280,11 +280,6 @@ func (mgr *Manager) refreshSession(ctx context.Context, sessionID string) {
 func (mgr *Manager) updateUserInfo(ctx context.Context, userID string) {
 	log.Ctx(ctx).Info().Str("user_id", userID).Msg("updating user info")
 
-	authenticator := mgr.cfg.Load().authenticator
-	if authenticator == nil {
-		return
-	}
-
 	mgr.mu.Lock()
 	u, ss := mgr.dataStore.getUserAndSessions(userID)
 	mgr.mu.Unlock()
@@ -305,6 +300,11 @@ func (mgr *Manager) updateUserInfo(ctx context.Context, userID string) {
 			continue
 		}
 
+		authenticator := mgr.cfg.Load().getAuthenticator(s.GetIdpId())
+		if authenticator == nil {
+			continue
+		}
+
 		err := authenticator.UpdateUserInfo(ctx, FromOAuthToken(s.GetOauthToken()), newUserUnmarshaler(u))
 		metrics.RecordIdentityManagerUserRefresh(ctx, err)
 		mgr.recordLastError(metrics_ids.IdentityManagerLastUserRefreshError, err)
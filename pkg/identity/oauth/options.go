@@ -29,4 +29,15 @@ type Options struct {
 	// AuthCodeOptions specifies additional key value pairs query params to add
 	// to the request flow signin url.
 	AuthCodeOptions map[string]string
+
+	// PushedAuthorizationRequests sends authorization requests to the
+	// provider's pushed authorization request endpoint. They are always
+	// pushed when the provider requires it.
+	PushedAuthorizationRequests *bool
+
+	// SAMLKeyPair is the PEM encoded private key and certificate used by a
+	// SAML service provider to sign requests and decrypt assertions.
+	SAMLKeyPair string
+	// SAMLAttributes maps claim names to SAML attribute names.
+	SAMLAttributes map[string]string
 }
@@ -43,10 +43,24 @@ type Provider struct {
 	// https://openid.net/specs/openid-connect-frontchannel-1_0.html#RPInitiated
 	EndSessionURL string `json:"end_session_endpoint,omitempty"`
 
+	// PushedAuthorizationRequestURL is the location of the pushed
+	// authorization request endpoint. If pushed authorization requests are
+	// used, authorization requests are pushed to it rather than sent in the
+	// sign in redirect.
+	// https://datatracker.ietf.org/doc/html/rfc9126
+	PushedAuthorizationRequestURL string `json:"pushed_authorization_request_endpoint,omitempty"`
+	// RequirePushedAuthorizationRequests is true if the provider only accepts
+	// pushed authorization requests.
+	RequirePushedAuthorizationRequests bool `json:"require_pushed_authorization_requests,omitempty"`
+
 	// AuthCodeOptions specifies additional key value pairs query params to add
 	// to the request flow signin url.
 	AuthCodeOptions map[string]string
 
+	// pushedAuthorizationRequests enables or disables pushed authorization
+	// requests. If nil, they are only used if the provider requires them.
+	pushedAuthorizationRequests *bool
+
 	mu       sync.Mutex
 	provider *go_oidc.Provider
 }
@@ -64,6 +78,7 @@ func New(ctx context.Context, o *oauth.Options, options ...Option) (*Provider, e
 	if len(o.AuthCodeOptions) != 0 {
 		p.AuthCodeOptions = o.AuthCodeOptions
 	}
+	p.pushedAuthorizationRequests = o.PushedAuthorizationRequests
 
 	p.cfg = getConfig(append([]Option{
 		WithGetOauthConfig(func(provider *go_oidc.Provider) *oauth2.Config {
@@ -112,11 +127,70 @@ func (p *Provider) SignIn(w http.ResponseWriter, r *http.Request, state string)
 	for k, v := range p.AuthCodeOptions {
 		opts = append(opts, oauth2.SetAuthURLParam(k, v))
 	}
+	opts = append(opts, reauthenticationAuthCodeOptions(r)...)
+	if opt, ok := pkceAuthCodeOption(r.Context()); ok {
+		opts = append(opts, opt)
+	}
 	signInURL := oa.AuthCodeURL(state, opts...)
+
+	if p.usePushedAuthorizationRequests() {
+		if p.PushedAuthorizationRequestURL == "" {
+			return errors.New("identity/oidc: pushed authorization requests are enabled, but the provider has no pushed_authorization_request_endpoint")
+		}
+		signInURL, err = pushAuthorizationRequest(r.Context(), oa, p.PushedAuthorizationRequestURL, signInURL)
+		if err != nil {
+			return fmt.Errorf("identity/oidc: pushed authorization request failed: %w", err)
+		}
+	}
+
 	httputil.Redirect(w, r, signInURL, http.StatusFound)
 	return nil
 }
 
+type signInRequestParamsKey struct{}
+
+// WithSignInRequestParams returns a new context with the params of the sign in
+// request, for when they aren't the request's query params, e.g. because they
+// were encrypted.
+func WithSignInRequestParams(ctx context.Context, params url.Values) context.Context {
+	return context.WithValue(ctx, signInRequestParamsKey{}, params)
+}
+
+// usePushedAuthorizationRequests returns true if authorization requests should
+// be pushed to the provider. They are always pushed if the provider requires
+// it. Otherwise they are only pushed if configured, since many providers
+// advertise the endpoint by default.
+func (p *Provider) usePushedAuthorizationRequests() bool {
+	if p.RequirePushedAuthorizationRequests {
+		return true
+	}
+	return p.pushedAuthorizationRequests != nil && *p.pushedAuthorizationRequests
+}
+
+// reauthenticationAuthCodeOptions returns the options used to ask the
+// provider to re-authenticate the user, as requested by the authorize service.
+//
+// https://openid.net/specs/openid-connect-core-1_0.html#AuthRequest
+func reauthenticationAuthCodeOptions(r *http.Request) []oauth2.AuthCodeOption {
+	params, ok := r.Context().Value(signInRequestParamsKey{}).(url.Values)
+	if !ok {
+		_ = r.ParseForm()
+		params = r.Form
+	}
+
+	var opts []oauth2.AuthCodeOption
+	for param, queryParam := range map[string]string{
+		"prompt":     urlutil.QueryPrompt,
+		"max_age":    urlutil.QueryMaxAge,
+		"acr_values": urlutil.QueryACRValues,
+	} {
+		if v := params.Get(queryParam); v != "" {
+			opts = append(opts, oauth2.SetAuthURLParam(param, v))
+		}
+	}
+	return opts
+}
+
 // Authenticate converts an authorization code returned from the identity
 // provider into a token which is then converted into a user session.
 func (p *Provider) Authenticate(ctx context.Context, code string, v identity.State) (*oauth2.Token, error) {
@@ -125,8 +199,13 @@ func (p *Provider) Authenticate(ctx context.Context, code string, v identity.Sta
 		return nil, err
 	}
 
+	var opts []oauth2.AuthCodeOption
+	if opt, ok := pkceExchangeOption(ctx); ok {
+		opts = append(opts, opt)
+	}
+
 	// Exchange converts an authorization code into a token.
-	oauth2Token, err := oa.Exchange(ctx, code)
+	oauth2Token, err := oa.Exchange(ctx, code, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("identity/oidc: token exchange failed: %w", err)
 	}
@@ -236,21 +315,48 @@ func (p *Provider) Revoke(ctx context.Context, t *oauth2.Token) error {
 		return ErrMissingAccessToken
 	}
 
+	err = p.revoke(ctx, oa, t.AccessToken, "access_token")
+	if err != nil && errors.Is(err, httputil.ErrTokenRevoked) {
+		return fmt.Errorf("internal/oidc: unexpected revoke error: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeToken revokes a single access or refresh token, as given by the token
+// type hint. Unlike Revoke, errors from the identity provider are returned,
+// other than the token already being expired or revoked.
+//
+// https://tools.ietf.org/html/rfc7009#section-2.1
+func (p *Provider) RevokeToken(ctx context.Context, token, tokenTypeHint string) error {
+	oa, err := p.GetOauthConfig()
+	if err != nil {
+		return err
+	}
+
+	if p.RevocationURL == "" {
+		return ErrRevokeNotImplemented
+	}
+
+	err = p.revoke(ctx, oa, token, tokenTypeHint)
+	if err != nil && !errors.Is(err, httputil.ErrTokenRevoked) {
+		return fmt.Errorf("identity/oidc: error revoking %s: %w", tokenTypeHint, err)
+	}
+
+	return nil
+}
+
+func (p *Provider) revoke(ctx context.Context, oa *oauth2.Config, token, tokenTypeHint string) error {
 	params := url.Values{}
-	params.Add("token", t.AccessToken)
-	params.Add("token_type_hint", "access_token")
+	params.Add("token", token)
+	params.Add("token_type_hint", tokenTypeHint)
 	// Some providers like okta / onelogin require "client authentication"
 	// https://developer.okta.com/docs/reference/api/oidc/#client-secret
 	// https://developers.onelogin.com/openid-connect/api/revoke-session
 	params.Add("client_id", oa.ClientID)
 	params.Add("client_secret", oa.ClientSecret)
 
-	err = httputil.Do(ctx, http.MethodPost, p.RevocationURL, version.UserAgent(), nil, params, nil)
-	if err != nil && errors.Is(err, httputil.ErrTokenRevoked) {
-		return fmt.Errorf("internal/oidc: unexpected revoke error: %w", err)
-	}
-
-	return nil
+	return httputil.Do(ctx, http.MethodPost, p.RevocationURL, version.UserAgent(), nil, params, nil)
 }
 
 // Name returns the provider name.
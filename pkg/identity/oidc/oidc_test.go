@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/pomerium/pomerium/pkg/identity/oauth"
 )
@@ -88,6 +90,139 @@ func TestSignIn(t *testing.T) {
 		"scope":         {"openid profile email offline_access"},
 		"state":         {"STATE"},
 	}, location.Query())
+
+	t.Run("reauthenticate", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		err = p.SignIn(rec, httptest.NewRequest(http.MethodGet,
+			"/?pomerium_prompt=login&pomerium_max_age=900&pomerium_acr_values=phr+phrh", nil), "STATE")
+		require.NoError(t, err)
+		location, _ := url.Parse(rec.Result().Header.Get("Location"))
+		assert.Equal(t, "login", location.Query().Get("prompt"))
+		assert.Equal(t, "900", location.Query().Get("max_age"))
+		assert.Equal(t, "phr phrh", location.Query().Get("acr_values"))
+	})
+	t.Run("reauthenticate with sign in request params", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r = r.WithContext(WithSignInRequestParams(r.Context(), url.Values{
+			"pomerium_prompt":  {"login"},
+			"pomerium_max_age": {"900"},
+		}))
+		rec := httptest.NewRecorder()
+		err = p.SignIn(rec, r, "STATE")
+		require.NoError(t, err)
+		location, _ := url.Parse(rec.Result().Header.Get("Location"))
+		assert.Equal(t, "login", location.Query().Get("prompt"))
+		assert.Equal(t, "900", location.Query().Get("max_age"))
+	})
+	t.Run("pkce", func(t *testing.T) {
+		// example from RFC 7636 appendix B
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r = r.WithContext(WithPKCEVerifier(r.Context(), "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+		rec := httptest.NewRecorder()
+		err = p.SignIn(rec, r, "STATE")
+		require.NoError(t, err)
+		location, _ := url.Parse(rec.Result().Header.Get("Location"))
+		assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", location.Query().Get("code_challenge"))
+		assert.Equal(t, "S256", location.Query().Get("code_challenge_method"))
+	})
+}
+
+func TestSignIn_PushedAuthorizationRequest(t *testing.T) {
+	ctx, clearTimeout := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(clearTimeout)
+
+	redirectURL, _ := url.Parse("https://localhost/oauth2/callback")
+
+	var requirePAR atomic.Bool
+	var srv *httptest.Server
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		baseURL, err := url.Parse(srv.URL)
+		require.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			json.NewEncoder(w).Encode(map[string]any{
+				"issuer": baseURL.String(),
+				"authorization_endpoint": baseURL.ResolveReference(&url.URL{
+					Path: "/login",
+				}).String(),
+				"pushed_authorization_request_endpoint": baseURL.ResolveReference(&url.URL{
+					Path: "/par",
+				}).String(),
+				"require_pushed_authorization_requests": requirePAR.Load(),
+			})
+		case "/par":
+			assert.Equal(t, http.MethodPost, r.Method)
+			username, password, _ := r.BasicAuth()
+			assert.Equal(t, "CLIENT_ID", username)
+			assert.Equal(t, "CLIENT_SECRET", password)
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, url.Values{
+				"client_id":             {"CLIENT_ID"},
+				"code_challenge":        {"E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"},
+				"code_challenge_method": {"S256"},
+				"redirect_uri":          {"https://localhost/oauth2/callback"},
+				"response_type":         {"code"},
+				"scope":                 {"openid profile email offline_access"},
+				"state":                 {"STATE"},
+			}, r.PostForm)
+
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]any{
+				"request_uri": "urn:ietf:params:oauth:request_uri:REQUEST",
+				"expires_in":  60,
+			})
+		default:
+			assert.Failf(t, "unexpected http request", "url: %s", r.URL.String())
+		}
+	})
+	srv = httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	signIn := func(t *testing.T, pushedAuthorizationRequests *bool) url.Values {
+		t.Helper()
+
+		p, err := New(ctx, &oauth.Options{
+			ProviderURL:                 srv.URL,
+			RedirectURL:                 redirectURL,
+			ClientID:                    "CLIENT_ID",
+			ClientSecret:                "CLIENT_SECRET",
+			PushedAuthorizationRequests: pushedAuthorizationRequests,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, p)
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r = r.WithContext(WithPKCEVerifier(r.Context(), "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+		rec := httptest.NewRecorder()
+		err = p.SignIn(rec, r, "STATE")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusFound, rec.Result().StatusCode)
+		location, _ := url.Parse(rec.Result().Header.Get("Location"))
+		assert.Equal(t, "/login", location.Path)
+		return location.Query()
+	}
+	pushed := url.Values{
+		"client_id":   {"CLIENT_ID"},
+		"request_uri": {"urn:ietf:params:oauth:request_uri:REQUEST"},
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		assert.Equal(t, pushed, signIn(t, proto.Bool(true)))
+	})
+	t.Run("not required", func(t *testing.T) {
+		assert.Equal(t, "STATE", signIn(t, nil).Get("state"),
+			"should not push authorization requests only because the endpoint is advertised")
+	})
+	t.Run("required", func(t *testing.T) {
+		requirePAR.Store(true)
+		t.Cleanup(func() { requirePAR.Store(false) })
+
+		assert.Equal(t, pushed, signIn(t, nil))
+		assert.Equal(t, pushed, signIn(t, proto.Bool(false)),
+			"should push authorization requests when required by the provider")
+	})
 }
 
 func TestSignOut(t *testing.T) {
@@ -154,6 +289,7 @@ func TestAuthenticate(t *testing.T) {
 	jti := uuid.NewString()
 
 	var expectedIDToken string
+	pkceVerifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
 
 	var srv *httptest.Server
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -184,6 +320,7 @@ func TestAuthenticate(t *testing.T) {
 			assert.Equal(t, "authorization_code", r.FormValue("grant_type"))
 			assert.Equal(t, "CODE", r.FormValue("code"))
 			assert.Equal(t, redirectURL.String(), r.FormValue("redirect_uri"))
+			assert.Equal(t, pkceVerifier, r.FormValue("code_verifier"))
 
 			idToken, err := jwt.Signed(jwtSigner).Claims(jwt.Claims{
 				Issuer:    srv.URL,
@@ -229,7 +366,7 @@ func TestAuthenticate(t *testing.T) {
 	require.NotNil(t, p)
 
 	var claims Claims
-	oauthToken, err := p.Authenticate(ctx, "CODE", &claims)
+	oauthToken, err := p.Authenticate(WithPKCEVerifier(ctx, pkceVerifier), "CODE", &claims)
 	require.NoError(t, err)
 	assert.Equal(t, "ACCESS_TOKEN", oauthToken.AccessToken)
 	assert.Equal(t, "REFRESH_TOKEN", oauthToken.RefreshToken)
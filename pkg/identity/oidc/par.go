@@ -0,0 +1,86 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/oauth2"
+
+	"github.com/pomerium/pomerium/internal/version"
+)
+
+// pushAuthorizationRequest sends the parameters of the authorization request
+// to the pushed authorization request endpoint, and returns the URL of the
+// authorization endpoint referencing the pushed request.
+//
+// https://datatracker.ietf.org/doc/html/rfc9126
+func pushAuthorizationRequest(ctx context.Context, oa *oauth2.Config, parURL, authCodeURL string) (string, error) {
+	u, err := url.Parse(authCodeURL)
+	if err != nil {
+		return "", err
+	}
+	params := u.Query()
+
+	// authenticate the client in the same way as for the token endpoint
+	// https://datatracker.ietf.org/doc/html/rfc9126#section-2
+	useBasicAuth := oa.Endpoint.AuthStyle != oauth2.AuthStyleInParams && oa.ClientSecret != ""
+	if !useBasicAuth && oa.ClientSecret != "" {
+		params.Set("client_secret", oa.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, parURL, strings.NewReader(params.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", version.UserAgent())
+	if useBasicAuth {
+		req.SetBasicAuth(url.QueryEscape(oa.ClientID), url.QueryEscape(oa.ClientSecret))
+	}
+
+	client := http.DefaultClient
+	if c, ok := ctx.Value(oauth2.HTTPClient).(*http.Client); ok {
+		client = c
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusOK {
+		var parErr struct {
+			Error            string `json:"error"`
+			ErrorDescription string `json:"error_description"`
+		}
+		if json.Unmarshal(body, &parErr) == nil && parErr.Error != "" {
+			return "", fmt.Errorf("unexpected status code %d: %s: %s", res.StatusCode, parErr.Error, parErr.ErrorDescription)
+		}
+		return "", fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	var parRes struct {
+		RequestURI string `json:"request_uri"`
+	}
+	if err := json.Unmarshal(body, &parRes); err != nil {
+		return "", fmt.Errorf("invalid response: %w", err)
+	}
+	if parRes.RequestURI == "" {
+		return "", fmt.Errorf("invalid response: missing request_uri")
+	}
+
+	u.RawQuery = url.Values{
+		"client_id":   {oa.ClientID},
+		"request_uri": {parRes.RequestURI},
+	}.Encode()
+	return u.String(), nil
+}
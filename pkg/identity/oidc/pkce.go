@@ -0,0 +1,39 @@
+package oidc
+
+import (
+	"context"
+
+	"golang.org/x/oauth2"
+)
+
+type pkceVerifierKey struct{}
+
+// WithPKCEVerifier returns a new context with the PKCE code verifier for the
+// sign in. SignIn sends the S256 challenge of the verifier in the
+// authorization request, and Authenticate sends the verifier when exchanging
+// the authorization code, so the same verifier must be used for both.
+//
+// https://datatracker.ietf.org/doc/html/rfc7636
+func WithPKCEVerifier(ctx context.Context, verifier string) context.Context {
+	return context.WithValue(ctx, pkceVerifierKey{}, verifier)
+}
+
+// pkceAuthCodeOption returns the auth code option that sends the S256
+// challenge of the verifier in the context, if any.
+func pkceAuthCodeOption(ctx context.Context) (oauth2.AuthCodeOption, bool) {
+	verifier, ok := ctx.Value(pkceVerifierKey{}).(string)
+	if !ok || verifier == "" {
+		return nil, false
+	}
+	return oauth2.S256ChallengeOption(verifier), true
+}
+
+// pkceExchangeOption returns the auth code option that sends the verifier in
+// the context, if any, when exchanging the authorization code.
+func pkceExchangeOption(ctx context.Context) (oauth2.AuthCodeOption, bool) {
+	verifier, ok := ctx.Value(pkceVerifierKey{}).(string)
+	if !ok || verifier == "" {
+		return nil, false
+	}
+	return oauth2.VerifierOption(verifier), true
+}
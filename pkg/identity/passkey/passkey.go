@@ -0,0 +1,96 @@
+// Package passkey implements sign-in with passkeys, without an upstream
+// identity provider.
+//
+// Users and their passkeys are stored in the databroker. The WebAuthn
+// ceremonies are handled by the authenticate service at SignInPath and
+// EnrollPath, which create sessions directly rather than exchanging an
+// authorization code at the callback endpoint.
+package passkey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/oauth2"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/pkg/identity/identity"
+	"github.com/pomerium/pomerium/pkg/identity/oauth"
+	"github.com/pomerium/pomerium/pkg/identity/oidc"
+)
+
+// Name identifies the passkey identity provider.
+const Name = "passkey"
+
+const (
+	// SignInPath is the path of the passkey sign-in page.
+	SignInPath = "/.pomerium/passkey"
+	// EnrollPath is the path of the passkey enrollment page.
+	EnrollPath = "/.pomerium/passkey/enroll"
+
+	// TokenType is the token type of the oauth2 tokens of passkey sessions.
+	TokenType = "passkey"
+)
+
+// ErrCodeNotSupported is returned when an authorization code is redeemed
+// with the passkey provider.
+var ErrCodeNotSupported = errors.New("identity/passkey: authorization codes are not supported")
+
+// Provider is the passkey identity provider.
+type Provider struct {
+	signInURL *url.URL
+}
+
+// New creates a new passkey identity provider.
+//
+// Only the RedirectURL option is used, to locate the authenticate service.
+func New(_ context.Context, o *oauth.Options) (*Provider, error) {
+	if o.RedirectURL == nil {
+		return nil, fmt.Errorf("identity/passkey: missing redirect url")
+	}
+	return &Provider{
+		signInURL: o.RedirectURL.ResolveReference(&url.URL{Path: SignInPath}),
+	}, nil
+}
+
+// Authenticate is not supported, passkey sessions are created by the
+// authenticate service once the passkey has been verified.
+func (p *Provider) Authenticate(_ context.Context, _ string, _ identity.State) (*oauth2.Token, error) {
+	return nil, ErrCodeNotSupported
+}
+
+// Refresh is a no-op for passkeys, sessions last until they expire.
+func (p *Provider) Refresh(_ context.Context, t *oauth2.Token, _ identity.State) (*oauth2.Token, error) {
+	return t, nil
+}
+
+// Revoke is not implemented for passkeys.
+func (p *Provider) Revoke(_ context.Context, _ *oauth2.Token) error {
+	return oidc.ErrRevokeNotImplemented
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	return Name
+}
+
+// UpdateUserInfo is a no-op for passkeys, the user info is stored in the databroker.
+func (p *Provider) UpdateUserInfo(_ context.Context, _ *oauth2.Token, _ any) error {
+	return nil
+}
+
+// SignIn redirects to the passkey sign-in page.
+func (p *Provider) SignIn(w http.ResponseWriter, r *http.Request, state string) error {
+	u := *p.signInURL
+	u.RawQuery = url.Values{"state": {state}}.Encode()
+	httputil.Redirect(w, r, u.String(), http.StatusFound)
+	return nil
+}
+
+// SignOut is not implemented for passkeys.
+func (p *Provider) SignOut(_ http.ResponseWriter, _ *http.Request, _, _, _ string) error {
+	return oidc.ErrSignoutNotImplemented
+}
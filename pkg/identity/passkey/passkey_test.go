@@ -0,0 +1,40 @@
+package passkey
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/pkg/identity/oauth"
+)
+
+func TestProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	_, err := New(ctx, &oauth.Options{})
+	assert.Error(t, err)
+
+	p, err := New(ctx, &oauth.Options{
+		RedirectURL: &url.URL{Scheme: "https", Host: "authenticate.example.com", Path: "/oauth2/callback"},
+	})
+	require.NoError(t, err)
+
+	t.Run("sign in", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "https://authenticate.example.com/.pomerium/sign_in", nil)
+		require.NoError(t, p.SignIn(w, r, "STATE"))
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, "https://authenticate.example.com/.pomerium/passkey?state=STATE", w.Header().Get("Location"))
+	})
+	t.Run("authenticate", func(t *testing.T) {
+		_, err := p.Authenticate(ctx, "CODE", nil)
+		assert.ErrorIs(t, err, ErrCodeNotSupported)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"golang.org/x/oauth2"
 
 	"github.com/pomerium/pomerium/pkg/identity/identity"
+	"github.com/pomerium/pomerium/pkg/identity/local"
 	"github.com/pomerium/pomerium/pkg/identity/oauth"
 	"github.com/pomerium/pomerium/pkg/identity/oauth/apple"
 	"github.com/pomerium/pomerium/pkg/identity/oauth/github"
@@ -22,6 +23,8 @@ import (
 	"github.com/pomerium/pomerium/pkg/identity/oidc/okta"
 	"github.com/pomerium/pomerium/pkg/identity/oidc/onelogin"
 	"github.com/pomerium/pomerium/pkg/identity/oidc/ping"
+	"github.com/pomerium/pomerium/pkg/identity/passkey"
+	"github.com/pomerium/pomerium/pkg/identity/saml"
 )
 
 // State is the identity state.
@@ -57,10 +60,13 @@ func init() {
 	RegisterAuthenticator(github.Name, func(ctx context.Context, o *oauth.Options) (Authenticator, error) { return github.New(ctx, o) })
 	RegisterAuthenticator(gitlab.Name, func(ctx context.Context, o *oauth.Options) (Authenticator, error) { return gitlab.New(ctx, o) })
 	RegisterAuthenticator(google.Name, func(ctx context.Context, o *oauth.Options) (Authenticator, error) { return google.New(ctx, o) })
+	RegisterAuthenticator(local.Name, func(ctx context.Context, o *oauth.Options) (Authenticator, error) { return local.New(ctx, o) })
 	RegisterAuthenticator(oidc.Name, func(ctx context.Context, o *oauth.Options) (Authenticator, error) { return oidc.New(ctx, o) })
 	RegisterAuthenticator(okta.Name, func(ctx context.Context, o *oauth.Options) (Authenticator, error) { return okta.New(ctx, o) })
 	RegisterAuthenticator(onelogin.Name, func(ctx context.Context, o *oauth.Options) (Authenticator, error) { return onelogin.New(ctx, o) })
+	RegisterAuthenticator(passkey.Name, func(ctx context.Context, o *oauth.Options) (Authenticator, error) { return passkey.New(ctx, o) })
 	RegisterAuthenticator(ping.Name, func(ctx context.Context, o *oauth.Options) (Authenticator, error) { return ping.New(ctx, o) })
+	RegisterAuthenticator(saml.Name, func(ctx context.Context, o *oauth.Options) (Authenticator, error) { return saml.New(ctx, o) })
 }
 
 // NewAuthenticator returns a new identity provider based on its name.
@@ -0,0 +1,115 @@
+package saml
+
+import (
+	"github.com/crewjam/saml"
+)
+
+// defaultAttributeNames maps well-known claims to the attribute names commonly
+// used by identity providers to convey them.
+var defaultAttributeNames = map[string][]string{
+	"email": {
+		"email",
+		"mail",
+		"emailAddress",
+		"urn:oid:0.9.2342.19200300.100.1.3",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+	},
+	"name": {
+		"name",
+		"displayName",
+		"cn",
+		"urn:oid:2.16.840.1.113730.3.1.241",
+		"urn:oid:2.5.4.3",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name",
+	},
+	"given_name": {
+		"given_name",
+		"givenName",
+		"firstName",
+		"urn:oid:2.5.4.42",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname",
+	},
+	"family_name": {
+		"family_name",
+		"sn",
+		"surname",
+		"lastName",
+		"urn:oid:2.5.4.4",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname",
+	},
+	"groups": {
+		"groups",
+		"memberOf",
+		"eduPersonAffiliation",
+		"urn:oid:1.3.6.1.4.1.5923.1.1.1.1",
+		"http://schemas.microsoft.com/ws/2008/06/identity/claims/groups",
+		"http://schemas.microsoft.com/ws/2008/06/identity/claims/role",
+	},
+}
+
+// multiValuedClaims are claims which are always represented as a list.
+var multiValuedClaims = map[string]bool{
+	"groups": true,
+}
+
+// claimsFromAssertion converts the attributes in a SAML assertion into a set of claims.
+//
+// Every attribute is added as a claim keyed by its friendly name (or its name if no
+// friendly name is set). Well-known claims (email, name, groups, ...) are then populated
+// from the first matching attribute, using attributeNames to override the defaults.
+func claimsFromAssertion(assertion *saml.Assertion, attributeNames map[string]string) map[string]any {
+	claims := map[string]any{}
+
+	attributes := map[string][]string{}
+	for _, stmt := range assertion.AttributeStatements {
+		for _, attr := range stmt.Attributes {
+			var values []string
+			for _, v := range attr.Values {
+				values = append(values, v.Value)
+			}
+			for _, name := range []string{attr.Name, attr.FriendlyName} {
+				if name == "" {
+					continue
+				}
+				attributes[name] = append(attributes[name], values...)
+			}
+
+			key := attr.FriendlyName
+			if key == "" {
+				key = attr.Name
+			}
+			claims[key] = claimValue(attributes[key], false)
+		}
+	}
+
+	for claim, names := range defaultAttributeNames {
+		if override, ok := attributeNames[claim]; ok {
+			names = []string{override}
+		}
+		for _, name := range names {
+			if values, ok := attributes[name]; ok && len(values) > 0 {
+				claims[claim] = claimValue(values, multiValuedClaims[claim])
+				break
+			}
+		}
+	}
+
+	// custom mappings for claims which aren't well-known
+	for claim, name := range attributeNames {
+		if _, ok := defaultAttributeNames[claim]; ok {
+			continue
+		}
+		if values, ok := attributes[name]; ok && len(values) > 0 {
+			claims[claim] = claimValue(values, false)
+		}
+	}
+
+	return claims
+}
+
+func claimValue(values []string, multi bool) any {
+	if len(values) == 1 && !multi {
+		return values[0]
+	}
+	return values
+}
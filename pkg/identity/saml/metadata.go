@@ -0,0 +1,96 @@
+package saml
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/crewjam/saml"
+
+	"github.com/pomerium/pomerium/internal/version"
+)
+
+const (
+	// maxMetadataSize is the maximum size of an identity provider metadata document.
+	maxMetadataSize = 10 << 20
+
+	// defaultMetadataRefreshInterval is how long identity provider metadata is
+	// cached for, unless its cacheDuration or validUntil is sooner.
+	defaultMetadataRefreshInterval = 24 * time.Hour
+	// minMetadataRefreshInterval is how often identity provider metadata may be
+	// refreshed when a SAML response can't be verified.
+	minMetadataRefreshInterval = 5 * time.Minute
+)
+
+// fetchIDPMetadata retrieves and parses the identity provider metadata from the given url.
+func fetchIDPMetadata(ctx context.Context, client *http.Client, metadataURL string) (*saml.EntityDescriptor, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", version.UserAgent())
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(res.Body, maxMetadataSize))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseIDPMetadata(raw)
+}
+
+// parseIDPMetadata parses identity provider metadata. Both a single EntityDescriptor
+// and an EntitiesDescriptor containing an identity provider are supported.
+func parseIDPMetadata(raw []byte) (*saml.EntityDescriptor, error) {
+	var ed saml.EntityDescriptor
+	if err := xml.Unmarshal(raw, &ed); err == nil {
+		if len(ed.IDPSSODescriptors) == 0 {
+			return nil, fmt.Errorf("no IDPSSODescriptor found in metadata")
+		}
+		return &ed, nil
+	}
+
+	var eds saml.EntitiesDescriptor
+	if err := xml.Unmarshal(raw, &eds); err != nil {
+		return nil, fmt.Errorf("invalid metadata: %w", err)
+	}
+	for i := range eds.EntityDescriptors {
+		ed := &eds.EntityDescriptors[i]
+		if len(ed.IDPSSODescriptors) > 0 {
+			// the entity inherits the caching of the document
+			if ed.ValidUntil.IsZero() && eds.ValidUntil != nil {
+				ed.ValidUntil = *eds.ValidUntil
+			}
+			if ed.CacheDuration == 0 && eds.CacheDuration != nil {
+				ed.CacheDuration = *eds.CacheDuration
+			}
+			return ed, nil
+		}
+	}
+	return nil, fmt.Errorf("no IDPSSODescriptor found in metadata")
+}
+
+// metadataExpiry returns when identity provider metadata fetched at the given
+// time should be refreshed, honoring its cacheDuration and validUntil.
+func metadataExpiry(md *saml.EntityDescriptor, fetchedAt time.Time) time.Time {
+	expiry := fetchedAt.Add(defaultMetadataRefreshInterval)
+	if md.CacheDuration > 0 && md.CacheDuration < defaultMetadataRefreshInterval {
+		expiry = fetchedAt.Add(md.CacheDuration)
+	}
+	if !md.ValidUntil.IsZero() && md.ValidUntil.Before(expiry) {
+		expiry = md.ValidUntil
+	}
+	return expiry
+}
@@ -0,0 +1,72 @@
+package saml
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/crewjam/saml"
+)
+
+// ErrAssertionReplayed is returned when an assertion has already been consumed.
+var ErrAssertionReplayed = errors.New("identity/saml: assertion has already been used")
+
+// errMissingReplayCache is returned when no assertion replay cache is in the context.
+var errMissingReplayCache = errors.New("identity/saml: missing assertion replay cache")
+
+// An AssertionReplayCache records the assertions that have been consumed.
+type AssertionReplayCache interface {
+	// Consume records the assertion with the given key until expiresAt. It
+	// returns false if the assertion has already been consumed.
+	Consume(ctx context.Context, key string, expiresAt time.Time) (bool, error)
+}
+
+type assertionReplayCacheKey struct{}
+
+// WithAssertionReplayCache returns a new context with the cache used to
+// reject assertions that have already been consumed. A signed response can
+// otherwise be replayed until the assertion expires, so Authenticate fails
+// if there is no cache in the context.
+func WithAssertionReplayCache(ctx context.Context, cache AssertionReplayCache) context.Context {
+	return context.WithValue(ctx, assertionReplayCacheKey{}, cache)
+}
+
+// consumeAssertion records the assertion in the replay cache of the context,
+// and returns an error if it has already been consumed.
+func consumeAssertion(ctx context.Context, assertion *saml.Assertion) error {
+	cache, ok := ctx.Value(assertionReplayCacheKey{}).(AssertionReplayCache)
+	if !ok || cache == nil {
+		return errMissingReplayCache
+	}
+	if assertion.ID == "" {
+		return fmt.Errorf("identity/saml: assertion is missing an id")
+	}
+
+	// assertion ids are only unique per identity provider
+	key := assertion.Issuer.Value + "\x00" + assertion.ID
+	ok, err := cache.Consume(ctx, key, assertionExpiry(assertion))
+	if err != nil {
+		return fmt.Errorf("identity/saml: failed to record assertion: %w", err)
+	} else if !ok {
+		return ErrAssertionReplayed
+	}
+	return nil
+}
+
+// assertionExpiry returns the time after which the service provider rejects
+// the assertion, and so no longer needs to remember it.
+func assertionExpiry(assertion *saml.Assertion) time.Time {
+	expiry := assertion.IssueInstant.Add(saml.MaxIssueDelay)
+	if c := assertion.Conditions; c != nil && c.NotOnOrAfter.After(expiry) {
+		expiry = c.NotOnOrAfter
+	}
+	if s := assertion.Subject; s != nil {
+		for _, sc := range s.SubjectConfirmations {
+			if d := sc.SubjectConfirmationData; d != nil && d.NotOnOrAfter.After(expiry) {
+				expiry = d.NotOnOrAfter
+			}
+		}
+	}
+	return expiry.Add(saml.MaxClockSkew)
+}
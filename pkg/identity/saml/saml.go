@@ -0,0 +1,443 @@
+// Package saml implements SAML 2.0 based authentication.
+//
+// The identity provider is configured using its metadata url as the provider url.
+// Pomerium acts as the service provider, consuming assertions at the OAuth 2.0
+// callback endpoint and publishing its metadata at /.pomerium/saml/metadata.
+package saml
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	dsig "github.com/russellhaering/goxmldsig"
+	"golang.org/x/oauth2"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/identity/identity"
+	"github.com/pomerium/pomerium/pkg/identity/oauth"
+	"github.com/pomerium/pomerium/pkg/identity/oidc"
+)
+
+// Name identifies the SAML identity provider.
+const Name = "saml"
+
+const (
+	// MetadataPath is the path the service provider metadata is served from.
+	MetadataPath  = "/.pomerium/saml/metadata"
+	signedOutPath = "/.pomerium/signed_out"
+
+	// TokenType is the token type of the oauth2 tokens returned by the provider.
+	TokenType = "saml"
+
+	// FormResponse is the form field used by identity providers to post the SAML response.
+	FormResponse = "SAMLResponse"
+	// FormRelayState is the form field used by identity providers to return the relay state.
+	FormRelayState = "RelayState"
+
+	metadataTimeout = 30 * time.Second
+)
+
+// ErrMissingSAMLResponse is returned when the authorization code does not contain a SAML response.
+var ErrMissingSAMLResponse = errors.New("identity/saml: missing SAML response")
+
+// Provider is a SAML 2.0 service provider.
+type Provider struct {
+	metadataURL    string
+	attributeNames map[string]string
+	client         *http.Client
+
+	sp         saml.ServiceProvider
+	idTokenKey jose.SigningKey
+
+	mu                   sync.Mutex
+	idpMetadata          *saml.EntityDescriptor
+	idpMetadataFetchedAt time.Time
+	idpMetadataExpiry    time.Time
+}
+
+// New creates a new SAML service provider.
+//
+// The options are interpreted as follows:
+//   - ProviderURL is the identity provider metadata url
+//   - ClientID is the service provider entity id (defaults to the service provider metadata url)
+//   - SAMLKeyPair is an optional PEM encoded private key and certificate used to sign requests
+//     and decrypt assertions. It may also be base64 encoded.
+//   - SAMLAttributes map claim names to SAML attribute names
+func New(_ context.Context, o *oauth.Options) (*Provider, error) {
+	if o.ProviderURL == "" {
+		return nil, oidc.ErrMissingProviderURL
+	}
+	if o.RedirectURL == nil {
+		return nil, fmt.Errorf("identity/saml: missing redirect url")
+	}
+
+	p := &Provider{
+		metadataURL:    o.ProviderURL,
+		attributeNames: o.SAMLAttributes,
+		client:         &http.Client{Timeout: metadataTimeout},
+	}
+
+	metadataURL := o.RedirectURL.ResolveReference(&url.URL{Path: MetadataPath})
+	p.sp = saml.ServiceProvider{
+		EntityID:    o.ClientID,
+		MetadataURL: *metadataURL,
+		AcsURL:      *o.RedirectURL,
+		SloURL:      *o.RedirectURL.ResolveReference(&url.URL{Path: signedOutPath}),
+	}
+
+	if o.SAMLKeyPair != "" {
+		key, cert, err := parseKeyPair(o.SAMLKeyPair)
+		if err != nil {
+			return nil, fmt.Errorf("identity/saml: invalid service provider key pair: %w", err)
+		}
+		p.sp.Key = key
+		p.sp.Certificate = cert
+		p.sp.SignatureMethod = dsig.RSASHA256SignatureMethod
+		p.idTokenKey = jose.SigningKey{Algorithm: jose.RS256, Key: key}
+	} else {
+		// without a service provider key the id token is only used to carry
+		// the session index, so sign it with an ephemeral key
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, err
+		}
+		p.idTokenKey = jose.SigningKey{Algorithm: jose.HS256, Key: secret}
+	}
+
+	return p, nil
+}
+
+// Authenticate validates the SAML response contained in code and fills v with the
+// claims from the assertion. The assertion is recorded in the replay cache of
+// the context (see WithAssertionReplayCache) so that it can only be used once.
+func (p *Provider) Authenticate(ctx context.Context, code string, v identity.State) (*oauth2.Token, error) {
+	values, err := url.ParseQuery(code)
+	if err != nil || values.Get(FormResponse) == "" {
+		return nil, ErrMissingSAMLResponse
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(values.Get(FormResponse))
+	if err != nil {
+		return nil, fmt.Errorf("identity/saml: invalid SAML response encoding: %w", err)
+	}
+
+	sp, err := p.serviceProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	possibleRequestIDs := []string{requestID(values.Get(FormRelayState))}
+	assertion, err := sp.ParseXMLResponse(raw, possibleRequestIDs)
+	if err != nil {
+		// the identity provider may have rotated its signing certificate
+		if refreshed, ok := p.refreshServiceProvider(ctx); ok {
+			sp = refreshed
+			assertion, err = sp.ParseXMLResponse(raw, possibleRequestIDs)
+		}
+	}
+	if err != nil {
+		var ire *saml.InvalidResponseError
+		if errors.As(err, &ire) {
+			err = ire.PrivateErr
+		}
+		return nil, fmt.Errorf("identity/saml: invalid SAML response: %w", err)
+	}
+	if assertion.Subject == nil || assertion.Subject.NameID == nil || assertion.Subject.NameID.Value == "" {
+		return nil, fmt.Errorf("identity/saml: assertion is missing a subject")
+	}
+	if err := consumeAssertion(ctx, assertion); err != nil {
+		return nil, err
+	}
+
+	claims := claimsFromAssertion(assertion, p.attributeNames)
+	claims["sub"] = assertion.Subject.NameID.Value
+
+	token := &oauth2.Token{TokenType: TokenType}
+	idTokenClaims := samlIDTokenClaims{
+		Claims: jwt.Claims{
+			Issuer:   assertion.Issuer.Value,
+			Subject:  assertion.Subject.NameID.Value,
+			Audience: jwt.Audience{sp.Metadata().EntityID},
+			IssuedAt: jwt.NewNumericDate(time.Now()),
+		},
+	}
+	for _, stmt := range assertion.AuthnStatements {
+		idTokenClaims.SessionIndex = stmt.SessionIndex
+		if stmt.SessionNotOnOrAfter != nil {
+			token.Expiry = *stmt.SessionNotOnOrAfter
+			idTokenClaims.Expiry = jwt.NewNumericDate(token.Expiry)
+		}
+	}
+
+	b, err := json.Marshal(claims)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, fmt.Errorf("identity/saml: failed to unmarshal claims: %w", err)
+	}
+
+	rawIDToken, err := p.signIDToken(&idTokenClaims)
+	if err != nil {
+		return nil, fmt.Errorf("identity/saml: failed to sign id token: %w", err)
+	}
+	v.SetRawIDToken(rawIDToken)
+
+	return token, nil
+}
+
+// Refresh is a no-op for SAML, sessions last until they expire.
+func (p *Provider) Refresh(_ context.Context, t *oauth2.Token, _ identity.State) (*oauth2.Token, error) {
+	return t, nil
+}
+
+// Revoke is not implemented for SAML.
+func (p *Provider) Revoke(_ context.Context, _ *oauth2.Token) error {
+	return oidc.ErrRevokeNotImplemented
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	return Name
+}
+
+// UpdateUserInfo is a no-op for SAML, all user info is contained in the assertion.
+func (p *Provider) UpdateUserInfo(_ context.Context, _ *oauth2.Token, _ any) error {
+	return nil
+}
+
+// SignIn initiates SP-initiated single sign-on by sending an AuthnRequest to the
+// identity provider, using the HTTP-Redirect binding if it is supported and the
+// HTTP-POST binding otherwise.
+func (p *Provider) SignIn(w http.ResponseWriter, r *http.Request, state string) error {
+	sp, err := p.serviceProvider(r.Context())
+	if err != nil {
+		return err
+	}
+
+	if location := sp.GetSSOBindingLocation(saml.HTTPRedirectBinding); location != "" {
+		req, err := sp.MakeAuthenticationRequest(location, saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+		if err != nil {
+			return fmt.Errorf("identity/saml: failed to create authentication request: %w", err)
+		}
+		req.ID = requestID(state)
+		signInURL, err := req.Redirect(state, sp)
+		if err != nil {
+			return fmt.Errorf("identity/saml: failed to create authentication request: %w", err)
+		}
+		httputil.Redirect(w, r, signInURL.String(), http.StatusFound)
+		return nil
+	}
+
+	if location := sp.GetSSOBindingLocation(saml.HTTPPostBinding); location != "" {
+		req, err := sp.MakeAuthenticationRequest(location, saml.HTTPPostBinding, saml.HTTPPostBinding)
+		if err != nil {
+			return fmt.Errorf("identity/saml: failed to create authentication request: %w", err)
+		}
+		req.ID = requestID(state)
+		if len(sp.SignatureMethod) > 0 {
+			req.Signature = nil
+			if err := sp.SignAuthnRequest(req); err != nil {
+				return fmt.Errorf("identity/saml: failed to sign authentication request: %w", err)
+			}
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, err = w.Write(req.Post(state))
+		return err
+	}
+
+	return fmt.Errorf("identity/saml: identity provider does not support a known SSO binding")
+}
+
+// SignOut initiates single logout if the identity provider supports it.
+func (p *Provider) SignOut(w http.ResponseWriter, r *http.Request, idTokenHint, _, redirectToURL string) error {
+	if idTokenHint == "" {
+		return oidc.ErrSignoutNotImplemented
+	}
+
+	sp, err := p.serviceProvider(r.Context())
+	if err != nil {
+		return err
+	}
+
+	location := sp.GetSLOBindingLocation(saml.HTTPRedirectBinding)
+	if location == "" || sp.Key == nil {
+		return oidc.ErrSignoutNotImplemented
+	}
+
+	claims, err := p.verifyIDToken(idTokenHint, sp.Metadata().EntityID)
+	if err != nil {
+		return fmt.Errorf("identity/saml: invalid id token hint: %w", err)
+	}
+
+	req, err := sp.MakeLogoutRequest(location, claims.Subject)
+	if err != nil {
+		return fmt.Errorf("identity/saml: failed to create logout request: %w", err)
+	}
+	if claims.SessionIndex != "" {
+		req.SessionIndex = &saml.SessionIndex{Value: claims.SessionIndex}
+		req.Signature = nil
+		if err := sp.SignLogoutRequest(req); err != nil {
+			return fmt.Errorf("identity/saml: failed to sign logout request: %w", err)
+		}
+	}
+
+	httputil.Redirect(w, r, req.Redirect(redirectToURL).String(), http.StatusFound)
+	return nil
+}
+
+// Metadata returns the service provider metadata.
+func (p *Provider) Metadata(ctx context.Context) ([]byte, error) {
+	sp, err := p.serviceProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return xml.MarshalIndent(sp.Metadata(), "", "  ")
+}
+
+// serviceProvider returns the service provider with the identity provider metadata populated.
+// The metadata is refreshed once it expires.
+func (p *Provider) serviceProvider(ctx context.Context) (*saml.ServiceProvider, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	if p.idpMetadata == nil || !now.Before(p.idpMetadataExpiry) {
+		err := p.updateIDPMetadataLocked(ctx, now)
+		switch {
+		case err == nil:
+		case p.idpMetadata == nil,
+			!p.idpMetadata.ValidUntil.IsZero() && !now.Before(p.idpMetadata.ValidUntil):
+			return nil, err
+		default:
+			// keep using the cached metadata until it's no longer valid
+			log.Ctx(ctx).Error().Err(err).Msg("identity/saml: using cached identity provider metadata")
+			p.idpMetadataExpiry = now.Add(minMetadataRefreshInterval)
+		}
+	}
+
+	return p.serviceProviderLocked(), nil
+}
+
+// refreshServiceProvider refreshes the identity provider metadata, unless it
+// was fetched recently, and returns the service provider if it was refreshed.
+func (p *Provider) refreshServiceProvider(ctx context.Context) (*saml.ServiceProvider, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(p.idpMetadataFetchedAt) < minMetadataRefreshInterval {
+		return nil, false
+	}
+	if err := p.updateIDPMetadataLocked(ctx, now); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("identity/saml: error refreshing identity provider metadata")
+		return nil, false
+	}
+	return p.serviceProviderLocked(), true
+}
+
+func (p *Provider) updateIDPMetadataLocked(ctx context.Context, now time.Time) error {
+	md, err := fetchIDPMetadata(ctx, p.client, p.metadataURL)
+	if err != nil {
+		return fmt.Errorf("identity/saml: failed to retrieve identity provider metadata: %w", err)
+	}
+	p.idpMetadata = md
+	p.idpMetadataFetchedAt = now
+	p.idpMetadataExpiry = metadataExpiry(md, now)
+	return nil
+}
+
+func (p *Provider) serviceProviderLocked() *saml.ServiceProvider {
+	sp := p.sp
+	sp.IDPMetadata = p.idpMetadata
+	return &sp
+}
+
+func (p *Provider) signIDToken(claims *samlIDTokenClaims) (string, error) {
+	signer, err := jose.NewSigner(p.idTokenKey, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		return "", err
+	}
+	return jwt.Signed(signer).Claims(claims).CompactSerialize()
+}
+
+// verifyIDToken verifies that the id token was signed by the provider for the
+// service provider, and returns its claims. The token may have expired, as it
+// is only used to end the session at the identity provider.
+func (p *Provider) verifyIDToken(rawIDToken, audience string) (*samlIDTokenClaims, error) {
+	tok, err := jwt.ParseSigned(rawIDToken)
+	if err != nil {
+		return nil, err
+	}
+
+	key := p.idTokenKey.Key
+	if privateKey, ok := key.(*rsa.PrivateKey); ok {
+		key = &privateKey.PublicKey
+	}
+	var claims samlIDTokenClaims
+	if err := tok.Claims(key, &claims); err != nil {
+		return nil, err
+	}
+	if !claims.Audience.Contains(audience) {
+		return nil, jwt.ErrInvalidAudience
+	}
+	return &claims, nil
+}
+
+type samlIDTokenClaims struct {
+	jwt.Claims
+	SessionIndex string `json:"sid,omitempty"`
+}
+
+// requestID derives the AuthnRequest ID from the relay state, so that the
+// InResponseTo of the response can be validated without storing the request.
+func requestID(state string) string {
+	h := sha256.Sum256([]byte(state))
+	return "id-" + hex.EncodeToString(h[:20])
+}
+
+// parseKeyPair parses a PEM (or base64 encoded PEM) bundle containing an RSA private key
+// and certificate.
+func parseKeyPair(raw string) (*rsa.PrivateKey, *x509.Certificate, error) {
+	bundle := []byte(raw)
+	if !strings.Contains(raw, "-----BEGIN") {
+		decoded, err := base64.StdEncoding.DecodeString(raw)
+		if err != nil {
+			return nil, nil, err
+		}
+		bundle = decoded
+	}
+
+	pair, err := tls.X509KeyPair(bundle, bundle)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, ok := pair.PrivateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("expected RSA private key, got: %T", pair.PrivateKey)
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, cert, nil
+}
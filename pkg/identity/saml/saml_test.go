@@ -0,0 +1,357 @@
+package saml
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"encoding/xml"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/pkg/identity/oauth"
+)
+
+type testState struct {
+	Subject    string   `json:"sub"`
+	Email      string   `json:"email"`
+	Name       string   `json:"name"`
+	GivenName  string   `json:"given_name"`
+	FamilyName string   `json:"family_name"`
+	Groups     []string `json:"groups"`
+	Department string   `json:"department"`
+	RawIDToken string   `json:"-"`
+}
+
+func (s *testState) SetRawIDToken(rawIDToken string) {
+	s.RawIDToken = rawIDToken
+}
+
+type serviceProviderFunc func(r *http.Request, serviceProviderID string) (*saml.EntityDescriptor, error)
+
+func (f serviceProviderFunc) GetServiceProvider(r *http.Request, serviceProviderID string) (*saml.EntityDescriptor, error) {
+	return f(r, serviceProviderID)
+}
+
+type sessionFunc func(w http.ResponseWriter, r *http.Request, req *saml.IdpAuthnRequest) *saml.Session
+
+func (f sessionFunc) GetSession(w http.ResponseWriter, r *http.Request, req *saml.IdpAuthnRequest) *saml.Session {
+	return f(w, r, req)
+}
+
+type testReplayCache struct {
+	mu   sync.Mutex
+	keys map[string]time.Time
+}
+
+func (c *testReplayCache) Consume(_ context.Context, key string, expiresAt time.Time) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.keys[key]; ok {
+		return false, nil
+	}
+	if c.keys == nil {
+		c.keys = make(map[string]time.Time)
+	}
+	c.keys[key] = expiresAt
+	return true, nil
+}
+
+func TestProvider(t *testing.T) {
+	ctx, clearTimeout := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(clearTimeout)
+	ctx = WithAssertionReplayCache(ctx, &testReplayCache{})
+
+	idpKey, idpCert := newTestKeyPair(t)
+	spKey, spCert := newTestKeyPair(t)
+
+	idp := &saml.IdentityProvider{
+		Key:         idpKey,
+		Certificate: idpCert,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		idp.Handler().ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	idp.MetadataURL = mustParseURL(t, srv.URL+"/metadata")
+	idp.SSOURL = mustParseURL(t, srv.URL+"/sso")
+	idp.LogoutURL = mustParseURL(t, srv.URL+"/slo")
+
+	redirectURL := mustParseURL(t, "https://authenticate.example.com/oauth2/callback")
+	p, err := New(ctx, &oauth.Options{
+		ProviderName:   Name,
+		ProviderURL:    idp.MetadataURL.String(),
+		RedirectURL:    &redirectURL,
+		SAMLKeyPair:    string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(spKey)})) + string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: spCert.Raw})),
+		SAMLAttributes: map[string]string{"department": "ou"},
+	})
+	require.NoError(t, err)
+
+	rawMetadata, err := p.Metadata(ctx)
+	require.NoError(t, err)
+	var spMetadata saml.EntityDescriptor
+	require.NoError(t, xml.Unmarshal(rawMetadata, &spMetadata))
+	assert.Equal(t, "https://authenticate.example.com/.pomerium/saml/metadata", spMetadata.EntityID)
+	require.Len(t, spMetadata.SPSSODescriptors, 1)
+	assert.Equal(t, "https://authenticate.example.com/oauth2/callback",
+		spMetadata.SPSSODescriptors[0].AssertionConsumerServices[0].Location)
+
+	idp.ServiceProviderProvider = serviceProviderFunc(func(_ *http.Request, serviceProviderID string) (*saml.EntityDescriptor, error) {
+		assert.Equal(t, spMetadata.EntityID, serviceProviderID)
+		return &spMetadata, nil
+	})
+	idp.SessionProvider = sessionFunc(func(_ http.ResponseWriter, _ *http.Request, _ *saml.IdpAuthnRequest) *saml.Session {
+		return &saml.Session{
+			ID:             "SESSION",
+			Index:          "SESSION_INDEX",
+			NameID:         "USER_ID",
+			UserCommonName: "Jane Doe",
+			UserGivenName:  "Jane",
+			UserSurname:    "Doe",
+			Groups:         []string{"admins", "users"},
+			CustomAttributes: []saml.Attribute{
+				{Name: "mail", Values: []saml.AttributeValue{{Value: "jane@example.com"}}},
+				{Name: "ou", Values: []saml.AttributeValue{{Value: "engineering"}}},
+			},
+		}
+	})
+
+	signIn := func(t *testing.T, state string) url.Values {
+		t.Helper()
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "https://authenticate.example.com/.pomerium/sign_in", nil)
+		require.NoError(t, p.SignIn(w, r, state))
+		require.Equal(t, http.StatusFound, w.Code)
+
+		location := w.Header().Get("Location")
+		assert.Contains(t, location, idp.SSOURL.String())
+
+		req, err := saml.NewIdpAuthnRequest(idp, httptest.NewRequest(http.MethodGet, location, nil))
+		require.NoError(t, err)
+		require.NoError(t, req.Validate())
+		assert.Equal(t, state, req.RelayState)
+		require.NoError(t, saml.DefaultAssertionMaker{}.MakeAssertion(req, idp.SessionProvider.GetSession(nil, nil, req)))
+		form, err := req.PostBinding()
+		require.NoError(t, err)
+		assert.Equal(t, redirectURL.String(), form.URL)
+
+		return url.Values{
+			FormResponse:   {form.SAMLResponse},
+			FormRelayState: {form.RelayState},
+		}
+	}
+
+	t.Run("authenticate", func(t *testing.T) {
+		values := signIn(t, "STATE")
+
+		var state testState
+		token, err := p.Authenticate(ctx, values.Encode(), &state)
+		require.NoError(t, err)
+		assert.Equal(t, TokenType, token.TokenType)
+		assert.Equal(t, testState{
+			Subject:    "USER_ID",
+			Email:      "jane@example.com",
+			Name:       "Jane Doe",
+			GivenName:  "Jane",
+			FamilyName: "Doe",
+			Groups:     []string{"admins", "users"},
+			Department: "engineering",
+			RawIDToken: state.RawIDToken,
+		}, state)
+
+		tok, err := jwt.ParseSigned(state.RawIDToken)
+		require.NoError(t, err)
+		var claims samlIDTokenClaims
+		require.NoError(t, tok.Claims(&spKey.PublicKey, &claims))
+		assert.Equal(t, "USER_ID", claims.Subject)
+		assert.Equal(t, idp.MetadataURL.String(), claims.Issuer)
+		assert.Equal(t, "SESSION_INDEX", claims.SessionIndex)
+	})
+	t.Run("replayed assertion", func(t *testing.T) {
+		values := signIn(t, "STATE")
+
+		var state testState
+		_, err := p.Authenticate(ctx, values.Encode(), &state)
+		require.NoError(t, err)
+		_, err = p.Authenticate(ctx, values.Encode(), &state)
+		assert.ErrorIs(t, err, ErrAssertionReplayed)
+	})
+	t.Run("missing replay cache", func(t *testing.T) {
+		values := signIn(t, "STATE")
+
+		var state testState
+		_, err := p.Authenticate(context.Background(), values.Encode(), &state)
+		assert.ErrorIs(t, err, errMissingReplayCache)
+	})
+	t.Run("relay state mismatch", func(t *testing.T) {
+		values := signIn(t, "STATE")
+		values.Set(FormRelayState, "OTHER")
+
+		var state testState
+		_, err := p.Authenticate(ctx, values.Encode(), &state)
+		assert.ErrorContains(t, err, "InResponseTo")
+	})
+	t.Run("rotated certificate", func(t *testing.T) {
+		idp.Key, idp.Certificate = newTestKeyPair(t)
+
+		values := signIn(t, "STATE")
+		var state testState
+		_, err := p.Authenticate(ctx, values.Encode(), &state)
+		assert.Error(t, err, "should not refresh metadata fetched recently")
+
+		p.mu.Lock()
+		p.idpMetadataFetchedAt = time.Now().Add(-minMetadataRefreshInterval)
+		p.mu.Unlock()
+
+		_, err = p.Authenticate(ctx, values.Encode(), &state)
+		assert.NoError(t, err, "should refresh metadata and verify the response")
+	})
+	t.Run("missing response", func(t *testing.T) {
+		var state testState
+		_, err := p.Authenticate(ctx, "code=CODE", &state)
+		assert.ErrorIs(t, err, ErrMissingSAMLResponse)
+	})
+	t.Run("sign out", func(t *testing.T) {
+		values := signIn(t, "STATE")
+		var state testState
+		_, err := p.Authenticate(ctx, values.Encode(), &state)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "https://authenticate.example.com/.pomerium/sign_out", nil)
+		require.NoError(t, p.SignOut(w, r, state.RawIDToken, "", "https://www.example.com"))
+		require.Equal(t, http.StatusFound, w.Code)
+
+		location, err := url.Parse(w.Header().Get("Location"))
+		require.NoError(t, err)
+		assert.Equal(t, idp.LogoutURL.Path, location.Path)
+		assert.Equal(t, "https://www.example.com", location.Query().Get("RelayState"))
+		assert.NotEmpty(t, location.Query().Get("SAMLRequest"))
+	})
+	t.Run("sign out with forged id token", func(t *testing.T) {
+		forgedKey, _ := newTestKeyPair(t)
+		signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: forgedKey}, nil)
+		require.NoError(t, err)
+		rawIDToken, err := jwt.Signed(signer).Claims(samlIDTokenClaims{
+			Claims: jwt.Claims{
+				Subject:  "OTHER_USER_ID",
+				Audience: jwt.Audience{spMetadata.EntityID},
+			},
+		}).CompactSerialize()
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "https://authenticate.example.com/.pomerium/sign_out", nil)
+		assert.ErrorContains(t, p.SignOut(w, r, rawIDToken, "", "https://www.example.com"), "invalid id token hint")
+	})
+}
+
+func TestClaimsFromAssertion(t *testing.T) {
+	assertion := &saml.Assertion{
+		AttributeStatements: []saml.AttributeStatement{{
+			Attributes: []saml.Attribute{
+				{
+					Name:   "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+					Values: []saml.AttributeValue{{Value: "user@example.com"}},
+				},
+				{
+					FriendlyName: "memberOf",
+					Name:         "urn:oid:1.2.840.113556.1.2.102",
+					Values:       []saml.AttributeValue{{Value: "admins"}},
+				},
+				{
+					Name:   "custom",
+					Values: []saml.AttributeValue{{Value: "a"}, {Value: "b"}},
+				},
+			},
+		}},
+	}
+
+	assert.Equal(t, map[string]any{
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress": "user@example.com",
+		"memberOf": "admins",
+		"custom":   []string{"a", "b"},
+		"email":    "user@example.com",
+		"groups":   []string{"admins"},
+	}, claimsFromAssertion(assertion, nil))
+
+	assert.Equal(t, map[string]any{
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress": "user@example.com",
+		"memberOf": "admins",
+		"custom":   []string{"a", "b"},
+		"email":    "user@example.com",
+		"groups":   []string{"a", "b"},
+	}, claimsFromAssertion(assertion, map[string]string{"groups": "custom"}))
+}
+
+func newTestKeyPair(t *testing.T) (*rsa.PrivateKey, *x509.Certificate) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return key, cert
+}
+
+func mustParseURL(t *testing.T, rawURL string) url.URL {
+	t.Helper()
+
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	return *u
+}
+
+func TestMetadataExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, now.Add(defaultMetadataRefreshInterval),
+		metadataExpiry(&saml.EntityDescriptor{}, now))
+	assert.Equal(t, now.Add(time.Hour),
+		metadataExpiry(&saml.EntityDescriptor{CacheDuration: time.Hour}, now))
+	assert.Equal(t, now.Add(defaultMetadataRefreshInterval),
+		metadataExpiry(&saml.EntityDescriptor{CacheDuration: 7 * 24 * time.Hour}, now))
+	assert.Equal(t, now.Add(time.Minute),
+		metadataExpiry(&saml.EntityDescriptor{CacheDuration: time.Hour, ValidUntil: now.Add(time.Minute)}, now))
+}
+
+func TestAssertionExpiry(t *testing.T) {
+	t.Parallel()
+
+	issued := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, issued.Add(saml.MaxIssueDelay+saml.MaxClockSkew),
+		assertionExpiry(&saml.Assertion{IssueInstant: issued}))
+	assert.Equal(t, issued.Add(time.Hour+saml.MaxClockSkew),
+		assertionExpiry(&saml.Assertion{
+			IssueInstant: issued,
+			Conditions:   &saml.Conditions{NotOnOrAfter: issued.Add(5 * time.Minute)},
+			Subject: &saml.Subject{SubjectConfirmations: []saml.SubjectConfirmation{{
+				SubjectConfirmationData: &saml.SubjectConfirmationData{NotOnOrAfter: issued.Add(time.Hour)},
+			}}},
+		}))
+}
@@ -49,6 +49,12 @@ const (
 	// This metric is computed by pomerium and used as an injected resource in envoy's overload manager.
 	EnvoyCgroupMemorySaturation = "envoy_cgroup_memory_saturation"
 
+	// RateLimitRequestsTotal is a counter of rate limited requests by route and result
+	RateLimitRequestsTotal = "rate_limit_requests_total"
+
+	// UpstreamUnhealthyEndpoints is the number of unhealthy endpoints of each upstream cluster
+	UpstreamUnhealthyEndpoints = "upstream_unhealthy_endpoints"
+
 	// BuildInfo is a gauge that may be used to detect whether component is live, and also has version
 	BuildInfo = "build_info"
 	// PolicyCountTotal is total amount of routes currently configured
@@ -0,0 +1,89 @@
+package criteria
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-policy-agent/opa/ast"
+
+	"github.com/pomerium/pomerium/pkg/policy/generator"
+	"github.com/pomerium/pomerium/pkg/policy/parser"
+	"github.com/pomerium/pomerium/pkg/policy/rules"
+)
+
+type acrCriterion struct {
+	g *Generator
+}
+
+func (acrCriterion) DataType() CriterionDataType {
+	return generator.CriterionDataTypeUnknown
+}
+
+func (acrCriterion) Name() string {
+	return "acr"
+}
+
+func (c acrCriterion) GenerateRule(_ string, data parser.Value) (*ast.Rule, []*ast.Rule, error) {
+	values, err := parseStringOrStringList(c.Name(), data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := NewCriterionReauthenticationRule(c.g, c.Name(),
+		ReasonACROK, ReasonACRUnauthorized,
+		sessionClaimInBody("acr", values), map[string]any{
+			"acr_values": strings.Join(values, " "),
+		})
+	return rule, []*ast.Rule{
+		rules.GetSession(),
+	}, nil
+}
+
+// ACR returns a Criterion which requires the user to have logged in with one
+// of the given authentication context class references.
+func ACR(generator *Generator) Criterion {
+	return acrCriterion{g: generator}
+}
+
+// parseStringOrStringList parses a non-empty string or list of strings.
+func parseStringOrStringList(name string, data parser.Value) ([]string, error) {
+	var values []string
+	switch v := data.(type) {
+	case parser.String:
+		values = append(values, string(v))
+	case parser.Array:
+		for _, vv := range v {
+			s, ok := vv.(parser.String)
+			if !ok {
+				return nil, fmt.Errorf("expected string in %s criterion list, got: %T", name, vv)
+			}
+			values = append(values, string(s))
+		}
+	default:
+		return nil, fmt.Errorf("expected string or list of strings for %s criterion, got: %T", name, data)
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("expected at least one value for %s criterion", name)
+	}
+	return values, nil
+}
+
+// sessionClaimInBody returns a rule body which passes if any of the values of
+// the session claim is one of the given values.
+func sessionClaimInBody(claim string, values []string) ast.Body {
+	var terms []*ast.Term
+	for _, v := range values {
+		terms = append(terms, ast.StringTerm(v))
+	}
+	return ast.Body{
+		ast.Assign.Expr(ast.VarTerm("claim_name"), ast.StringTerm(claim)),
+		ast.Assign.Expr(ast.VarTerm("expected_values"), ast.SetTerm(terms...)),
+		ast.MustParseExpr(`session_claims := object.get(session, "claims", {})`),
+		ast.MustParseExpr(`claim_values := object.get(session_claims, claim_name, [])`),
+		ast.MustParseExpr(`count([v | v := claim_values[_]; expected_values[v]]) > 0`),
+	}
+}
+
+func init() {
+	Register(ACR)
+}
@@ -0,0 +1,83 @@
+package criteria
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+func TestACR(t *testing.T) {
+	t.Parallel()
+
+	evaluateACR := func(t *testing.T, policy string, issuedAt time.Time, acr ...string) A {
+		t.Helper()
+		s := &session.Session{
+			Id:       "SESSION_ID",
+			IssuedAt: timestamppb.New(issuedAt),
+		}
+		if len(acr) > 0 {
+			v, err := structpb.NewList([]any{acr[0]})
+			require.NoError(t, err)
+			s.Claims = map[string]*structpb.ListValue{"acr": v}
+		}
+		res, err := evaluate(t, policy, []*databroker.Record{makeRecord(s)},
+			Input{Session: InputSession{ID: "SESSION_ID"}})
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{}}, res["deny"])
+		return res["allow"].(A)
+	}
+
+	policy := `
+allow:
+  and:
+    - acr: [phr, phrh]
+`
+	t.Run("no session", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := evaluate(t, policy, nil, Input{Session: InputSession{ID: "SESSION_ID"}})
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{ReasonUserUnauthenticated}, M{}}, res["allow"])
+	})
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, A{true, A{ReasonACROK}, M{}},
+			evaluateACR(t, policy, testingNow.Add(-time.Hour), "phrh"))
+		assert.Equal(t, A{true, A{ReasonACROK}, M{}},
+			evaluateACR(t, "allow:\n  and:\n    - acr: phr\n", testingNow.Add(-time.Hour), "phr"))
+	})
+	t.Run("reauthenticate", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, A{false, A{ReasonUserReauthenticate}, M{"acr_values": "phr phrh"}},
+			evaluateACR(t, policy, testingNow.Add(-time.Hour), "urn:mace:incommon:iap:silver"))
+		assert.Equal(t, A{false, A{ReasonUserReauthenticate}, M{"acr_values": "phr phrh"}},
+			evaluateACR(t, policy, testingNow.Add(-time.Hour)))
+	})
+	t.Run("grace period", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, A{false, A{ReasonACRUnauthorized}, M{}},
+			evaluateACR(t, policy, testingNow.Add(-time.Second), "0"))
+	})
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		for _, policy := range []string{
+			"allow:\n  and:\n    - acr: []\n",
+			"allow:\n  and:\n    - acr: [1]\n",
+			"allow:\n  and:\n    - acr: {is: phr}\n",
+		} {
+			_, err := evaluate(t, policy, nil, Input{})
+			assert.Error(t, err, policy)
+		}
+	})
+}
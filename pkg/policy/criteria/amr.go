@@ -0,0 +1,47 @@
+package criteria
+
+import (
+	"github.com/open-policy-agent/opa/ast"
+
+	"github.com/pomerium/pomerium/pkg/policy/generator"
+	"github.com/pomerium/pomerium/pkg/policy/parser"
+	"github.com/pomerium/pomerium/pkg/policy/rules"
+)
+
+type amrCriterion struct {
+	g *Generator
+}
+
+func (amrCriterion) DataType() CriterionDataType {
+	return generator.CriterionDataTypeUnknown
+}
+
+func (amrCriterion) Name() string {
+	return "amr"
+}
+
+func (c amrCriterion) GenerateRule(_ string, data parser.Value) (*ast.Rule, []*ast.Rule, error) {
+	values, err := parseStringOrStringList(c.Name(), data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// there is no standard way to request an authentication method from the
+	// identity provider, so only ask the user to log in again
+	rule := NewCriterionReauthenticationRule(c.g, c.Name(),
+		ReasonAMROK, ReasonAMRUnauthorized,
+		sessionClaimInBody("amr", values), map[string]any{})
+	return rule, []*ast.Rule{
+		rules.GetSession(),
+	}, nil
+}
+
+// AMR returns a Criterion which requires the user to have logged in with any
+// of the given authentication methods.
+func AMR(generator *Generator) Criterion {
+	return amrCriterion{g: generator}
+}
+
+func init() {
+	Register(AMR)
+}
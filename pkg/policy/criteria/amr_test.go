@@ -0,0 +1,43 @@
+package criteria
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+func TestAMR(t *testing.T) {
+	t.Parallel()
+
+	evaluateAMR := func(t *testing.T, issuedAt time.Time, amr ...any) A {
+		t.Helper()
+		v, err := structpb.NewList(amr)
+		require.NoError(t, err)
+		res, err := evaluate(t, `
+allow:
+  and:
+    - amr: [mfa, hwk]
+`, []*databroker.Record{makeRecord(&session.Session{
+			Id:       "SESSION_ID",
+			IssuedAt: timestamppb.New(issuedAt),
+			Claims:   map[string]*structpb.ListValue{"amr": v},
+		})}, Input{Session: InputSession{ID: "SESSION_ID"}})
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{}}, res["deny"])
+		return res["allow"].(A)
+	}
+
+	assert.Equal(t, A{true, A{ReasonAMROK}, M{}},
+		evaluateAMR(t, testingNow.Add(-time.Hour), "pwd", "mfa"))
+	assert.Equal(t, A{false, A{ReasonUserReauthenticate}, M{}},
+		evaluateAMR(t, testingNow.Add(-time.Hour), "pwd"))
+	assert.Equal(t, A{false, A{ReasonAMRUnauthorized}, M{}},
+		evaluateAMR(t, testingNow.Add(-time.Second), "pwd"))
+}
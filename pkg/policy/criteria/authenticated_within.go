@@ -0,0 +1,64 @@
+package criteria
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/open-policy-agent/opa/ast"
+
+	"github.com/pomerium/pomerium/pkg/policy/generator"
+	"github.com/pomerium/pomerium/pkg/policy/parser"
+	"github.com/pomerium/pomerium/pkg/policy/rules"
+)
+
+type authenticatedWithinCriterion struct {
+	g *Generator
+}
+
+func (authenticatedWithinCriterion) DataType() CriterionDataType {
+	return generator.CriterionDataTypeUnknown
+}
+
+func (authenticatedWithinCriterion) Name() string {
+	return "authenticated_within"
+}
+
+func (c authenticatedWithinCriterion) GenerateRule(_ string, data parser.Value) (*ast.Rule, []*ast.Rule, error) {
+	s, ok := data.(parser.String)
+	if !ok {
+		return nil, nil, fmt.Errorf("expected duration string for authenticated_within criterion, got: %T", data)
+	}
+	maxAge, err := time.ParseDuration(string(s))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid duration for authenticated_within criterion: %w", err)
+	} else if maxAge < time.Second {
+		return nil, nil, fmt.Errorf("authenticated_within criterion must be at least 1s, got: %s", maxAge)
+	}
+
+	body := ast.Body{
+		ast.Assign.Expr(ast.VarTerm("max_age_ns"), ast.IntNumberTerm(int(maxAge.Nanoseconds()))),
+		ast.MustParseExpr(`auth_time_ns := get_session_auth_time(session)`),
+		ast.MustParseExpr(`time.now_ns() - auth_time_ns <= max_age_ns`),
+	}
+
+	rule := NewCriterionReauthenticationRule(c.g, c.Name(),
+		ReasonAuthenticatedWithinOK, ReasonAuthenticatedWithinExpired,
+		body, map[string]any{
+			"max_age": strconv.Itoa(int(maxAge.Seconds())),
+		})
+	return rule, []*ast.Rule{
+		rules.GetSession(),
+		rules.GetSessionAuthTime(),
+	}, nil
+}
+
+// AuthenticatedWithin returns a Criterion which requires the user to have
+// logged in with the identity provider within the given duration.
+func AuthenticatedWithin(generator *Generator) Criterion {
+	return authenticatedWithinCriterion{g: generator}
+}
+
+func init() {
+	Register(AuthenticatedWithin)
+}
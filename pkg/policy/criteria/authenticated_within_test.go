@@ -0,0 +1,97 @@
+package criteria
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+func TestAuthenticatedWithin(t *testing.T) {
+	t.Parallel()
+
+	policy := `
+allow:
+  and:
+    - authenticated_within: 15m
+`
+	evaluateSession := func(t *testing.T, s *session.Session) A {
+		t.Helper()
+		s.Id = "SESSION_ID"
+		res, err := evaluate(t, policy, []*databroker.Record{makeRecord(s)},
+			Input{Session: InputSession{ID: "SESSION_ID"}})
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{}}, res["deny"])
+		return res["allow"].(A)
+	}
+
+	t.Run("no session", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := evaluate(t, policy, nil, Input{Session: InputSession{ID: "SESSION_ID"}})
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{ReasonUserUnauthenticated}, M{}}, res["allow"])
+	})
+	t.Run("auth_time", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, A{true, A{ReasonAuthenticatedWithinOK}, M{}}, evaluateSession(t, &session.Session{
+			IssuedAt: timestamppb.New(testingNow.Add(-time.Hour)),
+			Claims: map[string]*structpb.ListValue{
+				"auth_time": {Values: []*structpb.Value{structpb.NewNumberValue(float64(testingNow.Add(-10 * time.Minute).Unix()))}},
+			},
+		}))
+		assert.Equal(t, A{false, A{ReasonUserReauthenticate}, M{"max_age": "900"}}, evaluateSession(t, &session.Session{
+			IssuedAt: timestamppb.New(testingNow.Add(-time.Hour)),
+			Claims: map[string]*structpb.ListValue{
+				"auth_time": {Values: []*structpb.Value{structpb.NewNumberValue(float64(testingNow.Add(-20 * time.Minute).Unix()))}},
+			},
+		}))
+	})
+	t.Run("id token", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, A{true, A{ReasonAuthenticatedWithinOK}, M{}}, evaluateSession(t, &session.Session{
+			IssuedAt: timestamppb.New(testingNow.Add(-time.Hour)),
+			IdToken:  &session.IDToken{IssuedAt: timestamppb.New(testingNow.Add(-time.Minute))},
+		}))
+	})
+	t.Run("session", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, A{true, A{ReasonAuthenticatedWithinOK}, M{}}, evaluateSession(t, &session.Session{
+			IssuedAt: timestamppb.New(testingNow.Add(-5 * time.Minute)),
+		}))
+		assert.Equal(t, A{false, A{ReasonUserReauthenticate}, M{"max_age": "900"}}, evaluateSession(t, &session.Session{
+			IssuedAt: timestamppb.New(testingNow.Add(-time.Hour)),
+		}))
+	})
+	t.Run("grace period", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, A{false, A{ReasonAuthenticatedWithinExpired}, M{}}, evaluateSession(t, &session.Session{
+			IssuedAt: timestamppb.New(testingNow.Add(-10 * time.Second)),
+			Claims: map[string]*structpb.ListValue{
+				"auth_time": {Values: []*structpb.Value{structpb.NewNumberValue(float64(testingNow.Add(-time.Hour).Unix()))}},
+			},
+		}))
+	})
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		for _, policy := range []string{
+			"allow:\n  and:\n    - authenticated_within: soon\n",
+			"allow:\n  and:\n    - authenticated_within: 10ms\n",
+			"allow:\n  and:\n    - authenticated_within: [15m]\n",
+		} {
+			_, err := evaluate(t, policy, nil, Input{})
+			assert.Error(t, err, policy)
+		}
+	})
+}
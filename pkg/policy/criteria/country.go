@@ -0,0 +1,67 @@
+package criteria
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-policy-agent/opa/ast"
+
+	"github.com/pomerium/pomerium/pkg/policy/generator"
+	"github.com/pomerium/pomerium/pkg/policy/parser"
+)
+
+type countryCriterion struct {
+	g *Generator
+}
+
+func (countryCriterion) DataType() CriterionDataType {
+	return generator.CriterionDataTypeUnknown
+}
+
+func (countryCriterion) Name() string {
+	return "country"
+}
+
+func (c countryCriterion) GenerateRule(_ string, data parser.Value) (*ast.Rule, []*ast.Rule, error) {
+	var values []parser.Value
+	switch data := data.(type) {
+	case parser.String:
+		values = append(values, data)
+	case parser.Array:
+		values = data
+	default:
+		return nil, nil, fmt.Errorf("expected string or array for country criterion, got: %T", data)
+	}
+
+	var terms []*ast.Term
+	for _, v := range values {
+		s, ok := v.(parser.String)
+		if !ok {
+			return nil, nil, fmt.Errorf("expected string for country criterion value, got: %T", v)
+		}
+		if len(s) != 2 {
+			return nil, nil, fmt.Errorf("expected ISO 3166-1 alpha-2 country code for country criterion, got: %s", s)
+		}
+		terms = append(terms, ast.StringTerm(strings.ToUpper(string(s))))
+	}
+
+	body := ast.Body{
+		ast.MustParseExpr(`input.country != ""`),
+		ast.Member.Expr(ast.MustParseTerm(`input.country`), ast.SetTerm(terms...)),
+	}
+
+	rule := NewCriterionRule(c.g, c.Name(),
+		ReasonCountryOK, ReasonCountryUnauthorized,
+		body)
+
+	return rule, nil, nil
+}
+
+// Country returns a Criterion which matches the country of the downstream client IP address.
+func Country(generator *Generator) Criterion {
+	return countryCriterion{g: generator}
+}
+
+func init() {
+	Register(Country)
+}
@@ -0,0 +1,50 @@
+package criteria
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+func TestCountry(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		res, err := evaluate(t, `
+allow:
+  and:
+    - country: [us, CA]
+`, []*databroker.Record{}, Input{Country: "US"})
+		require.NoError(t, err)
+		require.Equal(t, A{true, A{ReasonCountryOK}, M{}}, res["allow"])
+		require.Equal(t, A{false, A{}}, res["deny"])
+	})
+	t.Run("unauthorized", func(t *testing.T) {
+		res, err := evaluate(t, `
+allow:
+  and:
+    - country: US
+`, []*databroker.Record{}, Input{Country: "DE"})
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{ReasonCountryUnauthorized}, M{}}, res["allow"])
+		require.Equal(t, A{false, A{}}, res["deny"])
+	})
+	t.Run("unknown", func(t *testing.T) {
+		res, err := evaluate(t, `
+allow:
+  and:
+    - country: US
+`, []*databroker.Record{}, Input{})
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{ReasonCountryUnauthorized}, M{}}, res["allow"])
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := evaluate(t, `
+allow:
+  and:
+    - country: USA
+`, nil, Input{})
+		assert.Error(t, err)
+	})
+}
@@ -3,6 +3,7 @@ package criteria
 
 import (
 	"sync"
+	"time"
 
 	"github.com/open-policy-agent/opa/ast"
 
@@ -48,10 +49,14 @@ const (
 	// CriterionDataTypeCertificateMatcher indicates the expected data type is
 	// a certificate matcher.
 	CriterionDataTypeCertificateMatcher CriterionDataType = "certificate_matcher"
+	// CriterionDataTypeDateRangeMatcher indicates the expected data type is a date range matcher.
+	CriterionDataTypeDateRangeMatcher CriterionDataType = "date_range_matcher"
 	// CriterionDataTypeStringListMatcher indicates the expected data type is a string list matcher.
 	CriterionDataTypeStringListMatcher CriterionDataType = "string_list_matcher"
 	// CriterionDataTypeStringMatcher indicates the expected data type is a string matcher.
 	CriterionDataTypeStringMatcher CriterionDataType = "string_matcher"
+	// CriterionDataTypeTimeOfDayMatcher indicates the expected data type is a time of day matcher.
+	CriterionDataTypeTimeOfDayMatcher CriterionDataType = "time_of_day_matcher"
 )
 
 // NewCriterionRule generates a new rule for a criterion.
@@ -168,6 +173,64 @@ func NewCriterionSessionRule(
 	return r1
 }
 
+// ReauthenticationGracePeriod is how long after logging in a user is not asked
+// to log in again. If a criterion requiring a fresh login still fails during
+// this period, the identity provider did not satisfy the request, and access
+// is denied rather than redirecting the user back to the identity provider.
+const ReauthenticationGracePeriod = time.Minute
+
+// NewCriterionReauthenticationRule generates a new rule for a criterion which
+// requires a session and which can be satisfied by logging in again. If there
+// is no session "user-unauthenticated" is returned. If the criterion fails
+// "user-reauthenticate" is returned along with the additional data, unless the
+// session was created during the ReauthenticationGracePeriod, in which case
+// the failReason is returned.
+func NewCriterionReauthenticationRule(
+	g *generator.Generator,
+	name string,
+	passReason, failReason Reason,
+	body ast.Body,
+	additionalData map[string]any,
+) *ast.Rule {
+	sharedBody := ast.Body{
+		ast.MustParseExpr(`session := get_session(input.session.id)`),
+		ast.MustParseExpr(`session.id != ""`),
+	}
+
+	// case 1: rule passes
+	r1 := g.NewRule(name)
+	r1.Head.Value = NewCriterionTerm(true, passReason)
+	r1.Body = append(sharedBody, body...)
+
+	// case 2: rule fails, but the user just logged in
+	r2 := &ast.Rule{
+		Head: generator.NewHead("", NewCriterionTerm(false, failReason)),
+		Body: append(sharedBody, ast.Body{
+			ast.Assign.Expr(ast.VarTerm("grace_period_ns"), ast.IntNumberTerm(int(ReauthenticationGracePeriod.Nanoseconds()))),
+			ast.MustParseExpr(`time.now_ns() - (object.get(object.get(session, "issued_at", {}), "seconds", 0) * 1000000000) < grace_period_ns`),
+		}...),
+	}
+	r1.Else = r2
+
+	// case 3: rule fails, the user needs to log in again
+	r3 := &ast.Rule{
+		Head: generator.NewHead("", NewCriterionTermWithAdditionalData(false, ReasonUserReauthenticate, additionalData)),
+		Body: sharedBody,
+	}
+	r2.Else = r3
+
+	// case 4: user not authenticated, session does not exist
+	r4 := &ast.Rule{
+		Head: generator.NewHead("", NewCriterionTerm(false, ReasonUserUnauthenticated)),
+		Body: ast.Body{
+			ast.NewExpr(ast.BooleanTerm(true)),
+		},
+	}
+	r3.Else = r4
+
+	return r1
+}
+
 // NewCriterionTerm creates a new rego term for a criterion:
 //
 //	[true, {"reason"}]
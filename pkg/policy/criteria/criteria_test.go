@@ -34,19 +34,22 @@ type (
 		HTTP                     InputHTTP    `json:"http"`
 		Session                  InputSession `json:"session"`
 		IsValidClientCertificate bool         `json:"is_valid_client_certificate"`
+		Country                  string       `json:"country"`
 	}
 	InputHTTP struct {
 		Method            string                `json:"method"`
 		Path              string                `json:"path"`
 		Headers           map[string][]string   `json:"headers"`
 		ClientCertificate ClientCertificateInfo `json:"client_certificate"`
+		IP                string                `json:"ip"`
 	}
 	InputSession struct {
 		ID string `json:"id"`
 	}
 	ClientCertificateInfo struct {
-		Presented bool   `json:"presented"`
-		Leaf      string `json:"leaf"`
+		Presented   bool   `json:"presented"`
+		Leaf        string `json:"leaf"`
+		Fingerprint string `json:"fingerprint"`
 	}
 )
 
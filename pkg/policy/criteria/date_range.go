@@ -0,0 +1,97 @@
+package criteria
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/open-policy-agent/opa/ast"
+
+	"github.com/pomerium/pomerium/pkg/policy/parser"
+)
+
+const (
+	dateRangeOperatorAfter  = "after"
+	dateRangeOperatorBefore = "before"
+)
+
+type dateRangeCriterion struct {
+	g *Generator
+}
+
+func (dateRangeCriterion) DataType() CriterionDataType {
+	return CriterionDataTypeDateRangeMatcher
+}
+
+func (dateRangeCriterion) Name() string {
+	return "date_range"
+}
+
+func (c dateRangeCriterion) GenerateRule(_ string, data parser.Value) (*ast.Rule, []*ast.Rule, error) {
+	obj, ok := data.(parser.Object)
+	if !ok {
+		return nil, nil, fmt.Errorf("expected object for date_range criterion, got: %T", data)
+	}
+
+	for k := range obj {
+		switch k {
+		case dateRangeOperatorAfter, dateRangeOperatorBefore:
+		default:
+			return nil, nil, fmt.Errorf("unexpected field in date_range criterion: %s", k)
+		}
+	}
+
+	after, hasAfter, err := parseDateRangeOperator(obj, dateRangeOperatorAfter)
+	if err != nil {
+		return nil, nil, err
+	}
+	before, hasBefore, err := parseDateRangeOperator(obj, dateRangeOperatorBefore)
+	if err != nil {
+		return nil, nil, err
+	}
+	if hasAfter && hasBefore && !after.Before(before) {
+		return nil, nil, fmt.Errorf("invalid date_range criterion, after must be earlier than before")
+	}
+
+	body := ast.Body{
+		ast.MustParseExpr(`now := time.now_ns()`),
+	}
+	if hasAfter {
+		body = append(body, ast.GreaterThanEq.Expr(ast.VarTerm("now"), ast.IntNumberTerm(int(after.UnixNano()))))
+	}
+	if hasBefore {
+		body = append(body, ast.LessThan.Expr(ast.VarTerm("now"), ast.IntNumberTerm(int(before.UnixNano()))))
+	}
+
+	rule := NewCriterionRule(c.g, c.Name(),
+		ReasonDateRangeOK, ReasonDateRangeUnauthorized,
+		body)
+
+	return rule, nil, nil
+}
+
+// parseDateRangeOperator parses an RFC 3339 timestamp.
+func parseDateRangeOperator(obj parser.Object, operator string) (t time.Time, ok bool, err error) {
+	v, ok := obj[operator]
+	if !ok {
+		return t, false, nil
+	}
+
+	s, ok := v.(parser.String)
+	if !ok {
+		return t, false, fmt.Errorf("expected string for date_range criterion %s operator, got %T", operator, v)
+	}
+	t, err = time.Parse(time.RFC3339, string(s))
+	if err != nil {
+		return t, false, fmt.Errorf("invalid timestamp for date_range criterion %s operator, expected RFC 3339: %w", operator, err)
+	}
+	return t, true, nil
+}
+
+// DateRange returns a Criterion which matches an absolute range of time.
+func DateRange(generator *Generator) Criterion {
+	return dateRangeCriterion{g: generator}
+}
+
+func init() {
+	Register(DateRange)
+}
@@ -0,0 +1,59 @@
+package criteria
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/policy/parser"
+)
+
+func TestDateRange(t *testing.T) {
+	policy := func(after, before time.Time) string {
+		return fmt.Sprintf(`
+allow:
+  and:
+    - date_range:
+        after: "%s"
+        before: "%s"
+`, after.Format(time.RFC3339), before.Format(time.RFC3339))
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		res, err := evaluate(t, policy(testingNow.Add(-time.Hour), testingNow.Add(time.Hour)),
+			[]*databroker.Record{}, Input{})
+		require.NoError(t, err)
+		require.Equal(t, A{true, A{ReasonDateRangeOK}, M{}}, res["allow"])
+		require.Equal(t, A{false, A{}}, res["deny"])
+	})
+	t.Run("unauthorized", func(t *testing.T) {
+		res, err := evaluate(t, policy(testingNow.Add(time.Hour), testingNow.Add(2*time.Hour)),
+			[]*databroker.Record{}, Input{})
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{ReasonDateRangeUnauthorized}, M{}}, res["allow"])
+		require.Equal(t, A{false, A{}}, res["deny"])
+	})
+	t.Run("json", func(t *testing.T) {
+		p, err := parser.ParseJSON(strings.NewReader(`{"allow":{"and":[{"date_range":{"before":"2030-01-01T00:00:00Z"}}]}}`))
+		require.NoError(t, err)
+		require.Len(t, p.Rules, 1)
+		assert.Equal(t, "date_range", p.Rules[0].And[0].Name)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		for _, raw := range []string{
+			`date_range: "2021-01-01T00:00:00Z"`,
+			`date_range: {after: "2021-01-01"}`,
+			`date_range: {until: "2021-01-01T00:00:00Z"}`,
+			`date_range: {after: "2021-01-01T00:00:00Z", before: "2021-01-01T00:00:00Z"}`,
+			`date_range: {after: "2022-01-01T00:00:00Z", before: "2021-01-01T00:00:00Z"}`,
+		} {
+			_, err := evaluate(t, "allow:\n  and:\n    - "+raw, nil, Input{})
+			assert.Error(t, err, raw)
+		}
+	})
+}
@@ -166,4 +166,59 @@ allow:
 		require.Equal(t, A{false, A{ReasonDeviceUnauthenticated}, M{"device_type": "t2"}}, res["allow"])
 		require.Equal(t, A{false, A{}}, res["deny"])
 	})
+	t.Run("client certificate", func(t *testing.T) {
+		mkInputWithLeaf := func(fingerprint, leaf string) Input {
+			return Input{
+				HTTP: InputHTTP{ClientCertificate: ClientCertificateInfo{
+					Presented: true, Leaf: leaf, Fingerprint: fingerprint,
+				}},
+				Session: InputSession{ID: "s1"},
+			}
+		}
+		mkInput := func(fingerprint string) Input {
+			return mkInputWithLeaf(fingerprint, testCert)
+		}
+		records := []*databroker.Record{
+			makeRecord(&session.Session{Id: "s1", UserId: "u1"}),
+			makeRecord(&device.Credential{
+				Id:           device.ClientCertificateCredentialID("client_certificate", "f1"),
+				TypeId:       "client_certificate",
+				EnrollmentId: "de1",
+				UserId:       "u1",
+				Specifier: &device.Credential_ClientCertificate_{
+					ClientCertificate: &device.Credential_ClientCertificate{Fingerprint: "f1"},
+				},
+			}),
+			makeRecord(&device.Enrollment{Id: "de1", ApprovedBy: "u2"}),
+		}
+		policy := `
+allow:
+  and:
+    - device:
+        type: client_certificate
+        approved: true
+`
+
+		res, err := evaluate(t, policy, records, mkInput("f1"))
+		require.NoError(t, err)
+		require.Equal(t, A{true, A{ReasonDeviceOK}, M{"device_type": "client_certificate"}}, res["allow"])
+
+		res, err = evaluate(t, policy, records, mkInput("f2"))
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{ReasonDeviceUnauthenticated}, M{"device_type": "client_certificate"}}, res["allow"])
+
+		res, err = evaluate(t, policy, records, mkInput(""))
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{ReasonDeviceUnauthenticated}, M{"device_type": "client_certificate"}}, res["allow"])
+
+		// testCertWithSANs is not valid until 2024
+		res, err = evaluate(t, policy, records, mkInputWithLeaf("f1", testCertWithSANs))
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{ReasonDeviceUnauthenticated}, M{"device_type": "client_certificate"}}, res["allow"])
+
+		records[0] = makeRecord(&session.Session{Id: "s1", UserId: "u2"})
+		res, err = evaluate(t, policy, records, mkInput("f1"))
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{ReasonDeviceUnauthenticated}, M{"device_type": "client_certificate"}}, res["allow"])
+	})
 }
@@ -8,12 +8,22 @@ type Reason string
 // Well-known reasons.
 const (
 	ReasonAccept                        = "accept"
+	ReasonACROK                         = "acr-ok"
+	ReasonACRUnauthorized               = "acr-unauthorized"
+	ReasonAMROK                         = "amr-ok"
+	ReasonAMRUnauthorized               = "amr-unauthorized"
+	ReasonAuthenticatedWithinOK         = "authenticated-within-ok"
+	ReasonAuthenticatedWithinExpired    = "authenticated-within-expired"
 	ReasonClaimOK                       = "claim-ok"
 	ReasonClaimUnauthorized             = "claim-unauthorized"
 	ReasonClientCertificateOK           = "client-certificate-ok"
 	ReasonClientCertificateUnauthorized = "client-certificate-unauthorized"
 	ReasonClientCertificateRequired     = "client-certificate-required"
+	ReasonCountryOK                     = "country-ok"
+	ReasonCountryUnauthorized           = "country-unauthorized"
 	ReasonCORSRequest                   = "cors-request"
+	ReasonDateRangeOK                   = "date-range-ok"
+	ReasonDateRangeUnauthorized         = "date-range-unauthorized"
 	ReasonDeviceOK                      = "device-ok"
 	ReasonDeviceUnauthenticated         = "device-unauthenticated"
 	ReasonDeviceUnauthorized            = "device-unauthorized"
@@ -33,7 +43,12 @@ const (
 	ReasonPomeriumRoute                 = "pomerium-route"
 	ReasonReject                        = "reject"
 	ReasonRouteNotFound                 = "route-not-found"
+	ReasonSourceIPOK                    = "source-ip-ok"
+	ReasonSourceIPUnauthorized          = "source-ip-unauthorized"
+	ReasonTimeOfDayOK                   = "time-of-day-ok"
+	ReasonTimeOfDayUnauthorized         = "time-of-day-unauthorized"
 	ReasonUserOK                        = "user-ok"
+	ReasonUserReauthenticate            = "user-reauthenticate"  // user needs to log in again
 	ReasonUserUnauthenticated           = "user-unauthenticated" // user needs to log in
 	ReasonUserUnauthorized              = "user-unauthorized"    // user does not have access
 	ReasonValidClientCertificate        = "valid-client-certificate"
@@ -0,0 +1,82 @@
+package criteria
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/open-policy-agent/opa/ast"
+
+	"github.com/pomerium/pomerium/pkg/policy/generator"
+	"github.com/pomerium/pomerium/pkg/policy/parser"
+)
+
+type sourceIPCriterion struct {
+	g *Generator
+}
+
+func (sourceIPCriterion) DataType() CriterionDataType {
+	return generator.CriterionDataTypeUnknown
+}
+
+func (sourceIPCriterion) Name() string {
+	return "source_ip"
+}
+
+func (c sourceIPCriterion) GenerateRule(_ string, data parser.Value) (*ast.Rule, []*ast.Rule, error) {
+	var values []parser.Value
+	switch data := data.(type) {
+	case parser.String:
+		values = append(values, data)
+	case parser.Array:
+		values = data
+	default:
+		return nil, nil, fmt.Errorf("expected string or array for source_ip criterion, got: %T", data)
+	}
+
+	var terms []*ast.Term
+	for _, v := range values {
+		s, ok := v.(parser.String)
+		if !ok {
+			return nil, nil, fmt.Errorf("expected string for source_ip criterion value, got: %T", v)
+		}
+		prefix, err := parseSourceIPPrefix(string(s))
+		if err != nil {
+			return nil, nil, err
+		}
+		terms = append(terms, ast.StringTerm(prefix.String()))
+	}
+
+	body := ast.Body{
+		ast.Assign.Expr(ast.VarTerm("cidrs"), ast.ArrayTerm(terms...)),
+		ast.MustParseExpr(`input.http.ip != ""`),
+		ast.MustParseExpr(`count(net.cidr_contains_matches(cidrs, input.http.ip)) > 0`),
+	}
+
+	rule := NewCriterionRule(c.g, c.Name(),
+		ReasonSourceIPOK, ReasonSourceIPUnauthorized,
+		body)
+
+	return rule, nil, nil
+}
+
+// parseSourceIPPrefix parses either a CIDR or a single IP address.
+func parseSourceIPPrefix(raw string) (netip.Prefix, error) {
+	if prefix, err := netip.ParsePrefix(raw); err == nil {
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(raw)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR or IP address for source_ip criterion: %s", raw)
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// SourceIP returns a Criterion which matches the downstream client IP address against a list of CIDRs.
+func SourceIP(generator *Generator) Criterion {
+	return sourceIPCriterion{g: generator}
+}
+
+func init() {
+	Register(SourceIP)
+}
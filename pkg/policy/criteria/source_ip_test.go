@@ -0,0 +1,59 @@
+package criteria
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+func TestSourceIP(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		res, err := evaluate(t, `
+allow:
+  and:
+    - source_ip: [10.0.0.0/8, "2001:db8::/32"]
+`, []*databroker.Record{}, Input{HTTP: InputHTTP{IP: "10.1.2.3"}})
+		require.NoError(t, err)
+		require.Equal(t, A{true, A{ReasonSourceIPOK}, M{}}, res["allow"])
+		require.Equal(t, A{false, A{}}, res["deny"])
+	})
+	t.Run("single address", func(t *testing.T) {
+		res, err := evaluate(t, `
+allow:
+  and:
+    - source_ip: 192.168.1.1
+`, []*databroker.Record{}, Input{HTTP: InputHTTP{IP: "192.168.1.1"}})
+		require.NoError(t, err)
+		require.Equal(t, A{true, A{ReasonSourceIPOK}, M{}}, res["allow"])
+	})
+	t.Run("unauthorized", func(t *testing.T) {
+		res, err := evaluate(t, `
+allow:
+  and:
+    - source_ip: [10.0.0.0/8]
+`, []*databroker.Record{}, Input{HTTP: InputHTTP{IP: "192.168.1.1"}})
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{ReasonSourceIPUnauthorized}, M{}}, res["allow"])
+		require.Equal(t, A{false, A{}}, res["deny"])
+	})
+	t.Run("missing ip", func(t *testing.T) {
+		res, err := evaluate(t, `
+allow:
+  and:
+    - source_ip: [0.0.0.0/0]
+`, []*databroker.Record{}, Input{})
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{ReasonSourceIPUnauthorized}, M{}}, res["allow"])
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := evaluate(t, `
+allow:
+  and:
+    - source_ip: [not-an-ip]
+`, nil, Input{})
+		assert.Error(t, err)
+	})
+}
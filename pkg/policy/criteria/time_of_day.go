@@ -0,0 +1,202 @@
+package criteria
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/open-policy-agent/opa/ast"
+
+	"github.com/pomerium/pomerium/pkg/policy/parser"
+)
+
+const (
+	timeOfDayOperatorAfter  = "after"
+	timeOfDayOperatorBefore = "before"
+	timeOfDayOperatorDays   = "days"
+	timeOfDayOperatorTZ     = "tz"
+)
+
+var timeOfDayOperatorLookup = map[string]struct{}{
+	timeOfDayOperatorAfter:  {},
+	timeOfDayOperatorBefore: {},
+	timeOfDayOperatorDays:   {},
+	timeOfDayOperatorTZ:     {},
+}
+
+// weekdayLookup maps the accepted spellings of a weekday to the name
+// returned by the rego time.weekday builtin.
+var weekdayLookup = func() map[string]string {
+	m := make(map[string]string)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		name := d.String()
+		m[strings.ToLower(name)] = name
+		m[strings.ToLower(name[:3])] = name
+	}
+	return m
+}()
+
+const secondsPerDay = 24 * 60 * 60
+
+type timeOfDayCriterion struct {
+	g *Generator
+}
+
+func (timeOfDayCriterion) DataType() CriterionDataType {
+	return CriterionDataTypeTimeOfDayMatcher
+}
+
+func (timeOfDayCriterion) Name() string {
+	return "time_of_day"
+}
+
+func (c timeOfDayCriterion) GenerateRule(_ string, data parser.Value) (*ast.Rule, []*ast.Rule, error) {
+	obj, ok := data.(parser.Object)
+	if !ok {
+		return nil, nil, fmt.Errorf("expected object for time_of_day criterion, got: %T", data)
+	}
+
+	for k := range obj {
+		_, ok := timeOfDayOperatorLookup[k]
+		if !ok {
+			return nil, nil, fmt.Errorf("unexpected field in time_of_day criterion: %s", k)
+		}
+	}
+
+	tz := "UTC"
+	if v, ok := obj[timeOfDayOperatorTZ]; ok {
+		s, ok := v.(parser.String)
+		if !ok {
+			return nil, nil, fmt.Errorf("expected string for time_of_day criterion tz operator, got %T", v)
+		}
+		if _, err := time.LoadLocation(string(s)); err != nil {
+			return nil, nil, fmt.Errorf("invalid time zone for time_of_day criterion: %w", err)
+		}
+		tz = string(s)
+	}
+
+	body := ast.Body{
+		ast.MustParseExpr(`now := time.now_ns()`),
+		ast.Assign.Expr(ast.VarTerm("tz"), ast.StringTerm(tz)),
+		ast.MustParseExpr(`clock := time.clock([now, tz])`),
+		ast.MustParseExpr(`seconds := ((clock[0] * 3600) + (clock[1] * 60)) + clock[2]`),
+	}
+
+	after, hasAfter, err := parseTimeOfDayOperator(obj, timeOfDayOperatorAfter)
+	if err != nil {
+		return nil, nil, err
+	}
+	before, hasBefore, err := parseTimeOfDayOperator(obj, timeOfDayOperatorBefore)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if hasAfter && hasBefore && after == before {
+		return nil, nil, fmt.Errorf("invalid time_of_day criterion, after and before must differ")
+	}
+
+	switch {
+	case hasAfter && hasBefore:
+		// rotate the clock so that the window starts at zero, this handles
+		// windows that wrap around midnight (ie. after 22:00, before 06:00)
+		body = append(body,
+			ast.Assign.Expr(ast.VarTerm("window_start"), ast.IntNumberTerm(after)),
+			ast.Assign.Expr(ast.VarTerm("window_length"), ast.IntNumberTerm((before-after+secondsPerDay)%secondsPerDay)),
+			ast.MustParseExpr(`((seconds - window_start) + 86400) % 86400 < window_length`),
+		)
+	case hasAfter:
+		body = append(body, ast.GreaterThanEq.Expr(ast.VarTerm("seconds"), ast.IntNumberTerm(after)))
+	case hasBefore:
+		body = append(body, ast.LessThan.Expr(ast.VarTerm("seconds"), ast.IntNumberTerm(before)))
+	}
+
+	if v, ok := obj[timeOfDayOperatorDays]; ok {
+		days, err := parseWeekdays(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		var terms []*ast.Term
+		for _, day := range days {
+			terms = append(terms, ast.StringTerm(day))
+		}
+		body = append(body,
+			ast.MustParseExpr(`weekday := time.weekday([now, tz])`),
+			ast.Member.Expr(ast.VarTerm("weekday"), ast.SetTerm(terms...)),
+		)
+	}
+
+	rule := NewCriterionRule(c.g, c.Name(),
+		ReasonTimeOfDayOK, ReasonTimeOfDayUnauthorized,
+		body)
+
+	return rule, nil, nil
+}
+
+// parseTimeOfDayOperator parses a "HH:MM" or "HH:MM:SS" value into the
+// number of seconds since midnight.
+func parseTimeOfDayOperator(obj parser.Object, operator string) (seconds int, ok bool, err error) {
+	v, ok := obj[operator]
+	if !ok {
+		return 0, false, nil
+	}
+
+	s, ok := v.(parser.String)
+	if !ok {
+		return 0, false, fmt.Errorf("expected string for time_of_day criterion %s operator, got %T", operator, v)
+	}
+
+	var t time.Time
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		t, err = time.Parse(layout, string(s))
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid time for time_of_day criterion %s operator, expected HH:MM: %s", operator, s)
+	}
+
+	return t.Hour()*3600 + t.Minute()*60 + t.Second(), true, nil
+}
+
+func parseWeekdays(v parser.Value) ([]string, error) {
+	var values []parser.Value
+	switch v := v.(type) {
+	case parser.String:
+		values = append(values, v)
+	case parser.Array:
+		values = v
+	default:
+		return nil, fmt.Errorf("expected string or array for time_of_day criterion days operator, got %T", v)
+	}
+
+	seen := map[string]struct{}{}
+	for _, value := range values {
+		s, ok := value.(parser.String)
+		if !ok {
+			return nil, fmt.Errorf("expected string for time_of_day criterion day, got %T", value)
+		}
+		day, ok := weekdayLookup[strings.ToLower(string(s))]
+		if !ok {
+			return nil, fmt.Errorf("unknown day for time_of_day criterion: %s", s)
+		}
+		seen[day] = struct{}{}
+	}
+
+	days := make([]string, 0, len(seen))
+	for day := range seen {
+		days = append(days, day)
+	}
+	sort.Strings(days)
+	return days, nil
+}
+
+// TimeOfDay returns a Criterion which matches the time of day and day of week.
+func TimeOfDay(generator *Generator) Criterion {
+	return timeOfDayCriterion{g: generator}
+}
+
+func init() {
+	Register(TimeOfDay)
+}
@@ -0,0 +1,81 @@
+package criteria
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+func TestTimeOfDay(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	now := testingNow.In(berlin)
+
+	policy := func(after, before time.Time, extra string) string {
+		return fmt.Sprintf(`
+allow:
+  and:
+    - time_of_day:
+        tz: Europe/Berlin
+        after: "%s"
+        before: "%s"
+%s`, after.Format("15:04"), before.Format("15:04"), extra)
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		res, err := evaluate(t, policy(now.Add(-time.Hour), now.Add(time.Hour), ""),
+			[]*databroker.Record{}, Input{})
+		require.NoError(t, err)
+		require.Equal(t, A{true, A{ReasonTimeOfDayOK}, M{}}, res["allow"])
+		require.Equal(t, A{false, A{}}, res["deny"])
+	})
+	t.Run("unauthorized", func(t *testing.T) {
+		res, err := evaluate(t, policy(now.Add(time.Hour), now.Add(2*time.Hour), ""),
+			[]*databroker.Record{}, Input{})
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{ReasonTimeOfDayUnauthorized}, M{}}, res["allow"])
+		require.Equal(t, A{false, A{}}, res["deny"])
+	})
+	t.Run("wrap around midnight", func(t *testing.T) {
+		res, err := evaluate(t, policy(now.Add(-time.Hour), now.Add(-2*time.Hour), ""),
+			[]*databroker.Record{}, Input{})
+		require.NoError(t, err)
+		require.Equal(t, A{true, A{ReasonTimeOfDayOK}, M{}}, res["allow"])
+
+		res, err = evaluate(t, policy(now.Add(time.Hour), now.Add(-time.Hour), ""),
+			[]*databroker.Record{}, Input{})
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{ReasonTimeOfDayUnauthorized}, M{}}, res["allow"])
+	})
+	t.Run("days", func(t *testing.T) {
+		res, err := evaluate(t, policy(now.Add(-time.Hour), now.Add(time.Hour),
+			fmt.Sprintf("        days: [%s]", now.Weekday().String()[:3])),
+			[]*databroker.Record{}, Input{})
+		require.NoError(t, err)
+		require.Equal(t, A{true, A{ReasonTimeOfDayOK}, M{}}, res["allow"])
+
+		res, err = evaluate(t, policy(now.Add(-time.Hour), now.Add(time.Hour),
+			fmt.Sprintf("        days: %s", (now.Weekday()+1)%7)),
+			[]*databroker.Record{}, Input{})
+		require.NoError(t, err)
+		require.Equal(t, A{false, A{ReasonTimeOfDayUnauthorized}, M{}}, res["allow"])
+	})
+	t.Run("invalid", func(t *testing.T) {
+		for _, raw := range []string{
+			`time_of_day: "08:00"`,
+			`time_of_day: {tz: Mars/Olympus_Mons}`,
+			`time_of_day: {after: "8am"}`,
+			`time_of_day: {days: [someday]}`,
+			`time_of_day: {from: "08:00"}`,
+			`time_of_day: {after: "08:00", before: "08:00:00"}`,
+		} {
+			_, err := evaluate(t, "allow:\n  and:\n    - "+raw, nil, Input{})
+			assert.Error(t, err, raw)
+		}
+	})
+}
@@ -43,13 +43,45 @@ get_user_email(session, user) := v if {
 `)
 }
 
-// GetDeviceCredential gets the device credential for the given session.
+// GetSessionAuthTime gets the time, in nanoseconds since the epoch, at which
+// the user last authenticated with the identity provider. It uses the
+// auth_time claim if available, otherwise the time the ID token or the session
+// was issued.
+func GetSessionAuthTime() *ast.Rule {
+	return MustParse(`
+get_session_auth_time(session) := v if {
+	v = session.claims.auth_time[0] * 1000000000
+} else := v if {
+	v = session.id_token.issued_at.seconds * 1000000000
+} else := v if {
+	v = session.issued_at.seconds * 1000000000
+} else := 0
+`)
+}
+
+// GetDeviceCredential gets the device credential for the given session. If
+// the session has no credential for the device type, the credential enrolled
+// for the presented client certificate is used, as long as the certificate
+// is currently valid. The validity period is compared as UTC RFC 3339
+// strings, as a NotBefore of year 1 can't be represented in nanoseconds.
 func GetDeviceCredential() *ast.Rule {
 	return MustParse(`
 get_device_credential(session, device_type_id) := v if {
 	device_credential_id := [x.Credential.Id|x:=session.device_credentials[_];x.type_id==device_type_id][0]
 	v = get_databroker_record("type.googleapis.com/pomerium.device.Credential", device_credential_id)
 	v != null
+} else := v if {
+	fingerprint := input.http.client_certificate.fingerprint
+	fingerprint != ""
+	cert := crypto.x509.parse_certificates(trim_space(input.http.client_certificate.leaf))[0]
+	now := time.format([time.now_ns(), "UTC", "2006-01-02T15:04:05Z07:00"])
+	cert.NotBefore <= now
+	now < cert.NotAfter
+	device_credential_id := crypto.sha256(concat("|", [device_type_id, fingerprint]))
+	v = get_databroker_record("type.googleapis.com/pomerium.device.Credential", device_credential_id)
+	v != null
+	v.user_id == session.user_id
+	v.type_id == device_type_id
 } else := {}
 `)
 }
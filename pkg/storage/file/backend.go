@@ -0,0 +1,328 @@
+// Package file contains a file-backed implementation of the databroker backend
+// using bbolt.
+package file
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/signal"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/health"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+type lease struct {
+	id     string
+	expiry time.Time
+}
+
+// A Backend stores data in a file on disk.
+type Backend struct {
+	cfg           *config
+	db            *bbolt.DB
+	onChange      *signal.Signal
+	serverVersion uint64
+
+	closeOnce sync.Once
+	closed    chan struct{}
+
+	mu     sync.Mutex
+	leases map[string]*lease
+}
+
+// New creates a new file backend storage. The file at path is created if it
+// doesn't exist.
+func New(path string, options ...Option) (*Backend, error) {
+	cfg := getConfig(options...)
+
+	db, err := bbolt.Open(path, 0o600, &bbolt.Options{
+		Timeout: cfg.openTimeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("storage/file: error opening database: %w", err)
+	}
+
+	var serverVersion uint64
+	err = db.Update(func(tx *bbolt.Tx) error {
+		serverVersion, err = setup(tx)
+		return err
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("storage/file: error initializing database: %w", err)
+	}
+
+	backend := &Backend{
+		cfg:           cfg,
+		db:            db,
+		onChange:      signal.New(),
+		serverVersion: serverVersion,
+		closed:        make(chan struct{}),
+		leases:        make(map[string]*lease),
+	}
+	if cfg.expiry != 0 {
+		go func() {
+			ticker := time.NewTicker(time.Second)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-backend.closed:
+					return
+				case <-ticker.C:
+				}
+
+				err := backend.removeChangesBefore(time.Now().Add(-cfg.expiry))
+				if err != nil {
+					log.Error().Err(err).Msg("storage/file: error removing changes")
+				}
+			}
+		}()
+	}
+
+	health.ReportOK(health.StorageBackend, health.StrAttr("backend", "file"))
+
+	return backend, nil
+}
+
+func (backend *Backend) removeChangesBefore(cutoff time.Time) error {
+	return backend.db.Update(func(tx *bbolt.Tx) error {
+		return deleteChangesBefore(tx, cutoff)
+	})
+}
+
+// Close closes the underlying database file.
+func (backend *Backend) Close() error {
+	var err error
+	backend.closeOnce.Do(func() {
+		close(backend.closed)
+		err = backend.db.Close()
+	})
+	return err
+}
+
+// Get gets a record from the file store.
+func (backend *Backend) Get(_ context.Context, recordType, id string) (record *databroker.Record, err error) {
+	err = backend.db.View(func(tx *bbolt.Tx) error {
+		record, err = getRecord(tx, recordType, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	} else if record == nil {
+		return nil, storage.ErrNotFound
+	}
+	return record, nil
+}
+
+// GetOptions returns the options for a type in the file store.
+func (backend *Backend) GetOptions(_ context.Context, recordType string) (options *databroker.Options, err error) {
+	err = backend.db.View(func(tx *bbolt.Tx) error {
+		options, err = getOptions(tx, recordType)
+		return err
+	})
+	return options, err
+}
+
+// Lease acquires or renews a lease. Leases are only held in memory as the
+// database file can only be used by a single process.
+func (backend *Backend) Lease(_ context.Context, leaseName, leaseID string, ttl time.Duration) (bool, error) {
+	backend.mu.Lock()
+	defer backend.mu.Unlock()
+
+	l, ok := backend.leases[leaseName]
+	// if there is no lease, or its expired, acquire a new one.
+	if !ok || l.expiry.Before(time.Now()) {
+		// remove any other expired leases, as leases used once are never released
+		for name, l := range backend.leases {
+			if l.expiry.Before(time.Now()) {
+				delete(backend.leases, name)
+			}
+		}
+		backend.leases[leaseName] = &lease{
+			id:     leaseID,
+			expiry: time.Now().Add(ttl),
+		}
+		return true, nil
+	}
+
+	// if the lease doesn't match, we can't acquire it
+	if l.id != leaseID {
+		return false, nil
+	}
+
+	// release the lease
+	if ttl <= 0 {
+		delete(backend.leases, leaseName)
+		return false, nil
+	}
+
+	// update the expiry (renew the lease)
+	l.expiry = time.Now().Add(ttl)
+	return true, nil
+}
+
+// ListTypes lists the record types.
+func (backend *Backend) ListTypes(_ context.Context) (recordTypes []string, err error) {
+	err = backend.db.View(func(tx *bbolt.Tx) error {
+		recordTypes, err = listTypes(tx)
+		return err
+	})
+	return recordTypes, err
+}
+
+// Put puts a record into the file store.
+func (backend *Backend) Put(ctx context.Context, records []*databroker.Record) (serverVersion uint64, err error) {
+	defer backend.onChange.Broadcast(ctx)
+
+	err = backend.db.Update(func(tx *bbolt.Tx) error {
+		recordTypes := map[string]struct{}{}
+		for _, record := range records {
+			if record == nil {
+				return fmt.Errorf("records cannot be nil")
+			}
+
+			err := putRecord(tx, record)
+			if err != nil {
+				return err
+			}
+
+			recordTypes[record.GetType()] = struct{}{}
+		}
+		for recordType := range recordTypes {
+			err := enforceCapacity(tx, recordType)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return backend.serverVersion, err
+}
+
+// Patch updates the specified fields of existing record(s).
+func (backend *Backend) Patch(
+	ctx context.Context, records []*databroker.Record, fields *fieldmaskpb.FieldMask,
+) (serverVersion uint64, patchedRecords []*databroker.Record, err error) {
+	defer backend.onChange.Broadcast(ctx)
+
+	serverVersion = backend.serverVersion
+	err = backend.db.Update(func(tx *bbolt.Tx) error {
+		patchedRecords = make([]*databroker.Record, 0, len(records))
+		for _, record := range records {
+			err := patch(tx, record, fields)
+			if storage.IsNotFound(err) {
+				// Skip any record that does not currently exist.
+				continue
+			} else if err != nil {
+				return err
+			}
+			patchedRecords = append(patchedRecords, record)
+		}
+		return nil
+	})
+	if err != nil {
+		return serverVersion, nil, err
+	}
+
+	return serverVersion, patchedRecords, nil
+}
+
+// patch updates the specified fields of an existing record.
+func patch(tx *bbolt.Tx, record *databroker.Record, fields *fieldmaskpb.FieldMask) error {
+	if record == nil {
+		return fmt.Errorf("cannot patch using a nil record")
+	}
+
+	existing, err := getRecord(tx, record.GetType(), record.GetId())
+	if err != nil {
+		return err
+	} else if existing == nil {
+		return storage.ErrNotFound
+	}
+
+	if err := storage.PatchRecord(existing, record, fields); err != nil {
+		return err
+	}
+
+	return putRecord(tx, record)
+}
+
+// SetOptions sets the options for a type in the file store.
+func (backend *Backend) SetOptions(ctx context.Context, recordType string, options *databroker.Options) error {
+	defer backend.onChange.Broadcast(ctx)
+
+	return backend.db.Update(func(tx *bbolt.Tx) error {
+		err := setOptions(tx, recordType, options)
+		if err != nil {
+			return err
+		}
+		return enforceCapacity(tx, recordType)
+	})
+}
+
+// Sync returns a record stream for any changes after recordVersion.
+func (backend *Backend) Sync(ctx context.Context, recordType string, serverVersion, recordVersion uint64) (storage.RecordStream, error) {
+	if serverVersion != backend.serverVersion {
+		return nil, storage.ErrInvalidServerVersion
+	}
+	return newSyncRecordStream(ctx, backend, recordType, recordVersion), nil
+}
+
+// SyncLatest returns a record stream for all the records.
+func (backend *Backend) SyncLatest(
+	ctx context.Context,
+	recordType string,
+	expr storage.FilterExpression,
+) (serverVersion, recordVersion uint64, stream storage.RecordStream, err error) {
+	filter, err := storage.RecordStreamFilterFromFilterExpression(expr)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	var records []*databroker.Record
+	err = backend.db.View(func(tx *bbolt.Tx) error {
+		recordVersion = getLastVersion(tx)
+
+		recordTypes := []string{recordType}
+		if recordType == "" {
+			recordTypes, err = listTypes(tx)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, recordType := range recordTypes {
+			all, err := listRecords(tx, recordType)
+			if err != nil {
+				return err
+			}
+			for _, record := range all {
+				if filter(record) {
+					records = append(records, record)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	return backend.serverVersion, recordVersion, storage.RecordListToStream(ctx, records), nil
+}
+
+func (backend *Backend) getSince(recordType string, version uint64) (records []*databroker.Record, err error) {
+	err = backend.db.View(func(tx *bbolt.Tx) error {
+		records, err = listChangesAfter(tx, recordType, version)
+		return err
+	})
+	return records, err
+}
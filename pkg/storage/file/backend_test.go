@@ -0,0 +1,337 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/storage"
+	"github.com/pomerium/pomerium/pkg/storage/storagetest"
+)
+
+func newTestBackend(t *testing.T, options ...Option) *Backend {
+	t.Helper()
+
+	backend, err := New(filepath.Join(t.TempDir(), "databroker.db"), options...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = backend.Close() })
+	return backend
+}
+
+func TestBackend(t *testing.T) {
+	ctx := context.Background()
+	backend := newTestBackend(t)
+	t.Run("get missing record", func(t *testing.T) {
+		record, err := backend.Get(ctx, "TYPE", "abcd")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		assert.Nil(t, record)
+	})
+	t.Run("get record", func(t *testing.T) {
+		data := new(anypb.Any)
+		sv, err := backend.Put(ctx, []*databroker.Record{
+			{
+				Type: "TYPE",
+				Id:   "a",
+				Data: data,
+			},
+			{
+				Type: "TYPE",
+				Id:   "b",
+				Data: data,
+			},
+			{
+				Type: "TYPE",
+				Id:   "c",
+				Data: data,
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, backend.serverVersion, sv)
+		for i, id := range []string{"a", "b", "c"} {
+			record, err := backend.Get(ctx, "TYPE", id)
+			require.NoError(t, err)
+			if assert.NotNil(t, record) {
+				assert.Empty(t, record.Data.GetTypeUrl())
+				assert.Nil(t, record.DeletedAt)
+				assert.Equal(t, id, record.Id)
+				assert.NotNil(t, record.ModifiedAt)
+				assert.Equal(t, "TYPE", record.Type)
+				assert.Equal(t, uint64(i+1), record.Version)
+			}
+		}
+	})
+	t.Run("delete record", func(t *testing.T) {
+		sv, err := backend.Put(ctx, []*databroker.Record{{
+			Type:      "TYPE",
+			Id:        "a",
+			DeletedAt: timestamppb.Now(),
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, backend.serverVersion, sv)
+		record, err := backend.Get(ctx, "TYPE", "a")
+		assert.Error(t, err)
+		assert.Nil(t, record)
+	})
+	t.Run("list types", func(t *testing.T) {
+		types, err := backend.ListTypes(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"TYPE"}, types)
+	})
+	t.Run("filter", func(t *testing.T) {
+		_, _, stream, err := backend.SyncLatest(ctx, "", storage.EqualsFilterExpression{
+			Fields: []string{"id"},
+			Value:  "b",
+		})
+		require.NoError(t, err)
+		records, err := storage.RecordStreamToList(stream)
+		require.NoError(t, err)
+		if assert.Len(t, records, 1) {
+			assert.Equal(t, "b", records[0].GetId())
+		}
+	})
+	t.Run("patch", func(t *testing.T) {
+		storagetest.TestBackendPatch(t, ctx, backend)
+	})
+}
+
+func TestPersistence(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "databroker.db")
+
+	backend, err := New(path)
+	require.NoError(t, err)
+	serverVersion, err := backend.Put(ctx, []*databroker.Record{{
+		Type: "TYPE",
+		Id:   "a",
+	}})
+	require.NoError(t, err)
+	require.NoError(t, backend.Close())
+
+	backend, err = New(path)
+	require.NoError(t, err)
+	defer func() { _ = backend.Close() }()
+
+	assert.Equal(t, serverVersion, backend.serverVersion,
+		"server version should be preserved")
+	record, err := backend.Get(ctx, "TYPE", "a")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), record.GetVersion())
+
+	_, err = backend.Put(ctx, []*databroker.Record{{
+		Type: "TYPE",
+		Id:   "b",
+	}})
+	require.NoError(t, err)
+	record, err = backend.Get(ctx, "TYPE", "b")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), record.GetVersion(),
+		"record versions should continue from the last version")
+}
+
+func TestLocked(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "databroker.db")
+
+	backend, err := New(path)
+	require.NoError(t, err)
+	defer func() { _ = backend.Close() }()
+
+	_, err = New(path, WithOpenTimeout(10*time.Millisecond))
+	assert.Error(t, err, "should not be able to open a database in use")
+}
+
+func TestExpiry(t *testing.T) {
+	ctx := context.Background()
+	backend := newTestBackend(t, WithExpiry(0))
+
+	for i := 0; i < 1000; i++ {
+		sv, err := backend.Put(ctx, []*databroker.Record{{
+			Type: "TYPE",
+			Id:   fmt.Sprint(i),
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, backend.serverVersion, sv)
+	}
+	stream, err := backend.Sync(ctx, "", backend.serverVersion, 0)
+	require.NoError(t, err)
+	records, err := storage.RecordStreamToList(stream)
+	require.NoError(t, err)
+	_ = stream.Close()
+	require.Len(t, records, 1000)
+
+	require.NoError(t, backend.removeChangesBefore(time.Now().Add(time.Second)))
+
+	stream, err = backend.Sync(ctx, "", backend.serverVersion, 0)
+	require.NoError(t, err)
+	records, err = storage.RecordStreamToList(stream)
+	require.NoError(t, err)
+	_ = stream.Close()
+	require.Len(t, records, 0)
+}
+
+func TestConcurrency(t *testing.T) {
+	ctx := context.Background()
+	backend := newTestBackend(t)
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		for i := 0; i < 100; i++ {
+			_, _ = backend.Get(ctx, "", fmt.Sprint(i))
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		for i := 0; i < 100; i++ {
+			_, err := backend.Put(ctx, []*databroker.Record{{
+				Id: fmt.Sprint(i),
+			}})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	assert.NoError(t, eg.Wait())
+}
+
+func TestStream(t *testing.T) {
+	ctx := context.Background()
+	backend := newTestBackend(t)
+
+	stream, err := backend.Sync(ctx, "TYPE", backend.serverVersion, 0)
+	require.NoError(t, err)
+	defer func() { _ = stream.Close() }()
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		for i := 0; i < 1000; i++ {
+			assert.True(t, stream.Next(true))
+			assert.Nil(t, stream.Err())
+			assert.Equal(t, "TYPE", stream.Record().GetType())
+			assert.Equal(t, fmt.Sprint(i), stream.Record().GetId())
+			assert.Equal(t, uint64(i+1), stream.Record().GetVersion())
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		for i := 0; i < 1000; i++ {
+			_, err := backend.Put(ctx, []*databroker.Record{{
+				Type: "TYPE",
+				Id:   fmt.Sprint(i),
+			}})
+			assert.NoError(t, err)
+		}
+		return nil
+	})
+	require.NoError(t, eg.Wait())
+}
+
+func TestStreamClose(t *testing.T) {
+	ctx := context.Background()
+	t.Run("by backend", func(t *testing.T) {
+		backend := newTestBackend(t)
+		stream, err := backend.Sync(ctx, "", backend.serverVersion, 0)
+		require.NoError(t, err)
+		require.NoError(t, backend.Close())
+		assert.False(t, stream.Next(true))
+		assert.Error(t, stream.Err())
+	})
+	t.Run("by stream", func(t *testing.T) {
+		backend := newTestBackend(t)
+		stream, err := backend.Sync(ctx, "", backend.serverVersion, 0)
+		require.NoError(t, err)
+		require.NoError(t, stream.Close())
+		assert.False(t, stream.Next(true))
+		assert.Error(t, stream.Err())
+	})
+	t.Run("by context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		backend := newTestBackend(t)
+		stream, err := backend.Sync(ctx, "", backend.serverVersion, 0)
+		require.NoError(t, err)
+		cancel()
+		assert.False(t, stream.Next(true))
+		assert.Error(t, stream.Err())
+	})
+}
+
+func TestCapacity(t *testing.T) {
+	ctx := context.Background()
+	backend := newTestBackend(t)
+
+	err := backend.SetOptions(ctx, "EXAMPLE", &databroker.Options{
+		Capacity: proto.Uint64(3),
+	})
+	require.NoError(t, err)
+
+	options, err := backend.GetOptions(ctx, "EXAMPLE")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), options.GetCapacity())
+
+	for i := 0; i < 10; i++ {
+		_, err = backend.Put(ctx, []*databroker.Record{{
+			Type: "EXAMPLE",
+			Id:   fmt.Sprint(i),
+		}})
+		require.NoError(t, err)
+	}
+
+	_, _, stream, err := backend.SyncLatest(ctx, "EXAMPLE", nil)
+	require.NoError(t, err)
+
+	records, err := storage.RecordStreamToList(stream)
+	require.NoError(t, err)
+	assert.Len(t, records, 3)
+
+	var ids []string
+	for _, r := range records {
+		ids = append(ids, r.GetId())
+	}
+	assert.Equal(t, []string{"7", "8", "9"}, ids, "should contain recent records")
+
+	stream, err = backend.Sync(ctx, "EXAMPLE", backend.serverVersion, 0)
+	require.NoError(t, err)
+	changes, err := storage.RecordStreamToList(stream)
+	require.NoError(t, err)
+	var deleted int
+	for _, r := range changes {
+		if r.GetDeletedAt() != nil {
+			deleted++
+		}
+	}
+	assert.Equal(t, 7, deleted, "should record deletions for removed records")
+}
+
+func TestLease(t *testing.T) {
+	ctx := context.Background()
+	backend := newTestBackend(t)
+	{
+		ok, err := backend.Lease(ctx, "test", "a", time.Second*30)
+		require.NoError(t, err)
+		assert.True(t, ok, "expected a to acquire the lease")
+	}
+	{
+		ok, err := backend.Lease(ctx, "test", "b", time.Second*30)
+		require.NoError(t, err)
+		assert.False(t, ok, "expected b to fail to acquire the lease")
+	}
+	{
+		ok, err := backend.Lease(ctx, "test", "a", 0)
+		require.NoError(t, err)
+		assert.False(t, ok, "expected a to clear the lease")
+	}
+	{
+		ok, err := backend.Lease(ctx, "test", "b", time.Second*30)
+		require.NoError(t, err)
+		assert.True(t, ok, "expected b to to acquire the lease")
+	}
+}
@@ -0,0 +1,293 @@
+package file
+
+import (
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// The database is laid out as:
+//
+//	meta/last_version          the version of the most recent change
+//	meta/server_version        the server version, created once per file
+//	changes/{version}          every change, ordered by version
+//	options/{type}             the options for a record type
+//	records/{type}/{id}        the latest version of each record
+//	order/{type}/{version}     the record ids of a type in insertion order
+//
+// bbolt doesn't allow empty bucket names, so the nested type buckets are
+// prefixed.
+var (
+	changesBucket = []byte("changes")
+	metaBucket    = []byte("meta")
+	optionsBucket = []byte("options")
+	orderBucket   = []byte("order")
+	recordsBucket = []byte("records")
+
+	lastVersionKey   = []byte("last_version")
+	serverVersionKey = []byte("server_version")
+)
+
+const typeBucketPrefix = "type:"
+
+func typeBucketName(recordType string) []byte {
+	return []byte(typeBucketPrefix + recordType)
+}
+
+func encodeVersion(version uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, version)
+}
+
+func decodeVersion(data []byte) uint64 {
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(data)
+}
+
+func decodeRecord(data []byte) (*databroker.Record, error) {
+	record := new(databroker.Record)
+	err := proto.Unmarshal(data, record)
+	if err != nil {
+		return nil, fmt.Errorf("storage/file: invalid record: %w", err)
+	}
+	return record, nil
+}
+
+// setup creates the buckets and returns the server version.
+func setup(tx *bbolt.Tx) (serverVersion uint64, err error) {
+	for _, name := range [][]byte{changesBucket, metaBucket, optionsBucket, orderBucket, recordsBucket} {
+		_, err = tx.CreateBucketIfNotExists(name)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	meta := tx.Bucket(metaBucket)
+	serverVersion = decodeVersion(meta.Get(serverVersionKey))
+	if serverVersion == 0 {
+		serverVersion = cryptutil.NewRandomUInt64()
+		err = meta.Put(serverVersionKey, encodeVersion(serverVersion))
+	}
+	return serverVersion, err
+}
+
+func getLastVersion(tx *bbolt.Tx) uint64 {
+	return decodeVersion(tx.Bucket(metaBucket).Get(lastVersionKey))
+}
+
+func getOptions(tx *bbolt.Tx, recordType string) (*databroker.Options, error) {
+	options := new(databroker.Options)
+	data := tx.Bucket(optionsBucket).Get([]byte(recordType))
+	if data == nil {
+		return options, nil
+	}
+
+	err := proto.Unmarshal(data, options)
+	if err != nil {
+		return nil, fmt.Errorf("storage/file: invalid options: %w", err)
+	}
+	return options, nil
+}
+
+func setOptions(tx *bbolt.Tx, recordType string, options *databroker.Options) error {
+	if options.Capacity == nil {
+		return tx.Bucket(optionsBucket).Delete([]byte(recordType))
+	}
+
+	data, err := proto.Marshal(options)
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(optionsBucket).Put([]byte(recordType), data)
+}
+
+// getRecord returns the record, or nil if it doesn't exist.
+func getRecord(tx *bbolt.Tx, recordType, recordID string) (*databroker.Record, error) {
+	records := tx.Bucket(recordsBucket).Bucket(typeBucketName(recordType))
+	if records == nil {
+		return nil, nil
+	}
+
+	data := records.Get([]byte(recordID))
+	if data == nil {
+		return nil, nil
+	}
+
+	return decodeRecord(data)
+}
+
+// listRecords returns all the records of a type in insertion order.
+func listRecords(tx *bbolt.Tx, recordType string) ([]*databroker.Record, error) {
+	records := tx.Bucket(recordsBucket).Bucket(typeBucketName(recordType))
+	order := tx.Bucket(orderBucket).Bucket(typeBucketName(recordType))
+	if records == nil || order == nil {
+		return nil, nil
+	}
+
+	var all []*databroker.Record
+	err := order.ForEach(func(_, recordID []byte) error {
+		record, err := decodeRecord(records.Get(recordID))
+		if err != nil {
+			return err
+		}
+		all = append(all, record)
+		return nil
+	})
+	return all, err
+}
+
+func listTypes(tx *bbolt.Tx) ([]string, error) {
+	var recordTypes []string
+	err := tx.Bucket(recordsBucket).ForEachBucket(func(name []byte) error {
+		recordTypes = append(recordTypes, string(name[len(typeBucketPrefix):]))
+		return nil
+	})
+	return recordTypes, err
+}
+
+// listChangesAfter returns all the changes after the given version.
+func listChangesAfter(tx *bbolt.Tx, recordType string, version uint64) ([]*databroker.Record, error) {
+	var changes []*databroker.Record
+	c := tx.Bucket(changesBucket).Cursor()
+	for k, v := c.Seek(encodeVersion(version + 1)); k != nil; k, v = c.Next() {
+		record, err := decodeRecord(v)
+		if err != nil {
+			return nil, err
+		}
+		if recordType == "" || record.GetType() == recordType {
+			changes = append(changes, record)
+		}
+	}
+	return changes, nil
+}
+
+// putRecord stores a record and its change, updating the record's version and
+// modified at timestamp.
+func putRecord(tx *bbolt.Tx, record *databroker.Record) error {
+	meta := tx.Bucket(metaBucket)
+	version := getLastVersion(tx) + 1
+	err := meta.Put(lastVersionKey, encodeVersion(version))
+	if err != nil {
+		return err
+	}
+
+	record.ModifiedAt = timestamppb.Now()
+	record.Version = version
+	data, err := proto.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Bucket(changesBucket).Put(encodeVersion(version), data)
+	if err != nil {
+		return err
+	}
+
+	records, err := tx.Bucket(recordsBucket).CreateBucketIfNotExists(typeBucketName(record.GetType()))
+	if err != nil {
+		return err
+	}
+	order, err := tx.Bucket(orderBucket).CreateBucketIfNotExists(typeBucketName(record.GetType()))
+	if err != nil {
+		return err
+	}
+
+	// remove the previous version from the insertion order
+	if existing := records.Get([]byte(record.GetId())); existing != nil {
+		previous, err := decodeRecord(existing)
+		if err != nil {
+			return err
+		}
+		err = order.Delete(encodeVersion(previous.GetVersion()))
+		if err != nil {
+			return err
+		}
+	}
+
+	if record.GetDeletedAt() != nil {
+		return records.Delete([]byte(record.GetId()))
+	}
+
+	err = records.Put([]byte(record.GetId()), data)
+	if err != nil {
+		return err
+	}
+	return order.Put(encodeVersion(version), []byte(record.GetId()))
+}
+
+// enforceCapacity deletes the oldest records of a type that exceed the
+// capacity set in the type's options.
+func enforceCapacity(tx *bbolt.Tx, recordType string) error {
+	options, err := getOptions(tx, recordType)
+	if err != nil {
+		return err
+	}
+	if options.Capacity == nil {
+		return nil
+	}
+
+	order := tx.Bucket(orderBucket).Bucket(typeBucketName(recordType))
+	if order == nil {
+		return nil
+	}
+
+	var recordIDs []string
+	c := order.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		recordIDs = append(recordIDs, string(v))
+	}
+
+	for len(recordIDs) > int(options.GetCapacity()) {
+		// delete the record
+		record, err := getRecord(tx, recordType, recordIDs[0])
+		if err != nil {
+			return err
+		}
+		record.DeletedAt = timestamppb.Now()
+		err = putRecord(tx, record)
+		if err != nil {
+			return err
+		}
+
+		// move forward
+		recordIDs = recordIDs[1:]
+	}
+
+	return nil
+}
+
+// deleteChangesBefore deletes any changes modified before the cutoff.
+func deleteChangesBefore(tx *bbolt.Tx, cutoff time.Time) error {
+	var keys [][]byte
+	c := tx.Bucket(changesBucket).Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		record, err := decodeRecord(v)
+		if err != nil {
+			return err
+		}
+
+		// nothing left to remove
+		if !record.GetModifiedAt().AsTime().Before(cutoff) {
+			break
+		}
+
+		keys = append(keys, slices.Clone(k))
+	}
+
+	for _, k := range keys {
+		err := tx.Bucket(changesBucket).Delete(k)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package file
+
+import "time"
+
+type config struct {
+	expiry      time.Duration
+	openTimeout time.Duration
+}
+
+// An Option customizes the file backend.
+type Option func(cfg *config)
+
+func getConfig(options ...Option) *config {
+	cfg := &config{
+		expiry:      time.Hour,
+		openTimeout: 10 * time.Second,
+	}
+	for _, option := range options {
+		option(cfg)
+	}
+	return cfg
+}
+
+// WithExpiry sets the expiry for changes.
+func WithExpiry(expiry time.Duration) Option {
+	return func(cfg *config) {
+		cfg.expiry = expiry
+	}
+}
+
+// WithOpenTimeout sets the amount of time to wait for the lock on the
+// database file to be released by another process.
+func WithOpenTimeout(timeout time.Duration) Option {
+	return func(cfg *config) {
+		cfg.openTimeout = timeout
+	}
+}
@@ -177,6 +177,12 @@ func (backend *Backend) Lease(_ context.Context, leaseName, leaseID string, ttl
 	l, ok := backend.leases[leaseName]
 	// if there is no lease, or its expired, acquire a new one.
 	if !ok || l.expiry.Before(time.Now()) {
+		// remove any other expired leases, as leases used once are never released
+		for name, l := range backend.leases {
+			if l.expiry.Before(time.Now()) {
+				delete(backend.leases, name)
+			}
+		}
 		backend.leases[leaseName] = &lease{
 			id:     leaseID,
 			expiry: time.Now().Add(ttl),
@@ -0,0 +1,445 @@
+// Package redis contains a Redis implementation of the databroker backend.
+//
+// Only standalone Redis is supported. The Lua scripts access keys derived from
+// the key prefix without declaring them, which Redis Cluster doesn't allow.
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/signal"
+	"github.com/pomerium/pomerium/pkg/contextutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/health"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+// maxPatchAttempts is the maximum number of times a patch will be retried
+// when the record is modified concurrently.
+const maxPatchAttempts = 10
+
+// errClusterNotSupported is returned when the url or server is a Redis
+// Cluster.
+var errClusterNotSupported = errors.New("storage/redis: redis cluster is not supported")
+
+// Backend is a storage Backend implemented with Redis.
+type Backend struct {
+	cfg            *config
+	rawURL         string
+	onRecordChange *signal.Signal
+
+	closeCtx context.Context
+	close    context.CancelFunc
+
+	mu            sync.RWMutex
+	client        redis.UniversalClient
+	serverVersion uint64
+}
+
+// New creates a new Backend.
+func New(ctx context.Context, rawURL string, options ...Option) *Backend {
+	backend := &Backend{
+		cfg:            getConfig(options...),
+		rawURL:         rawURL,
+		onRecordChange: signal.New(),
+	}
+	backend.closeCtx, backend.close = context.WithCancel(ctx)
+
+	go backend.doPeriodically(func(ctx context.Context) error {
+		_, client, err := backend.init(ctx)
+		if err != nil {
+			return err
+		}
+
+		return deleteChangesBefore(ctx, client, backend.cfg.keyPrefix, time.Now().Add(-backend.cfg.expiry))
+	}, time.Minute)
+
+	go backend.doPeriodically(func(ctx context.Context) error {
+		return backend.listenForNotifications(ctx)
+	}, time.Millisecond*100)
+
+	go backend.doPeriodically(func(ctx context.Context) error {
+		err := backend.ping(ctx)
+		if err != nil {
+			health.ReportError(health.StorageBackend, err, health.StrAttr("backend", "redis"))
+		} else {
+			health.ReportOK(health.StorageBackend, health.StrAttr("backend", "redis"))
+		}
+		return nil
+	}, time.Minute)
+
+	return backend
+}
+
+// Close closes the underlying redis connection.
+func (backend *Backend) Close() error {
+	backend.mu.Lock()
+	defer backend.mu.Unlock()
+
+	backend.close()
+
+	var err error
+	if backend.client != nil {
+		err = backend.client.Close()
+		backend.client = nil
+	}
+	return err
+}
+
+// Get gets a record from redis.
+func (backend *Backend) Get(
+	ctx context.Context,
+	recordType, recordID string,
+) (*databroker.Record, error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel()
+
+	_, client, err := backend.init(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return getRecord(ctx, client, backend.cfg.keyPrefix, recordType, recordID)
+}
+
+// GetOptions returns the options for the given record type.
+func (backend *Backend) GetOptions(
+	ctx context.Context,
+	recordType string,
+) (*databroker.Options, error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel()
+
+	_, client, err := backend.init(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return getOptions(ctx, client, backend.cfg.keyPrefix, recordType)
+}
+
+// Lease attempts to acquire a lease for the given name.
+func (backend *Backend) Lease(
+	ctx context.Context,
+	leaseName, leaseID string,
+	ttl time.Duration,
+) (acquired bool, err error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel()
+
+	_, client, err := backend.init(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return maybeAcquireLease(ctx, client, backend.cfg.keyPrefix, leaseName, leaseID, ttl)
+}
+
+// ListTypes lists the record types.
+func (backend *Backend) ListTypes(ctx context.Context) ([]string, error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel()
+
+	_, client, err := backend.init(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return listTypes(ctx, client, backend.cfg.keyPrefix)
+}
+
+// Put puts records into redis.
+func (backend *Backend) Put(
+	ctx context.Context,
+	records []*databroker.Record,
+) (serverVersion uint64, err error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel()
+
+	serverVersion, client, err := backend.init(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := timestamppb.Now()
+
+	recordTypes := map[string]struct{}{}
+	updated := make([]*databroker.Record, len(records))
+	for i, record := range records {
+		recordTypes[record.GetType()] = struct{}{}
+
+		record = dup(record)
+		record.ModifiedAt = now
+		updated[i] = record
+	}
+
+	// add all the records
+	err = putRecords(ctx, client, backend.cfg.keyPrefix, updated, nil)
+	if err != nil {
+		return serverVersion, fmt.Errorf("storage/redis: error saving records: %w", err)
+	}
+	copy(records, updated)
+
+	// enforce options for each record type
+	for recordType := range recordTypes {
+		options, err := getOptions(ctx, client, backend.cfg.keyPrefix, recordType)
+		if err != nil {
+			return serverVersion, fmt.Errorf("storage/redis: error getting options: %w", err)
+		}
+		err = enforceOptions(ctx, client, backend.cfg.keyPrefix, recordType, options)
+		if err != nil {
+			return serverVersion, fmt.Errorf("storage/redis: error enforcing options: %w", err)
+		}
+	}
+
+	return serverVersion, nil
+}
+
+// Patch updates specific fields of existing records in redis.
+func (backend *Backend) Patch(
+	ctx context.Context,
+	records []*databroker.Record,
+	fields *fieldmaskpb.FieldMask,
+) (uint64, []*databroker.Record, error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel()
+
+	serverVersion, client, err := backend.init(ctx)
+	if err != nil {
+		return serverVersion, nil, err
+	}
+
+	patchedRecords := make([]*databroker.Record, 0, len(records))
+
+	now := timestamppb.Now()
+
+	for _, record := range records {
+		record = dup(record)
+		record.ModifiedAt = now
+		err := backend.patchRecord(ctx, client, record, fields)
+		if storage.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			err = fmt.Errorf("storage/redis: error patching record %q of type %q: %w",
+				record.GetId(), record.GetType(), err)
+			return serverVersion, patchedRecords, err
+		}
+		patchedRecords = append(patchedRecords, record)
+	}
+
+	return serverVersion, patchedRecords, nil
+}
+
+// SetOptions sets the options for the given record type.
+func (backend *Backend) SetOptions(
+	ctx context.Context,
+	recordType string,
+	options *databroker.Options,
+) error {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel()
+
+	_, client, err := backend.init(ctx)
+	if err != nil {
+		return err
+	}
+
+	return setOptions(ctx, client, backend.cfg.keyPrefix, recordType, options)
+}
+
+// Sync syncs the records.
+func (backend *Backend) Sync(
+	ctx context.Context,
+	recordType string,
+	serverVersion, recordVersion uint64,
+) (storage.RecordStream, error) {
+	// the original ctx will be used for the stream, this ctx used for pre-stream calls
+	callCtx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel()
+
+	currentServerVersion, _, err := backend.init(callCtx)
+	if err != nil {
+		return nil, err
+	}
+	if currentServerVersion != serverVersion {
+		return nil, storage.ErrInvalidServerVersion
+	}
+
+	return newChangedRecordStream(ctx, backend, recordType, recordVersion), nil
+}
+
+// SyncLatest syncs the latest version of each record.
+func (backend *Backend) SyncLatest(
+	ctx context.Context,
+	recordType string,
+	expr storage.FilterExpression,
+) (serverVersion, recordVersion uint64, stream storage.RecordStream, err error) {
+	// the original ctx will be used for the stream, this ctx used for pre-stream calls
+	callCtx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel()
+
+	serverVersion, client, err := backend.init(callCtx)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	recordVersion, records, err := listRecords(callCtx, client, backend.cfg.keyPrefix, recordType, expr)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	stream = storage.RecordListToStream(ctx, records)
+	return serverVersion, recordVersion, stream, nil
+}
+
+func (backend *Backend) init(ctx context.Context) (serverVersion uint64, client redis.UniversalClient, err error) {
+	backend.mu.RLock()
+	serverVersion = backend.serverVersion
+	client = backend.client
+	backend.mu.RUnlock()
+
+	if client != nil {
+		return serverVersion, client, nil
+	}
+
+	backend.mu.Lock()
+	defer backend.mu.Unlock()
+
+	// double-checked locking, might have already initialized, so just return
+	serverVersion = backend.serverVersion
+	client = backend.client
+	if client != nil {
+		return serverVersion, client, nil
+	}
+
+	opts, err := parseURL(backend.rawURL)
+	if err != nil {
+		return serverVersion, nil, err
+	}
+	client = redis.NewClient(opts)
+
+	// CLUSTER INFO fails unless cluster support is enabled
+	if _, err := client.ClusterInfo(ctx).Result(); err == nil {
+		_ = client.Close()
+		return serverVersion, nil, errClusterNotSupported
+	}
+
+	serverVersion, err = getOrCreateServerVersion(ctx, client, backend.cfg.keyPrefix)
+	if err != nil {
+		_ = client.Close()
+		return serverVersion, nil, err
+	}
+
+	backend.serverVersion = serverVersion
+	backend.client = client
+	return serverVersion, client, nil
+}
+
+func parseURL(rawURL string) (*redis.Options, error) {
+	if scheme, _, ok := strings.Cut(rawURL, "://"); ok && strings.HasSuffix(scheme, "+cluster") {
+		return nil, errClusterNotSupported
+	}
+	opts, err := redis.ParseURL(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("storage/redis: invalid url: %w", err)
+	}
+	return opts, nil
+}
+
+func (backend *Backend) doPeriodically(f func(ctx context.Context) error, dur time.Duration) {
+	ctx := backend.closeCtx
+
+	ticker := time.NewTicker(dur)
+	defer ticker.Stop()
+
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxElapsedTime = 0
+
+	for {
+		err := f(ctx)
+		if err == nil {
+			bo.Reset()
+			select {
+			case <-backend.closeCtx.Done():
+				return
+			case <-ticker.C:
+			}
+		} else {
+			if !errors.Is(err, context.Canceled) {
+				log.Ctx(ctx).Error().Err(err).Msg("storage/redis")
+			}
+			select {
+			case <-backend.closeCtx.Done():
+				return
+			case <-time.After(bo.NextBackOff()):
+			}
+		}
+	}
+}
+
+func (backend *Backend) listenForNotifications(ctx context.Context) error {
+	_, client, err := backend.init(ctx)
+	if err != nil {
+		return fmt.Errorf("error initializing client for notifications: %w", err)
+	}
+
+	pubsub := client.Subscribe(ctx, backend.cfg.keyPrefix+notifyChannel)
+	defer pubsub.Close()
+
+	// for each notification broadcast the signal
+	for {
+		_, err := pubsub.ReceiveMessage(ctx)
+		if err != nil {
+			return fmt.Errorf("error receiving notification: %w", err)
+		}
+
+		backend.onRecordChange.Broadcast(ctx)
+	}
+}
+
+func (backend *Backend) patchRecord(
+	ctx context.Context,
+	client redis.UniversalClient,
+	record *databroker.Record,
+	fields *fieldmaskpb.FieldMask,
+) error {
+	for i := 0; i < maxPatchAttempts; i++ {
+		existing, err := getRecord(ctx, client, backend.cfg.keyPrefix, record.GetType(), record.GetId())
+		if err != nil {
+			return err
+		}
+
+		if err := storage.PatchRecord(existing, record, fields); err != nil {
+			return err
+		}
+
+		// only store the record if it hasn't been modified since we read it
+		err = putRecords(ctx, client, backend.cfg.keyPrefix,
+			[]*databroker.Record{record}, []uint64{existing.GetVersion()})
+		if errors.Is(err, errVersionConflict) {
+			continue
+		}
+		return err
+	}
+	return errVersionConflict
+}
+
+func (backend *Backend) ping(ctx context.Context) error {
+	_, client, err := backend.init(ctx)
+	if err != nil {
+		return err
+	}
+
+	return client.Ping(ctx).Err()
+}
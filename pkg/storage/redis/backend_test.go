@@ -0,0 +1,260 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+	"github.com/pomerium/pomerium/pkg/storage"
+	"github.com/pomerium/pomerium/pkg/storage/storagetest"
+)
+
+func TestBackend(t *testing.T) {
+	t.Parallel()
+
+	ctx, clearTimeout := context.WithTimeout(context.Background(), time.Minute)
+	defer clearTimeout()
+
+	srv := miniredis.RunT(t)
+
+	backend := New(ctx, "redis://"+srv.Addr())
+	defer backend.Close()
+
+	t.Run("put", func(t *testing.T) {
+		serverVersion, err := backend.Put(ctx, []*databroker.Record{
+			{Type: "test-1", Id: "r1", Data: protoutil.NewAny(protoutil.NewStructMap(map[string]*structpb.Value{
+				"k1": protoutil.NewStructString("v1"),
+			}))},
+			{Type: "test-1", Id: "r2", Data: protoutil.NewAny(protoutil.NewStructMap(map[string]*structpb.Value{
+				"k2": protoutil.NewStructString("v2"),
+			}))},
+		})
+		assert.NotEqual(t, 0, serverVersion)
+		assert.NoError(t, err)
+
+		record, err := backend.Get(ctx, "test-1", "r1")
+		require.NoError(t, err)
+		assert.Equal(t, "r1", record.GetId())
+		assert.NotZero(t, record.GetVersion())
+		assert.NotNil(t, record.GetModifiedAt())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		serverVersion, err := backend.Put(ctx, []*databroker.Record{{
+			Type:      "test-1",
+			Id:        "r1",
+			DeletedAt: timestamppb.Now(),
+		}})
+		assert.NotEqual(t, 0, serverVersion)
+		assert.NoError(t, err)
+
+		_, err = backend.Get(ctx, "test-1", "r1")
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+
+		stream, err := backend.Sync(ctx, "test-1", serverVersion, 0)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = stream.Close() })
+		records, err := storage.RecordStreamToList(stream)
+		require.NoError(t, err)
+		if assert.Len(t, records, 3) {
+			assert.NotNil(t, records[2].GetDeletedAt())
+		}
+	})
+
+	t.Run("invalid server version", func(t *testing.T) {
+		_, err := backend.Sync(ctx, "test-1", 0, 0)
+		assert.ErrorIs(t, err, storage.ErrInvalidServerVersion)
+	})
+
+	t.Run("capacity", func(t *testing.T) {
+		err := backend.SetOptions(ctx, "capacity-test", &databroker.Options{
+			Capacity: proto.Uint64(3),
+		})
+		require.NoError(t, err)
+
+		options, err := backend.GetOptions(ctx, "capacity-test")
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), options.GetCapacity())
+
+		for i := 0; i < 10; i++ {
+			_, err = backend.Put(ctx, []*databroker.Record{{
+				Type: "capacity-test",
+				Id:   fmt.Sprint(i),
+				Data: protoutil.NewAny(protoutil.NewStructMap(map[string]*structpb.Value{})),
+			}})
+			require.NoError(t, err)
+		}
+
+		_, _, stream, err := backend.SyncLatest(ctx, "capacity-test", nil)
+		require.NoError(t, err)
+		defer stream.Close()
+
+		records, err := storage.RecordStreamToList(stream)
+		require.NoError(t, err)
+		assert.Len(t, records, 3)
+
+		var ids []string
+		for _, r := range records {
+			ids = append(ids, r.GetId())
+		}
+		assert.Equal(t, []string{"7", "8", "9"}, ids, "should contain recent records")
+	})
+
+	t.Run("lease", func(t *testing.T) {
+		acquired, err := backend.Lease(ctx, "lease-test", "client-1", time.Second)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+
+		acquired, err = backend.Lease(ctx, "lease-test", "client-2", time.Second)
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+
+		srv.FastForward(2 * time.Second)
+
+		acquired, err = backend.Lease(ctx, "lease-test", "client-2", time.Second)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+
+		acquired, err = backend.Lease(ctx, "lease-test", "client-2", 0)
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+
+		acquired, err = backend.Lease(ctx, "lease-test", "client-1", time.Second)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("latest", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			_, err := backend.Put(ctx, []*databroker.Record{{
+				Type: "latest-test",
+				Id:   fmt.Sprint(i),
+				Data: protoutil.NewAny(protoutil.NewStructMap(map[string]*structpb.Value{})),
+			}})
+			require.NoError(t, err)
+		}
+
+		_, _, stream, err := backend.SyncLatest(ctx, "latest-test", nil)
+		require.NoError(t, err)
+		defer stream.Close()
+
+		count := map[string]int{}
+
+		for stream.Next(true) {
+			count[stream.Record().GetId()]++
+		}
+		assert.NoError(t, err)
+
+		for i := 0; i < 100; i++ {
+			assert.Equal(t, 1, count[fmt.Sprint(i)])
+		}
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		_, _, stream, err := backend.SyncLatest(ctx, "latest-test", storage.OrFilterExpression{
+			storage.EqualsFilterExpression{Fields: []string{"id"}, Value: "1"},
+			storage.EqualsFilterExpression{Fields: []string{"id"}, Value: "2"},
+		})
+		require.NoError(t, err)
+		defer stream.Close()
+
+		records, err := storage.RecordStreamToList(stream)
+		require.NoError(t, err)
+		assert.Len(t, records, 2)
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		serverVersion, recordVersion, stream, err := backend.SyncLatest(ctx, "sync-test", nil)
+		require.NoError(t, err)
+		assert.NoError(t, stream.Close())
+
+		stream, err = backend.Sync(ctx, "", serverVersion, recordVersion)
+		require.NoError(t, err)
+		defer stream.Close()
+
+		go func() {
+			for i := 0; i < 10; i++ {
+				_, err := backend.Put(ctx, []*databroker.Record{{
+					Type: "sync-test",
+					Id:   fmt.Sprint(i),
+					Data: protoutil.NewAny(protoutil.NewStructMap(map[string]*structpb.Value{})),
+				}})
+				assert.NoError(t, err)
+				time.Sleep(50 * time.Millisecond)
+			}
+		}()
+
+		for i := 0; i < 10; i++ {
+			if assert.True(t, stream.Next(true)) {
+				assert.Equal(t, fmt.Sprint(i), stream.Record().GetId())
+				assert.Equal(t, "sync-test", stream.Record().GetType())
+			} else {
+				break
+			}
+		}
+		assert.False(t, stream.Next(false))
+		assert.NoError(t, stream.Err())
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		serverVersion, recordVersion, stream, err := backend.SyncLatest(ctx, "", nil)
+		require.NoError(t, err)
+		assert.NoError(t, stream.Close())
+
+		_, client, err := backend.init(ctx)
+		require.NoError(t, err)
+		require.NoError(t, deleteChangesBefore(ctx, client, backend.cfg.keyPrefix, time.Now().Add(time.Minute)))
+
+		stream, err = backend.Sync(ctx, "", serverVersion, 0)
+		require.NoError(t, err)
+		defer stream.Close()
+
+		records, err := storage.RecordStreamToList(stream)
+		assert.NoError(t, err)
+		assert.Empty(t, records, "changes should be removed")
+
+		_, latestRecordVersion, stream, err := backend.SyncLatest(ctx, "", nil)
+		require.NoError(t, err)
+		defer stream.Close()
+		assert.Equal(t, recordVersion, latestRecordVersion)
+		records, err = storage.RecordStreamToList(stream)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, records, "records should be kept")
+	})
+
+	t.Run("list types", func(t *testing.T) {
+		types, err := backend.ListTypes(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"capacity-test", "latest-test", "sync-test", "test-1"}, types)
+	})
+
+	t.Run("patch", func(t *testing.T) {
+		storagetest.TestBackendPatch(t, ctx, backend)
+	})
+}
+
+func TestParseURL(t *testing.T) {
+	t.Parallel()
+
+	opts, err := parseURL("redis://localhost:6379/1")
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:6379", opts.Addr)
+	assert.Equal(t, 1, opts.DB)
+
+	_, err = parseURL("redis+cluster://localhost:6379")
+	assert.ErrorIs(t, err, errClusterNotSupported)
+	_, err = parseURL("rediss+cluster://localhost:6379")
+	assert.ErrorIs(t, err, errClusterNotSupported)
+	_, err = parseURL("http://localhost:6379")
+	assert.Error(t, err)
+}
@@ -0,0 +1,42 @@
+package redis
+
+import (
+	"time"
+)
+
+const (
+	defaultExpiry    = time.Hour * 24
+	defaultKeyPrefix = "pomerium:databroker:"
+)
+
+type config struct {
+	expiry    time.Duration
+	keyPrefix string
+}
+
+// Option customizes a Backend.
+type Option func(*config)
+
+// WithExpiry sets the expiry for changes.
+func WithExpiry(expiry time.Duration) Option {
+	return func(cfg *config) {
+		cfg.expiry = expiry
+	}
+}
+
+// WithKeyPrefix sets the prefix used for all the redis keys.
+func WithKeyPrefix(keyPrefix string) Option {
+	return func(cfg *config) {
+		cfg.keyPrefix = keyPrefix
+	}
+}
+
+func getConfig(options ...Option) *config {
+	cfg := new(config)
+	WithExpiry(defaultExpiry)(cfg)
+	WithKeyPrefix(defaultKeyPrefix)(cfg)
+	for _, o := range options {
+		o(cfg)
+	}
+	return cfg
+}
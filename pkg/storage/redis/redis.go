@@ -0,0 +1,299 @@
+package redis
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+const (
+	changesKey       = "changes"
+	changeTimesKey   = "change_times"
+	lastVersionKey   = "last_version"
+	notifyChannel    = "notify"
+	optionsKey       = "options"
+	recordsKeyPrefix = "records:"
+	serverVersionKey = "server_version"
+	typesKey         = "types"
+)
+
+// errVersionConflict indicates that a record was modified concurrently.
+var errVersionConflict = errors.New("redis: version conflict")
+
+func decodeRecord(value string) (*databroker.Record, error) {
+	rawVersion, data, ok := strings.Cut(value, ":")
+	if !ok {
+		return nil, fmt.Errorf("redis: invalid record encoding")
+	}
+
+	version, err := strconv.ParseUint(rawVersion, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("redis: invalid record version: %w", err)
+	}
+
+	record := new(databroker.Record)
+	err = proto.Unmarshal([]byte(data), record)
+	if err != nil {
+		return nil, fmt.Errorf("redis: invalid record data: %w", err)
+	}
+	record.Version = version
+	return record, nil
+}
+
+func deleteChangesBefore(ctx context.Context, c redis.UniversalClient, prefix string, cutoff time.Time) error {
+	return deleteChangesBeforeScript.Run(ctx, c, nil, prefix, cutoff.UnixMilli()).Err()
+}
+
+func dup(record *databroker.Record) *databroker.Record {
+	return proto.Clone(record).(*databroker.Record)
+}
+
+func encodeRecord(record *databroker.Record) ([]byte, error) {
+	record = dup(record)
+	// the version is assigned by redis and prepended to the encoded record
+	record.Version = 0
+	return proto.Marshal(record)
+}
+
+func enforceOptions(ctx context.Context, c redis.UniversalClient, prefix, recordType string, options *databroker.Options) error {
+	if options == nil || options.Capacity == nil {
+		return nil
+	}
+
+	return enforceCapacityScript.Run(ctx, c, nil, prefix, recordType, options.GetCapacity()).Err()
+}
+
+func getLatestRecordVersion(ctx context.Context, c redis.UniversalClient, prefix string) (uint64, error) {
+	recordVersion, err := c.Get(ctx, prefix+lastVersionKey).Uint64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return recordVersion, err
+}
+
+func getNextChangedRecords(
+	ctx context.Context,
+	c redis.UniversalClient,
+	prefix, recordType string,
+	afterRecordVersion uint64,
+	limit int64,
+) (records []*databroker.Record, lastRecordVersion uint64, err error) {
+	values, err := c.ZRangeByScoreWithScores(ctx, prefix+changesKey, &redis.ZRangeBy{
+		Min:   "(" + strconv.FormatUint(afterRecordVersion, 10),
+		Max:   "+inf",
+		Count: limit,
+	}).Result()
+	if err != nil {
+		return nil, afterRecordVersion, err
+	}
+
+	lastRecordVersion = afterRecordVersion
+	for _, value := range values {
+		lastRecordVersion = uint64(value.Score)
+
+		str, ok := value.Member.(string)
+		if !ok {
+			return nil, afterRecordVersion, fmt.Errorf("redis: unexpected change type: %T", value.Member)
+		}
+		record, err := decodeRecord(str)
+		if err != nil {
+			return nil, afterRecordVersion, err
+		}
+		if recordType != "" && record.GetType() != recordType {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, lastRecordVersion, nil
+}
+
+func getOptions(ctx context.Context, c redis.UniversalClient, prefix, recordType string) (*databroker.Options, error) {
+	options := new(databroker.Options)
+	data, err := c.HGet(ctx, prefix+optionsKey, recordType).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return options, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = proto.Unmarshal(data, options)
+	if err != nil {
+		return nil, fmt.Errorf("redis: invalid options data: %w", err)
+	}
+	return options, nil
+}
+
+func getOrCreateServerVersion(ctx context.Context, c redis.UniversalClient, prefix string) (uint64, error) {
+	err := c.SetNX(ctx, prefix+serverVersionKey, cryptutil.NewRandomUInt64(), 0).Err()
+	if err != nil {
+		return 0, err
+	}
+
+	return c.Get(ctx, prefix+serverVersionKey).Uint64()
+}
+
+func getRecord(ctx context.Context, c redis.UniversalClient, prefix, recordType, recordID string) (*databroker.Record, error) {
+	value, err := c.HGet(ctx, prefix+recordsKeyPrefix+recordType, recordID).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, storage.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return decodeRecord(value)
+}
+
+func listRecords(
+	ctx context.Context,
+	c redis.UniversalClient,
+	prefix, recordType string,
+	expr storage.FilterExpression,
+) (recordVersion uint64, records []*databroker.Record, err error) {
+	filter, err := storage.RecordStreamFilterFromFilterExpression(expr)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	recordTypes := []string{recordType}
+	if recordType == "" {
+		recordTypes, err = listTypes(ctx, c, prefix)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	// read the latest version and the records in a transaction so that they
+	// are consistent with each other
+	var lastVersionCmd *redis.StringCmd
+	recordsCmds := make([]*redis.MapStringStringCmd, len(recordTypes))
+	_, err = c.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		lastVersionCmd = p.Get(ctx, prefix+lastVersionKey)
+		for i, recordType := range recordTypes {
+			recordsCmds[i] = p.HGetAll(ctx, prefix+recordsKeyPrefix+recordType)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return 0, nil, err
+	}
+
+	recordVersion, err = lastVersionCmd.Uint64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return 0, nil, err
+	}
+
+	for _, cmd := range recordsCmds {
+		for _, value := range cmd.Val() {
+			record, err := decodeRecord(value)
+			if err != nil {
+				return 0, nil, err
+			}
+			if filter(record) {
+				records = append(records, record)
+			}
+		}
+	}
+	slices.SortFunc(records, func(a, b *databroker.Record) int {
+		return cmp.Compare(a.GetVersion(), b.GetVersion())
+	})
+
+	return recordVersion, records, nil
+}
+
+func listTypes(ctx context.Context, c redis.UniversalClient, prefix string) ([]string, error) {
+	recordTypes, err := c.SMembers(ctx, prefix+typesKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(recordTypes)
+	return recordTypes, nil
+}
+
+func maybeAcquireLease(
+	ctx context.Context,
+	c redis.UniversalClient,
+	prefix, leaseName, leaseID string,
+	ttl time.Duration,
+) (acquired bool, err error) {
+	return leaseScript.Run(ctx, c, nil, prefix, leaseName, leaseID, ttl.Milliseconds()).Bool()
+}
+
+// putRecords stores the records and their changes. If expectedVersions is
+// non-nil, each record is only stored if its current version matches the
+// expected version, otherwise errVersionConflict is returned.
+func putRecords(
+	ctx context.Context,
+	c redis.UniversalClient,
+	prefix string,
+	records []*databroker.Record,
+	expectedVersions []uint64,
+) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	args := []any{prefix, time.Now().UnixMilli()}
+	for i, record := range records {
+		data, err := encodeRecord(record)
+		if err != nil {
+			return fmt.Errorf("redis: failed to encode record: %w", err)
+		}
+
+		deleted := 0
+		if record.GetDeletedAt() != nil {
+			deleted = 1
+		}
+
+		var expectedVersion uint64
+		if expectedVersions != nil {
+			expectedVersion = expectedVersions[i]
+		}
+
+		args = append(args, record.GetType(), record.GetId(), deleted, expectedVersion, data)
+	}
+
+	result, err := putScript.Run(ctx, c, nil, args...).Result()
+	if err != nil {
+		return err
+	}
+
+	versions, ok := result.([]any)
+	if !ok {
+		return errVersionConflict
+	} else if len(versions) != len(records) {
+		return fmt.Errorf("redis: unexpected number of versions: %d", len(versions))
+	}
+
+	for i, version := range versions {
+		v, ok := version.(int64)
+		if !ok {
+			return fmt.Errorf("redis: unexpected version type: %T", version)
+		}
+		records[i].Version = uint64(v)
+	}
+	return nil
+}
+
+func setOptions(ctx context.Context, c redis.UniversalClient, prefix, recordType string, options *databroker.Options) error {
+	if options == nil || options.Capacity == nil {
+		return c.HDel(ctx, prefix+optionsKey, recordType).Err()
+	}
+
+	data, err := proto.Marshal(options)
+	if err != nil {
+		return err
+	}
+	return c.HSet(ctx, prefix+optionsKey, recordType, data).Err()
+}
@@ -0,0 +1,126 @@
+package redis
+
+import (
+	"github.com/redis/go-redis/v9"
+)
+
+// putScript stores records and their changes atomically.
+//
+// ARGV[1] is the key prefix, ARGV[2] is the current time in milliseconds,
+// followed by groups of 5 arguments for each record: type, id, deleted ("1" or
+// "0"), the expected current version of the record (0 for any version) and the
+// encoded record.
+//
+// If any of the expected versions do not match, nothing is stored and -1 is
+// returned. Otherwise the new version of each record is returned.
+var putScript = redis.NewScript(`
+local prefix = ARGV[1]
+local now = ARGV[2]
+
+for i = 3, #ARGV, 5 do
+  local expected = tonumber(ARGV[i+3])
+  if expected > 0 then
+    local current = redis.call('ZSCORE', prefix .. 'order:' .. ARGV[i], ARGV[i+1])
+    if (not current) or (tonumber(current) ~= expected) then
+      return -1
+    end
+  end
+end
+
+local versions = {}
+for i = 3, #ARGV, 5 do
+  local record_type, record_id, deleted, data = ARGV[i], ARGV[i+1], ARGV[i+2], ARGV[i+4]
+  local version = redis.call('INCR', prefix .. 'last_version')
+  local value = version .. ':' .. data
+
+  redis.call('SADD', prefix .. 'types', record_type)
+  if deleted == '1' then
+    redis.call('HDEL', prefix .. 'records:' .. record_type, record_id)
+    redis.call('ZREM', prefix .. 'order:' .. record_type, record_id)
+  else
+    redis.call('HSET', prefix .. 'records:' .. record_type, record_id, value)
+    redis.call('ZADD', prefix .. 'order:' .. record_type, version, record_id)
+  end
+  redis.call('ZADD', prefix .. 'changes', version, value)
+  redis.call('ZADD', prefix .. 'change_times', now, version)
+  table.insert(versions, version)
+end
+
+redis.call('PUBLISH', prefix .. 'notify', 'record')
+return versions
+`)
+
+// deleteChangesBeforeScript removes any changes made before the given time.
+//
+// ARGV[1] is the key prefix, ARGV[2] is the cutoff time in milliseconds.
+var deleteChangesBeforeScript = redis.NewScript(`
+local prefix = ARGV[1]
+local versions = redis.call('ZRANGEBYSCORE', prefix .. 'change_times', '-inf', '(' .. ARGV[2])
+if #versions == 0 then
+  return 0
+end
+
+local max_version = 0
+for _, version in ipairs(versions) do
+  max_version = math.max(max_version, tonumber(version))
+end
+
+redis.call('ZREMRANGEBYSCORE', prefix .. 'changes', '-inf', max_version)
+redis.call('ZREMRANGEBYSCORE', prefix .. 'change_times', '-inf', '(' .. ARGV[2])
+return #versions
+`)
+
+// enforceCapacityScript removes the oldest records of a type which exceed the
+// given capacity.
+//
+// ARGV[1] is the key prefix, ARGV[2] is the record type and ARGV[3] is the
+// capacity.
+var enforceCapacityScript = redis.NewScript(`
+local prefix, record_type, capacity = ARGV[1], ARGV[2], tonumber(ARGV[3])
+local order_key = prefix .. 'order:' .. record_type
+
+local count = redis.call('ZCARD', order_key)
+if count <= capacity then
+  return 0
+end
+
+local ids = redis.call('ZRANGE', order_key, 0, count - capacity - 1)
+redis.call('HDEL', prefix .. 'records:' .. record_type, unpack(ids))
+redis.call('ZREM', order_key, unpack(ids))
+return #ids
+`)
+
+// leaseScript acquires, renews or releases a lease.
+//
+// ARGV[1] is the key prefix, ARGV[2] is the lease name, ARGV[3] is the lease id
+// and ARGV[4] is the ttl in milliseconds. 1 is returned if the lease is held by
+// the lease id.
+var leaseScript = redis.NewScript(`
+local key = ARGV[1] .. 'lease:' .. ARGV[2]
+local current = redis.call('GET', key)
+local ttl = tonumber(ARGV[4])
+
+-- if there is no lease, or its expired, acquire a new one
+if not current then
+  if ttl <= 0 then
+    return 0
+  end
+  redis.call('SET', key, ARGV[3], 'PX', ttl)
+  return 1
+end
+
+-- if the lease doesn't match, we can't acquire it
+if current ~= ARGV[3] then
+  return 0
+end
+
+-- release the lease
+if ttl <= 0 then
+  redis.call('DEL', key)
+  return 0
+end
+
+-- update the expiry (renew the lease)
+redis.call('PEXPIRE', key, ttl)
+return 1
+`)
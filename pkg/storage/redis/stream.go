@@ -0,0 +1,113 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/pomerium/pomerium/pkg/contextutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+const (
+	changeBatchSize   = 1024
+	watchPollInterval = 30 * time.Second
+)
+
+type changedRecordStream struct {
+	backend       *Backend
+	recordType    string
+	recordVersion uint64
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	pending []*databroker.Record
+	record  *databroker.Record
+	err     error
+	ticker  *time.Ticker
+	changed chan context.Context
+}
+
+func newChangedRecordStream(
+	ctx context.Context,
+	backend *Backend,
+	recordType string,
+	recordVersion uint64,
+) storage.RecordStream {
+	stream := &changedRecordStream{
+		backend:       backend,
+		recordType:    recordType,
+		recordVersion: recordVersion,
+		ticker:        time.NewTicker(watchPollInterval),
+		changed:       backend.onRecordChange.Bind(),
+	}
+	stream.ctx, stream.cancel = contextutil.Merge(ctx, backend.closeCtx)
+	return stream
+}
+
+func (stream *changedRecordStream) Close() error {
+	stream.cancel()
+	stream.ticker.Stop()
+	stream.backend.onRecordChange.Unbind(stream.changed)
+	return nil
+}
+
+func (stream *changedRecordStream) Next(block bool) bool {
+	for {
+		if stream.err != nil {
+			return false
+		}
+
+		if len(stream.pending) > 0 {
+			stream.record, stream.pending = stream.pending[0], stream.pending[1:]
+			return true
+		}
+
+		var client redis.UniversalClient
+		_, client, stream.err = stream.backend.init(stream.ctx)
+		if stream.err != nil {
+			return false
+		}
+
+		var lastRecordVersion uint64
+		stream.pending, lastRecordVersion, stream.err = getNextChangedRecords(
+			stream.ctx,
+			client,
+			stream.backend.cfg.keyPrefix,
+			stream.recordType,
+			stream.recordVersion,
+			changeBatchSize,
+		)
+		if stream.err != nil {
+			return false
+		}
+
+		// if we skipped over changes for other types, try again immediately
+		if lastRecordVersion != stream.recordVersion {
+			stream.recordVersion = lastRecordVersion
+			continue
+		}
+
+		if !block {
+			return false
+		}
+
+		select {
+		case <-stream.ctx.Done():
+			stream.err = stream.ctx.Err()
+			return false
+		case <-stream.ticker.C:
+		case <-stream.changed:
+		}
+	}
+}
+
+func (stream *changedRecordStream) Record() *databroker.Record {
+	return stream.record
+}
+
+func (stream *changedRecordStream) Err() error {
+	return stream.err
+}
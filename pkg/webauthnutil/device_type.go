@@ -16,6 +16,9 @@ import (
 // DefaultDeviceType is the default device type when none is specified.
 const DefaultDeviceType = urlutil.DefaultDeviceType
 
+// PasskeyDeviceType is the device type used to sign in with passkeys.
+const PasskeyDeviceType = "passkey"
+
 var supportedPublicKeyCredentialParameters = []*device.WebAuthnOptions_PublicKeyCredentialParameters{
 	{Type: device.WebAuthnOptions_PUBLIC_KEY, Alg: int64(cose.AlgorithmES256)},
 	{Type: device.WebAuthnOptions_PUBLIC_KEY, Alg: int64(cose.AlgorithmRS256)},
@@ -55,6 +58,30 @@ var predefinedDeviceTypes = map[string]*device.Type{
 			},
 		},
 	},
+	PasskeyDeviceType: {
+		Id:   PasskeyDeviceType,
+		Name: "Passkey",
+		Specifier: &device.Type_Webauthn{
+			Webauthn: &device.Type_WebAuthn{
+				Options: &device.WebAuthnOptions{
+					Attestation: device.WebAuthnOptions_NONE.Enum(),
+					AuthenticatorSelection: &device.WebAuthnOptions_AuthenticatorSelectionCriteria{
+						UserVerification:       device.WebAuthnOptions_USER_VERIFICATION_REQUIRED.Enum(),
+						RequireResidentKey:     proto.Bool(true),
+						ResidentKeyRequirement: device.WebAuthnOptions_RESIDENT_KEY_REQUIRED.Enum(),
+					},
+					PubKeyCredParams: supportedPublicKeyCredentialParameters,
+				},
+			},
+		},
+	},
+	"client_certificate": {
+		Id:   "client_certificate",
+		Name: "Client Certificate",
+		Specifier: &device.Type_ClientCertificate_{
+			ClientCertificate: &device.Type_ClientCertificate{},
+		},
+	},
 }
 
 // GetDeviceType gets the device type from the databroker. If the device type does not exist in the databroker
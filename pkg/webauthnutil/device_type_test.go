@@ -47,4 +47,22 @@ func TestGetDeviceType(t *testing.T) {
 		deviceType := GetDeviceType(ctx, client, "any")
 		assert.Equal(t, "Any", deviceType.GetName())
 	})
+	t.Run("passkey", func(t *testing.T) {
+		client := &mockDataBrokerServiceClient{
+			get: func(_ context.Context, _ *databroker.GetRequest, _ ...grpc.CallOption) (*databroker.GetResponse, error) {
+				return nil, status.Error(codes.NotFound, "not found")
+			},
+		}
+		deviceType := GetDeviceType(ctx, client, PasskeyDeviceType)
+		assert.True(t, deviceType.GetWebauthn().GetOptions().GetAuthenticatorSelection().GetRequireResidentKey())
+	})
+	t.Run("client certificate", func(t *testing.T) {
+		client := &mockDataBrokerServiceClient{
+			get: func(_ context.Context, _ *databroker.GetRequest, _ ...grpc.CallOption) (*databroker.GetResponse, error) {
+				return nil, status.Error(codes.NotFound, "not found")
+			},
+		}
+		deviceType := GetDeviceType(ctx, client, "client_certificate")
+		assert.NotNil(t, deviceType.GetClientCertificate())
+	})
 }
@@ -0,0 +1,148 @@
+package webauthnutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/device"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/webauthn"
+)
+
+// GetOrCreateDeviceEnrollment gets the device enrollment for the enrollment
+// token in the request, or creates a new enrollment if there is no token, and
+// marks it as enrolled with the given device credential.
+func GetOrCreateDeviceEnrollment(
+	ctx context.Context,
+	r *http.Request,
+	client databroker.DataBrokerServiceClient,
+	key []byte,
+	deviceTypeID string,
+	deviceCredentialID string,
+	u *user.User,
+) (*device.Enrollment, error) {
+	var deviceEnrollment *device.Enrollment
+
+	enrollmentTokenParam := r.FormValue(urlutil.QueryEnrollmentToken)
+	if enrollmentTokenParam == "" {
+		// create a new enrollment
+		deviceEnrollment = &device.Enrollment{
+			Id:     uuid.New().String(),
+			TypeId: deviceTypeID,
+			UserId: u.GetId(),
+		}
+	} else {
+		// use an existing enrollment
+		deviceEnrollmentID, err := ParseAndVerifyEnrollmentToken(key, enrollmentTokenParam)
+		if err != nil {
+			return nil, httputil.NewError(http.StatusBadRequest, fmt.Errorf("invalid enrollment token: %w", err))
+		}
+
+		deviceEnrollment, err = device.GetEnrollment(ctx, client, deviceEnrollmentID)
+		if err != nil {
+			return nil, err
+		}
+
+		if deviceEnrollment.GetTypeId() != deviceTypeID {
+			return nil, httputil.NewError(http.StatusForbidden, fmt.Errorf("invalid enrollment token: wrong device type"))
+		}
+
+		if deviceEnrollment.GetUserId() != u.GetId() {
+			return nil, httputil.NewError(http.StatusForbidden, fmt.Errorf("invalid enrollment token: wrong user id"))
+		}
+
+		if deviceEnrollment.GetEnrolledAt().IsValid() {
+			return nil, httputil.NewError(http.StatusForbidden, fmt.Errorf("invalid enrollment token: already used for existing credential"))
+		}
+	}
+
+	deviceEnrollment.CredentialId = deviceCredentialID
+	deviceEnrollment.EnrolledAt = timestamppb.Now()
+	deviceEnrollment.UserAgent = r.UserAgent()
+	deviceEnrollment.IpAddress = httputil.GetClientIPAddress(r)
+
+	err := device.PutEnrollment(ctx, client, deviceEnrollment)
+	if err != nil {
+		return nil, err
+	}
+	return deviceEnrollment, nil
+}
+
+// RegisterDeviceCredential verifies a WebAuthn registration ceremony for the
+// user and stores the new device credential, enrolling it with the enrollment
+// token in the request if there is one.
+func RegisterDeviceCredential(
+	ctx context.Context,
+	r *http.Request,
+	client databroker.DataBrokerServiceClient,
+	relyingParty *webauthn.RelyingParty,
+	key []byte,
+	deviceType *device.Type,
+	u *user.User,
+	credential *webauthn.PublicKeyCreationCredential,
+) (*device.Credential, error) {
+	credentialJSON, err := json.Marshal(credential)
+	if err != nil {
+		return nil, err
+	}
+
+	creationOptions, err := GetCreationOptionsForCredential(r, key, deviceType, u, credential)
+	if err != nil {
+		return nil, httputil.NewError(http.StatusBadRequest, fmt.Errorf("invalid register options: %w", err))
+	}
+	creationOptionsJSON, err := json.Marshal(creationOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	serverCredential, err := relyingParty.VerifyRegistrationCeremony(ctx, creationOptions, credential)
+	if err != nil {
+		return nil, httputil.NewError(http.StatusBadRequest, fmt.Errorf("error verifying registration: %w", err))
+	}
+
+	deviceCredentialID := GetDeviceCredentialID(serverCredential.ID)
+
+	deviceEnrollment, err := GetOrCreateDeviceEnrollment(ctx, r, client, key,
+		deviceType.GetId(), deviceCredentialID, u)
+	if err != nil {
+		return nil, err
+	}
+
+	// save the credential
+	deviceCredential := &device.Credential{
+		Id:           deviceCredentialID,
+		TypeId:       deviceType.GetId(),
+		EnrollmentId: deviceEnrollment.GetId(),
+		UserId:       u.GetId(),
+		Specifier: &device.Credential_Webauthn{
+			Webauthn: &device.Credential_WebAuthn{
+				Id:        serverCredential.ID,
+				PublicKey: serverCredential.PublicKey,
+
+				RegisterOptions:  creationOptionsJSON,
+				RegisterResponse: credentialJSON,
+			},
+		},
+	}
+	err = device.PutCredential(ctx, client, deviceCredential)
+	if err != nil {
+		return nil, err
+	}
+
+	// save the user
+	u.AddDeviceCredentialID(deviceCredential.GetId())
+	_, err = databroker.Put(ctx, client, u)
+	if err != nil {
+		return nil, err
+	}
+
+	return deviceCredential, nil
+}
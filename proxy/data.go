@@ -7,6 +7,7 @@ import (
 	"github.com/pomerium/csrf"
 	"github.com/pomerium/datasource/pkg/directory"
 	"github.com/pomerium/pomerium/internal/handlers"
+	"github.com/pomerium/pomerium/internal/handlers/clientcert"
 	"github.com/pomerium/pomerium/internal/handlers/webauthn"
 	"github.com/pomerium/pomerium/internal/urlutil"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
@@ -120,3 +121,37 @@ func (p *Proxy) getWebauthnState(r *http.Request) (*webauthn.State, error) {
 		BrandingOptions:         options.BrandingOptions,
 	}, nil
 }
+
+func (p *Proxy) getClientCertState(r *http.Request) (*clientcert.State, error) {
+	options := p.currentOptions.Load()
+	state := p.state.Load()
+
+	ss, err := state.sessionStore.LoadSessionState(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s, _, err := p.getSession(r.Context(), ss.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticateURL, err := options.GetAuthenticateURL()
+	if err != nil {
+		return nil, err
+	}
+
+	internalAuthenticateURL, err := options.GetInternalAuthenticateURL()
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientcert.State{
+		AuthenticateURL:         authenticateURL,
+		InternalAuthenticateURL: internalAuthenticateURL,
+		SharedKey:               state.sharedKey,
+		Client:                  state.dataBrokerClient,
+		Session:                 s,
+		BrandingOptions:         options.BrandingOptions,
+	}, nil
+}
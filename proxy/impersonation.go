@@ -0,0 +1,194 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/pomerium/csrf"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+	"github.com/pomerium/pomerium/pkg/storage"
+	"github.com/pomerium/pomerium/ui"
+)
+
+const impersonateSessionIDField = "impersonate_session_id"
+
+// registerImpersonationHandlers registers the handlers used to start and stop
+// impersonating a user. Starting an impersonation is authorized by the
+// impersonation policy, which is enforced by the authorize service.
+func (p *Proxy) registerImpersonationHandlers(h *mux.Router, opts *config.Options) {
+	i := h.PathPrefix("/impersonate").Subrouter()
+	i.Use(func(h http.Handler) http.Handler {
+		csrfKey := fmt.Sprintf("%s_csrf", opts.CookieName)
+		return csrf.Protect(p.state.Load().cookieSecret,
+			csrf.Secure(true),
+			csrf.Path("/"),
+			csrf.CookieName(csrfKey),
+			csrf.FieldName(csrfKey),
+			csrf.ErrorHandler(httputil.HandlerFunc(httputil.CSRFFailureHandler)),
+			csrf.SameSite(opts.GetCSRFSameSite()),
+		)(h)
+	})
+	i.Path("").Handler(httputil.HandlerFunc(p.impersonate)).Methods(http.MethodGet)
+	i.Path("").Handler(httputil.HandlerFunc(p.startImpersonation)).Methods(http.MethodPost)
+	i.Path("/stop").Handler(httputil.HandlerFunc(p.stopImpersonation)).Methods(http.MethodPost)
+}
+
+func (p *Proxy) impersonate(w http.ResponseWriter, r *http.Request) error {
+	data := p.getUserInfoData(r)
+	return ui.ServePage(w, r, "Impersonate", "Impersonate User", data.ToJSON())
+}
+
+// startImpersonation creates a session for the requested user and links it to
+// the operator's session, so requests are authorized as that user until the
+// impersonation is stopped or expires.
+func (p *Proxy) startImpersonation(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	client := p.state.Load().dataBrokerClient
+	options := p.currentOptions.Load()
+
+	s, err := p.getOperatorSession(r)
+	if err != nil {
+		return err
+	}
+	if s.GetImpersonateSessionId() != "" {
+		return httputil.NewError(http.StatusBadRequest, errors.New("already impersonating a user"))
+	}
+
+	u, err := findUser(ctx, client, strings.TrimSpace(r.FormValue("user")))
+	if err != nil {
+		return err
+	}
+	if u.GetId() == s.GetUserId() {
+		return httputil.NewError(http.StatusBadRequest, errors.New("cannot impersonate yourself"))
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(options.Impersonation.GetDuration())
+	if operatorExpiresAt := s.GetExpiresAt().AsTime(); s.GetExpiresAt() != nil && operatorExpiresAt.Before(expiresAt) {
+		expiresAt = operatorExpiresAt
+	}
+	impersonated := &session.Session{
+		Id:         uuid.NewString(),
+		UserId:     u.GetId(),
+		IdpId:      s.GetIdpId(),
+		IssuedAt:   timestamppb.New(now),
+		AccessedAt: timestamppb.New(now),
+		ExpiresAt:  timestamppb.New(expiresAt),
+		Claims:     u.GetClaims(),
+	}
+	if _, err := session.Put(ctx, client, impersonated); err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+
+	s.ImpersonateSessionId = &impersonated.Id
+	_, err = session.Patch(ctx, client, s, &fieldmaskpb.FieldMask{Paths: []string{impersonateSessionIDField}})
+	if err != nil {
+		_ = session.Delete(ctx, client, impersonated.Id)
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+
+	log.Ctx(ctx).Info().
+		Str("session-id", s.GetId()).
+		Str("user-id", s.GetUserId()).
+		Str("impersonate-session-id", impersonated.GetId()).
+		Str("impersonate-user-id", u.GetId()).
+		Str("impersonate-email", u.GetEmail()).
+		Time("expires-at", expiresAt).
+		Msg("proxy: impersonation started")
+
+	httputil.Redirect(w, r, dashboardPath+"/", http.StatusFound)
+	return nil
+}
+
+// stopImpersonation ends the impersonation of the operator's session.
+func (p *Proxy) stopImpersonation(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	client := p.state.Load().dataBrokerClient
+
+	s, err := p.getOperatorSession(r)
+	if err != nil {
+		return err
+	}
+
+	if impersonateSessionID := s.GetImpersonateSessionId(); impersonateSessionID != "" {
+		s.ImpersonateSessionId = nil
+		_, err = session.Patch(ctx, client, s, &fieldmaskpb.FieldMask{Paths: []string{impersonateSessionIDField}})
+		if err != nil {
+			return httputil.NewError(http.StatusInternalServerError, err)
+		}
+		if err := session.Delete(ctx, client, impersonateSessionID); err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("session-id", impersonateSessionID).Msg("proxy: error deleting impersonated session")
+		}
+
+		log.Ctx(ctx).Info().
+			Str("session-id", s.GetId()).
+			Str("user-id", s.GetUserId()).
+			Str("impersonate-session-id", impersonateSessionID).
+			Msg("proxy: impersonation stopped")
+	}
+
+	httputil.Redirect(w, r, dashboardPath+"/", http.StatusFound)
+	return nil
+}
+
+// getOperatorSession returns the session for the request, without following
+// any impersonation.
+func (p *Proxy) getOperatorSession(r *http.Request) (*session.Session, error) {
+	state := p.state.Load()
+
+	ss, err := state.sessionStore.LoadSessionState(r)
+	if err != nil {
+		return nil, httputil.NewError(http.StatusUnauthorized, err)
+	}
+
+	s, err := session.Get(r.Context(), state.dataBrokerClient, ss.ID)
+	if storage.IsNotFound(err) {
+		return nil, httputil.NewError(http.StatusUnauthorized, err)
+	} else if err != nil {
+		return nil, httputil.NewError(http.StatusInternalServerError, err)
+	}
+	return s, nil
+}
+
+// findUser finds a user by id or by email.
+func findUser(ctx context.Context, client databroker.DataBrokerServiceClient, idOrEmail string) (*user.User, error) {
+	if idOrEmail == "" {
+		return nil, httputil.NewError(http.StatusBadRequest, errors.New("a user id or email is required"))
+	}
+
+	u, err := user.Get(ctx, client, idOrEmail)
+	if err == nil {
+		return u, nil
+	} else if !storage.IsNotFound(err) {
+		return nil, httputil.NewError(http.StatusInternalServerError, err)
+	}
+
+	records, _, _, err := databroker.InitialSync(ctx, client, &databroker.SyncLatestRequest{
+		Type: grpcutil.GetTypeURL(new(user.User)),
+	})
+	if err != nil {
+		return nil, httputil.NewError(http.StatusInternalServerError, err)
+	}
+	for _, record := range records {
+		var u user.User
+		if record.GetData().UnmarshalTo(&u) == nil && strings.EqualFold(u.GetEmail(), idOrEmail) {
+			return &u, nil
+		}
+	}
+	return nil, httputil.NewError(http.StatusNotFound, errors.New("user not found"))
+}
@@ -0,0 +1,104 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/testutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+func TestProxy_impersonation(t *testing.T) {
+	t.Parallel()
+
+	ctx, clearTimeout := context.WithTimeout(context.Background(), time.Second*10)
+	defer clearTimeout()
+
+	cc := testutil.NewGRPCServer(t, func(srv *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(srv, databroker.New(ctx))
+	})
+	t.Cleanup(func() { cc.Close() })
+
+	client := databrokerpb.NewDataBrokerServiceClient(cc)
+
+	opts := testOptions(t)
+	opts.Impersonation.Duration = 10 * time.Minute
+	proxy, err := New(ctx, &config.Config{Options: opts})
+	require.NoError(t, err)
+	proxy.state.Load().dataBrokerClient = client
+
+	operatorExpiresAt := time.Now().Add(time.Hour)
+	require.NoError(t, databrokerpb.PutMulti(ctx, client,
+		makeRecord(&session.Session{
+			Id:        "S1",
+			UserId:    "U1",
+			IdpId:     "IDP1",
+			ExpiresAt: timestamppb.New(operatorExpiresAt),
+		}),
+		makeRecord(&user.User{Id: "U1", Email: "admin@example.com"}),
+		makeRecord(&user.User{Id: "U2", Email: "user@example.com"})))
+
+	post := func(handler httputil.HandlerFunc, form url.Values) error {
+		r := httptest.NewRequest(http.MethodPost, "/.pomerium/impersonate", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.Header.Set("Authorization", "Bearer Pomerium-"+encodeSession(t, opts, &sessions.State{
+			ID: "S1",
+		}))
+		w := httptest.NewRecorder()
+		err := handler(w, r)
+		if err == nil {
+			assert.Equal(t, http.StatusFound, w.Code)
+			assert.Equal(t, "/.pomerium/", w.Header().Get("Location"))
+		}
+		return err
+	}
+	status := func(err error) int {
+		var httpErr *httputil.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		return httpErr.Status
+	}
+
+	err = post(proxy.startImpersonation, url.Values{"user": {"admin@example.com"}})
+	assert.Equal(t, http.StatusBadRequest, status(err))
+	err = post(proxy.startImpersonation, url.Values{"user": {"missing@example.com"}})
+	assert.Equal(t, http.StatusNotFound, status(err))
+
+	require.NoError(t, post(proxy.startImpersonation, url.Values{"user": {"USER@example.com"}}))
+
+	s, err := session.Get(ctx, client, "S1")
+	require.NoError(t, err)
+	require.NotEmpty(t, s.GetImpersonateSessionId())
+	impersonated, err := session.Get(ctx, client, s.GetImpersonateSessionId())
+	require.NoError(t, err)
+	assert.Equal(t, "U2", impersonated.GetUserId())
+	assert.Equal(t, "IDP1", impersonated.GetIdpId())
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), impersonated.GetExpiresAt().AsTime(), time.Minute)
+
+	err = post(proxy.startImpersonation, url.Values{"user": {"U2"}})
+	assert.Equal(t, http.StatusBadRequest, status(err), "should not allow nested impersonation")
+
+	require.NoError(t, post(proxy.stopImpersonation, nil))
+
+	s, err = session.Get(ctx, client, "S1")
+	require.NoError(t, err)
+	assert.Empty(t, s.GetImpersonateSessionId())
+	_, err = session.Get(ctx, client, impersonated.GetId())
+	assert.True(t, storage.IsNotFound(err), "should delete the impersonated session")
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/atomicutil"
+	"github.com/pomerium/pomerium/internal/handlers/clientcert"
 	"github.com/pomerium/pomerium/internal/handlers/webauthn"
 	"github.com/pomerium/pomerium/internal/httputil"
 	"github.com/pomerium/pomerium/internal/log"
@@ -56,6 +57,7 @@ type Proxy struct {
 	currentOptions *atomicutil.Value[*config.Options]
 	currentRouter  *atomicutil.Value[*mux.Router]
 	webauthn       *webauthn.Handler
+	clientCert     *clientcert.Handler
 }
 
 // New takes a Proxy service from options and a validation function.
@@ -71,8 +73,9 @@ func New(ctx context.Context, cfg *config.Config) (*Proxy, error) {
 		currentOptions: config.NewAtomicOptions(),
 		currentRouter:  atomicutil.NewValue(httputil.NewRouter()),
 	}
-	p.OnConfigChange(ctx, cfg)
 	p.webauthn = webauthn.New(p.getWebauthnState)
+	p.clientCert = clientcert.New(p.getClientCertState)
+	p.OnConfigChange(ctx, cfg)
 
 	metrics.AddPolicyCountCallback("pomerium-proxy", func() int64 {
 		return int64(p.currentOptions.Load().NumPolicies())
@@ -25,6 +25,7 @@ type proxyState struct {
 	authenticateRefreshURL   *url.URL
 
 	sharedKey                           []byte
+	cookieSecret                        []byte
 	sessionStore                        *config.SessionStore
 	dataBrokerClient                    databroker.DataBrokerServiceClient
 	programmaticRedirectDomainWhitelist []string
@@ -52,6 +53,11 @@ func newProxyStateFromConfig(ctx context.Context, cfg *config.Config) (*proxySta
 		return nil, err
 	}
 
+	state.cookieSecret, err = cfg.Options.GetCookieSecret()
+	if err != nil {
+		return nil, err
+	}
+
 	state.sessionStore, err = config.NewSessionStore(cfg.Options)
 	if err != nil {
 		return nil, err
@@ -1,9 +1,16 @@
 import { Box, CssBaseline, ThemeProvider } from "@mui/material";
 import React, { FC, useLayoutEffect } from "react";
 
+import ClientCertificateRegistrationPage from "./components/ClientCertificateRegistrationPage";
 import ErrorPage from "./components/ErrorPage";
 import Footer from "./components/Footer";
 import Header from "./components/Header";
+import ImpersonatePage from "./components/ImpersonatePage";
+import ImpersonationBanner from "./components/ImpersonationBanner";
+import LocalSignInPage from "./components/LocalSignInPage";
+import PasskeyEnrollPage from "./components/PasskeyEnrollPage";
+import PasskeySignInPage from "./components/PasskeySignInPage";
+import SelectIdentityProviderPage from "./components/SelectIdentityProviderPage";
 import SignOutConfirmPage from "./components/SignOutConfirmPage";
 import SignedOutPage from "./components/SignedOutPage";
 import { ToolbarOffset } from "./components/ToolbarOffset";
@@ -30,12 +37,30 @@ const App: FC = () => {
     case "Error":
       body = <ErrorPage data={data} />;
       break;
+    case "Impersonate":
+      body = <ImpersonatePage data={data} />;
+      break;
+    case "LocalSignIn":
+      body = <LocalSignInPage data={data} />;
+      break;
+    case "PasskeyEnroll":
+      body = <PasskeyEnrollPage data={data} />;
+      break;
+    case "PasskeySignIn":
+      body = <PasskeySignInPage data={data} />;
+      break;
+    case "SelectIdentityProvider":
+      body = <SelectIdentityProviderPage data={data} />;
+      break;
     case "SignOutConfirm":
       body = <SignOutConfirmPage data={data} />;
       break;
     case "SignedOut":
       body = <SignedOutPage data={data} />;
       break;
+    case "ClientCertificateRegistration":
+      body = <ClientCertificateRegistrationPage data={data} />;
+      break;
     case "DeviceEnrolled":
     case "UserInfo":
       body = <UserInfoPage data={data} />;
@@ -74,6 +99,9 @@ const App: FC = () => {
               paddingTop: theme.spacing(5),
             }}
           >
+            {"isImpersonated" in data && data.isImpersonated && (
+              <ImpersonationBanner session={data.session} user={data.user} />
+            )}
             {body}
             <ToolbarOffset />
           </Box>
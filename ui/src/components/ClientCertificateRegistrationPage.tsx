@@ -0,0 +1,73 @@
+import {
+  Button,
+  Card,
+  CardActions,
+  CardContent,
+  Container,
+  Typography,
+} from "@mui/material";
+import React, { FC } from "react";
+
+import { ClientCertificateRegistrationPageData } from "../types";
+
+type ClientCertificateRegistrationPageProps = {
+  data: ClientCertificateRegistrationPageData;
+};
+const ClientCertificateRegistrationPage: FC<
+  ClientCertificateRegistrationPageProps
+> = ({ data }) => {
+  const certificate = data?.certificate;
+  return (
+    <Container maxWidth="sm">
+      <Card>
+        <form action={data?.selfUrl} method="POST">
+          <input type="hidden" name="action" value="register" />
+          <CardContent>
+            <Typography variant="h5">Client Certificate Registration</Typography>
+            {certificate ? (
+              <>
+                <Typography variant="body2" sx={{ paddingTop: 1 }}>
+                  Subject: {certificate.subject}
+                </Typography>
+                <Typography variant="body2">
+                  Issuer: {certificate.issuer}
+                </Typography>
+                <Typography variant="body2">
+                  Expires: {certificate.notAfter}
+                </Typography>
+                <Typography variant="body2" sx={{ wordBreak: "break-all" }}>
+                  Fingerprint: {certificate.fingerprint}
+                </Typography>
+                {data?.enrolled && (
+                  <Typography variant="body2" sx={{ paddingTop: 1 }}>
+                    This certificate is already registered.
+                  </Typography>
+                )}
+                {!data?.supported && (
+                  <Typography variant="body2" sx={{ paddingTop: 1 }}>
+                    This certificate cannot be registered for this device type.
+                  </Typography>
+                )}
+              </>
+            ) : (
+              <Typography variant="body2" sx={{ paddingTop: 1 }}>
+                No client certificate was presented. Configure your browser or
+                device to present a client certificate and try again.
+              </Typography>
+            )}
+          </CardContent>
+          <CardActions>
+            <Button
+              type="submit"
+              variant="contained"
+              disabled={!certificate || data?.enrolled || !data?.supported}
+            >
+              Register
+            </Button>
+          </CardActions>
+        </form>
+      </Card>
+    </Container>
+  );
+};
+export default ClientCertificateRegistrationPage;
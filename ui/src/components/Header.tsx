@@ -58,7 +58,9 @@ const Header: FC<HeaderProps> = ({ includeSidebar, data }) => {
     get(data, "profile.claims.picture") ||
     null;
   const showAvatar =
-    data?.page !== "SignOutConfirm" && data?.page !== "SignedOut";
+    data?.page !== "SelectIdentityProvider" &&
+    data?.page !== "SignOutConfirm" &&
+    data?.page !== "SignedOut";
 
   const handleDrawerOpen = () => {
     setDrawerOpen(true);
@@ -0,0 +1,71 @@
+import {
+  Button,
+  Card,
+  CardActions,
+  CardContent,
+  Container,
+  TextField,
+  Typography,
+} from "@mui/material";
+import React, { FC } from "react";
+
+import { ImpersonatePageData } from "../types";
+import CsrfInput from "./CsrfInput";
+
+type ImpersonatePageProps = {
+  data: ImpersonatePageData;
+};
+const ImpersonatePage: FC<ImpersonatePageProps> = ({ data }) => {
+  if (data?.isImpersonated) {
+    return (
+      <Container maxWidth="sm">
+        <Card>
+          <form action="/.pomerium/impersonate/stop" method="POST">
+            <CsrfInput csrfToken={data?.csrfToken} />
+            <CardContent>
+              <Typography variant="h5">Stop Impersonating</Typography>
+              <Typography variant="body2" sx={{ paddingTop: 1 }}>
+                Requests will be authorized as yourself again.
+              </Typography>
+            </CardContent>
+            <CardActions>
+              <Button type="submit" variant="contained">
+                Stop
+              </Button>
+            </CardActions>
+          </form>
+        </Card>
+      </Container>
+    );
+  }
+
+  return (
+    <Container maxWidth="sm">
+      <Card>
+        <form action="/.pomerium/impersonate" method="POST">
+          <CsrfInput csrfToken={data?.csrfToken} />
+          <CardContent>
+            <Typography variant="h5">Impersonate User</Typography>
+            <Typography variant="body2" sx={{ paddingY: 1 }}>
+              Requests will be authorized as the user until you stop
+              impersonating them or the impersonation expires.
+            </Typography>
+            <TextField
+              name="user"
+              label="User ID or Email"
+              fullWidth
+              required
+              autoFocus
+            />
+          </CardContent>
+          <CardActions>
+            <Button type="submit" variant="contained">
+              Impersonate
+            </Button>
+          </CardActions>
+        </form>
+      </Card>
+    </Container>
+  );
+};
+export default ImpersonatePage;
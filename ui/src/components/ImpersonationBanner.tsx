@@ -0,0 +1,36 @@
+import { Alert, Button, Container } from "@mui/material";
+import get from "lodash/get";
+import React, { FC } from "react";
+
+import { Session, User } from "../types";
+
+export type ImpersonationBannerProps = {
+  session?: Session;
+  user?: User;
+};
+export const ImpersonationBanner: FC<ImpersonationBannerProps> = ({
+  session,
+  user,
+}) => {
+  const name =
+    get(user, "claims.email.0") || user?.name || session?.userId || "";
+  const expiresAt = session?.expiresAt
+    ? new Date(session.expiresAt).toLocaleString()
+    : "";
+  return (
+    <Container maxWidth={false} sx={{ paddingBottom: 3 }}>
+      <Alert
+        severity="warning"
+        action={
+          <Button color="inherit" size="small" href="/.pomerium/impersonate">
+            Stop
+          </Button>
+        }
+      >
+        You are impersonating <b>{name}</b>
+        {expiresAt && <> until {expiresAt}</>}.
+      </Alert>
+    </Container>
+  );
+};
+export default ImpersonationBanner;
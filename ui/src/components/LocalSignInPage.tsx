@@ -0,0 +1,61 @@
+import {
+  Alert,
+  Button,
+  Container,
+  Paper,
+  Stack,
+  TextField,
+  Typography,
+} from "@mui/material";
+import React, { FC } from "react";
+
+import { LocalSignInPageData } from "../types";
+import CsrfInput from "./CsrfInput";
+
+type LocalSignInPageProps = {
+  data: LocalSignInPageData;
+};
+const LocalSignInPage: FC<LocalSignInPageProps> = ({ data }) => {
+  return (
+    <Container maxWidth="xs">
+      <Paper sx={{ padding: 3 }}>
+        <form action={data?.selfUrl} method="POST">
+          <CsrfInput csrfToken={data?.csrfToken} />
+          <Stack spacing={2}>
+            <Typography variant="h5">Sign in</Typography>
+            {data?.error && <Alert severity="error">{data.error}</Alert>}
+            <TextField
+              name="username"
+              label="Username"
+              autoComplete="username"
+              defaultValue={data?.username}
+              fullWidth
+              required
+              autoFocus
+            />
+            <TextField
+              name="password"
+              label="Password"
+              type="password"
+              autoComplete="current-password"
+              fullWidth
+              required
+            />
+            <TextField
+              name="code"
+              label="Authentication Code"
+              helperText="Only required if two-factor authentication is enabled."
+              autoComplete="one-time-code"
+              inputProps={{ inputMode: "numeric", pattern: "[0-9]{6}" }}
+              fullWidth
+            />
+            <Button type="submit" variant="contained" size="large">
+              Sign in
+            </Button>
+          </Stack>
+        </form>
+      </Paper>
+    </Container>
+  );
+};
+export default LocalSignInPage;
@@ -0,0 +1,32 @@
+import { Container, Paper, Stack, Typography } from "@mui/material";
+import React, { FC } from "react";
+
+import { PasskeyEnrollPageData } from "../types";
+import WebAuthnRegisterButton from "./WebAuthnRegisterButton";
+
+type PasskeyEnrollPageProps = {
+  data: PasskeyEnrollPageData;
+};
+const PasskeyEnrollPage: FC<PasskeyEnrollPageProps> = ({ data }) => {
+  return (
+    <Container maxWidth="xs">
+      <Paper sx={{ padding: 3 }}>
+        <Stack spacing={2}>
+          <Typography variant="h5">Create a passkey</Typography>
+          <Typography>
+            Create a passkey to sign in as <strong>{data?.user}</strong>. This
+            link can only be used once.
+          </Typography>
+          <WebAuthnRegisterButton
+            creationOptions={data?.creationOptions}
+            csrfToken={data?.csrfToken}
+            url={data?.selfUrl}
+            text="Create passkey"
+            size="large"
+          />
+        </Stack>
+      </Paper>
+    </Container>
+  );
+};
+export default PasskeyEnrollPage;
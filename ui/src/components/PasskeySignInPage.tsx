@@ -0,0 +1,29 @@
+import { Container, Paper, Stack, Typography } from "@mui/material";
+import React, { FC } from "react";
+
+import { PasskeySignInPageData } from "../types";
+import WebAuthnAuthenticateButton from "./WebAuthnAuthenticateButton";
+
+type PasskeySignInPageProps = {
+  data: PasskeySignInPageData;
+};
+const PasskeySignInPage: FC<PasskeySignInPageProps> = ({ data }) => {
+  return (
+    <Container maxWidth="xs">
+      <Paper sx={{ padding: 3 }}>
+        <Stack spacing={2}>
+          <Typography variant="h5">Sign in</Typography>
+          <WebAuthnAuthenticateButton
+            requestOptions={data?.requestOptions}
+            csrfToken={data?.csrfToken}
+            url={data?.selfUrl}
+            enable={!!data?.requestOptions}
+            text="Sign in with a passkey"
+            size="large"
+          />
+        </Stack>
+      </Paper>
+    </Container>
+  );
+};
+export default PasskeySignInPage;
@@ -0,0 +1,38 @@
+import {
+  Button,
+  Container,
+  Paper,
+  Stack,
+  Typography,
+} from "@mui/material";
+import React, { FC } from "react";
+
+import { SelectIdentityProviderPageData } from "../types";
+
+type SelectIdentityProviderPageProps = {
+  data: SelectIdentityProviderPageData;
+};
+const SelectIdentityProviderPage: FC<SelectIdentityProviderPageProps> = ({
+  data,
+}) => {
+  return (
+    <Container maxWidth="xs">
+      <Paper sx={{ padding: 3 }}>
+        <Stack spacing={2}>
+          <Typography variant="h5">Sign in with</Typography>
+          {data?.identityProviders?.map((idp) => (
+            <Button
+              key={idp.url}
+              variant="outlined"
+              size="large"
+              href={idp.url}
+            >
+              {idp.name}
+            </Button>
+          ))}
+        </Stack>
+      </Paper>
+    </Container>
+  );
+};
+export default SelectIdentityProviderPage;
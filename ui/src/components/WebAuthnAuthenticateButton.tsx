@@ -37,9 +37,10 @@ async function authenticateCredential(
 export type WebAuthnAuthenticateButtonProps = Omit<
   WebAuthnButtonProps,
   "action" | "enable" | "onClick" | "text"
-> & {
-  requestOptions: WebAuthnRequestOptions;
-};
+> &
+  Partial<Pick<WebAuthnButtonProps, "enable" | "text">> & {
+    requestOptions: WebAuthnRequestOptions;
+  };
 export const WebAuthnAuthenticateButton: FC<
   WebAuthnAuthenticateButtonProps
 > = ({ requestOptions, ...props }) => {
@@ -55,11 +55,12 @@ async function createCredential(
 export type WebAuthnRegisterButtonProps = Omit<
   WebAuthnButtonProps,
   "action" | "enable" | "onClick" | "text"
-> & {
-  creationOptions: WebAuthnCreationOptions;
-  csrfToken: string;
-  url: string;
-};
+> &
+  Partial<Pick<WebAuthnButtonProps, "enable" | "text">> & {
+    creationOptions: WebAuthnCreationOptions;
+    csrfToken: string;
+    url: string;
+  };
 export const WebAuthnRegisterButton: FC<WebAuthnRegisterButtonProps> = ({
   creationOptions,
   ...props
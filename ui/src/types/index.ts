@@ -96,6 +96,7 @@ export type UserInfoData = {
   directoryGroups?: Group[];
   directoryUser?: DirectoryUser;
   isEnterprise?: boolean;
+  isImpersonated?: boolean;
   session?: Session;
   user?: User;
   profile?: Profile;
@@ -104,11 +105,38 @@ export type UserInfoData = {
   webAuthnUrl?: string;
 };
 
+export type ClientCertificateRegistrationPageData = BasePageData & {
+  page: "ClientCertificateRegistration";
+
+  certificate?: {
+    subject: string;
+    issuer: string;
+    notAfter: string;
+    fingerprint: string;
+  };
+  enrolled?: boolean;
+  supported?: boolean;
+  selfUrl: string;
+};
+
 export type DeviceEnrolledPageData = BasePageData &
   UserInfoData & {
     page: "DeviceEnrolled";
   };
 
+export type ImpersonatePageData = BasePageData &
+  UserInfoData & {
+    page: "Impersonate";
+  };
+
+export type SelectIdentityProviderPageData = BasePageData & {
+  page: "SelectIdentityProvider";
+  identityProviders: {
+    name: string;
+    url: string;
+  }[];
+};
+
 export type SignOutConfirmPageData = BasePageData & {
   page: "SignOutConfirm";
   url: string;
@@ -132,9 +160,41 @@ export type WebAuthnRegistrationPageData = BasePageData & {
   selfUrl: string;
 };
 
+export type LocalSignInPageData = BasePageData & {
+  page: "LocalSignIn";
+
+  csrfToken: string;
+  error?: string;
+  selfUrl: string;
+  username?: string;
+};
+
+export type PasskeySignInPageData = BasePageData & {
+  page: "PasskeySignIn";
+
+  csrfToken: string;
+  requestOptions: WebAuthnRequestOptions;
+  selfUrl: string;
+};
+
+export type PasskeyEnrollPageData = BasePageData & {
+  page: "PasskeyEnroll";
+
+  creationOptions: WebAuthnCreationOptions;
+  csrfToken: string;
+  selfUrl: string;
+  user: string;
+};
+
 export type PageData =
   | ErrorPageData
+  | ClientCertificateRegistrationPageData
   | DeviceEnrolledPageData
+  | SelectIdentityProviderPageData
+  | ImpersonatePageData
+  | LocalSignInPageData
+  | PasskeyEnrollPageData
+  | PasskeySignInPageData
   | SignOutConfirmPageData
   | SignedOutPageData
   | UserInfoPageData